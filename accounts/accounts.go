@@ -18,6 +18,7 @@
 package accounts
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/big"
 
@@ -39,9 +40,13 @@ const (
 	MimetypeDataWithValidator = "data/validator"
 	MimetypeTypedData         = "data/typed"
 	MimetypeClique            = "application/x-clique-header"
+	MimetypeHotstuff          = "application/x-hotstuff-vote"
 	MimetypeTextPlain         = "text/plain"
 )
 
+// hotstuffVotePrefix is the domain of the hashes signed by hotstuff validators.
+const hotstuffVotePrefix = "hotstuff-vote"
+
 // Wallet represents a software or hardware wallet that might contain one or more
 // accounts (derived from the same seed).
 type Wallet interface {
@@ -199,6 +204,34 @@ func TextAndHash(data []byte) ([]byte, string) {
 	return hasher.Sum(nil), msg
 }
 
+// HotstuffVoteHash is a helper function that calculates the hash signed by hotstuff
+// validators for the consensus hash at the given height with the message code.
+//
+// The hash is calulcated as
+//   keccak256("hotstuff-vote"${height}${code}${hash}).
+//
+// This gives context to the consensus hash and prevents signing of transactions.
+func HotstuffVoteHash(height, code uint64, hash common.Hash) []byte {
+	sighash, _ := HotstuffVoteAndHash(height, code, hash)
+	return sighash
+}
+
+// HotstuffVoteAndHash is a helper function that calculates the hash signed by hotstuff
+// validators, along with the pre-image of it.
+//
+// The height and code are encoded as 8 bytes big endian integers.
+func HotstuffVoteAndHash(height, code uint64, hash common.Hash) ([]byte, []byte) {
+	n := len(hotstuffVotePrefix)
+	msg := make([]byte, n+16+common.HashLength)
+	copy(msg, hotstuffVotePrefix)
+	binary.BigEndian.PutUint64(msg[n:], height)
+	binary.BigEndian.PutUint64(msg[n+8:], code)
+	copy(msg[n+16:], hash.Bytes())
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(msg)
+	return hasher.Sum(nil), msg
+}
+
 // WalletEventType represents the different event types that can be fired by
 // the wallet subscription subsystem.
 type WalletEventType int
@@ -125,6 +125,10 @@ var (
 		Name:  "rules",
 		Usage: "Path to the rule file to auto-authorize requests with",
 	}
+	hotstuffFlag = cli.BoolFlag{
+		Name:  "hotstuff",
+		Usage: "Auto-authorize hotstuff consensus votes, refusing to sign different votes for the same height/round/type",
+	}
 	stdiouiFlag = cli.BoolFlag{
 		Name: "stdio-ui",
 		Usage: "Use STDIN/STDOUT as a channel for an external UI. " +
@@ -268,6 +272,7 @@ func init() {
 		customDBFlag,
 		auditLogFlag,
 		ruleFlag,
+		hotstuffFlag,
 		stdiouiFlag,
 		testFlag,
 		advancedMode,
@@ -577,6 +582,9 @@ func signer(c *cli.Context) error {
 	)
 	configDir := c.GlobalString(configdirFlag.Name)
 	if stretchedKey, err := readMasterKey(c, ui); err != nil {
+		if c.GlobalBool(hotstuffFlag.Name) {
+			utils.Fatalf("Hotstuff rule set requires master seed: %v", err)
+		}
 		log.Warn("Failed to open master, rules disabled", "err", err)
 	} else {
 		vaultLocation := filepath.Join(configDir, common.Bytes2Hex(crypto.Keccak256([]byte("vault"), stretchedKey)[:10]))
@@ -590,6 +598,7 @@ func signer(c *cli.Context) error {
 		pwStorage = storage.NewAESEncryptedStorage(filepath.Join(vaultLocation, "credentials.json"), pwkey)
 		jsStorage := storage.NewAESEncryptedStorage(filepath.Join(vaultLocation, "jsstorage.json"), jskey)
 		configStorage := storage.NewAESEncryptedStorage(filepath.Join(vaultLocation, "config.json"), confkey)
+		hotstuffkey := crypto.Keccak256([]byte("hotstuff"), stretchedKey)
 
 		// Do we have a rule-file?
 		if ruleFile := c.GlobalString(ruleFlag.Name); ruleFile != "" {
@@ -614,6 +623,13 @@ func signer(c *cli.Context) error {
 				}
 			}
 		}
+		// The hotstuff rule set protects validators from double signing, votes signed
+		// before are persisted in the vault to survive clef restarts
+		if c.GlobalBool(hotstuffFlag.Name) {
+			hotstuffStorage := storage.NewAESEncryptedStorage(filepath.Join(vaultLocation, "hotstuff.json"), hotstuffkey)
+			ui = rules.NewHotstuffRuleset(ui, hotstuffStorage)
+			log.Info("Hotstuff rule set configured")
+		}
 	}
 	var (
 		chainId  = c.GlobalInt64(chainIdFlag.Name)
@@ -47,6 +47,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.BaikalFlag,
 			utils.RopstenFlag,
 			utils.SyncModeFlag,
+			utils.EpochCheckpointFlag,
 			utils.ExitWhenSyncedFlag,
 			utils.GCModeFlag,
 			utils.TxLookupLimitFlag,
@@ -126,6 +127,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.UnlockedAccountFlag,
 			utils.PasswordFileFlag,
 			utils.ExternalSignerFlag,
+			utils.HotStuffSignerFlag,
 			utils.InsecureUnlockAllowedFlag,
 		},
 	},
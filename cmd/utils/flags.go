@@ -218,6 +218,11 @@ var (
 		Usage: `Blockchain sync mode ("fast", "full", "snap" or "light")`,
 		Value: &defaultSyncMode,
 	}
+	EpochCheckpointFlag = cli.StringFlag{
+		Name:  "syncepoch",
+		Usage: "Trusted epoch start block hash to sync the epochs from, replacing fast or snap sync of an empty chain",
+		Value: "",
+	}
 	GCModeFlag = cli.StringFlag{
 		Name:  "gcmode",
 		Usage: `Blockchain garbage collection mode ("full", "archive")`,
@@ -495,6 +500,11 @@ var (
 		Usage: "External signer (url or path to ipc file)",
 		Value: "",
 	}
+	HotStuffSignerFlag = cli.StringFlag{
+		Name:  "hotstuff.signer",
+		Usage: "External signer (url or path to ipc file) holding the validator key to sign hotstuff consensus messages",
+		Value: "",
+	}
 	VMEnableDebugFlag = cli.BoolFlag{
 		Name:  "vmdebug",
 		Usage: "Record information useful for VM and contract debugging",
@@ -1504,6 +1514,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	setMiner(ctx, &cfg.Miner)
 	setWhitelist(ctx, cfg)
 	setLes(ctx, cfg)
+	if ctx.GlobalIsSet(HotStuffSignerFlag.Name) {
+		cfg.HotStuffSigner = ctx.GlobalString(HotStuffSignerFlag.Name)
+	}
 
 	// Cap the cache allowance and tune the garbage collector
 	mem, err := gopsutil.VirtualMemory()
@@ -1528,6 +1541,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(SyncModeFlag.Name) {
 		cfg.SyncMode = *GlobalTextMarshaler(ctx, SyncModeFlag.Name).(*downloader.SyncMode)
 	}
+	if ctx.GlobalIsSet(EpochCheckpointFlag.Name) {
+		hash := ctx.GlobalString(EpochCheckpointFlag.Name)
+		if err := cfg.EpochCheckpoint.UnmarshalText([]byte(hash)); err != nil {
+			Fatalf("Invalid epoch checkpoint hash %s: %v", hash, err)
+		}
+	}
 	if ctx.GlobalIsSet(NetworkIdFlag.Name) {
 		cfg.NetworkId = ctx.GlobalUint64(NetworkIdFlag.Name)
 	}
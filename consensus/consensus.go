@@ -157,6 +157,15 @@ type Peer interface {
 	Send(msgcode uint64, data interface{}) error
 }
 
+// PriorityPeer defines the peer of the dedicated consensus protocol, which queues the
+// messages by priority
+type PriorityPeer interface {
+	Peer
+
+	// SendUrgent sends the message to this peer ahead of the queued ones
+	SendUrgent(data interface{}) error
+}
+
 // HotStuff is a consensus engine to implement the scalable hotstuff consensus
 type HotStuff interface {
 	Engine
@@ -171,6 +180,23 @@ type HotStuff interface {
 	FillHeader(state *state.StateDB, header *types.Header) error
 }
 
+// EpochVerifier is implemented by the consensus engines whose validators only change at
+// the epoch start headers, so that a header can be verified without its ancestors once the
+// epoch start header covering it is trusted.
+type EpochVerifier interface {
+	// EpochRange returns the start height of the epoch which the header belongs to, and the
+	// height of the next epoch start header.
+	EpochRange(header *types.Header) (start, end uint64, err error)
+
+	// VerifyEpochHeader checks the next epoch start header against the validators elected
+	// in the trusted epoch start header.
+	VerifyEpochHeader(epoch, header *types.Header) error
+
+	// VerifyEpochMember checks the header inside of the epoch against the validators
+	// elected in the trusted epoch start header.
+	VerifyEpochMember(epoch, header *types.Header) error
+}
+
 // Handler should be implemented is the consensus needs to handle and send peer's message
 type Handler interface {
 	// NewChainHead handles a new head block comes
@@ -191,6 +217,13 @@ type Handler interface {
 	SubscribeBlock(ch chan<- ExecutedBlock) event.Subscription
 }
 
+// HandshakeSigner is implemented by the consensus engines which authenticate the consensus peers
+// with the validator key, the key may be held by an external signer rather than the node key.
+type HandshakeSigner interface {
+	// SignHandshake signs the handshake hash with the validator key.
+	SignHandshake(hash common.Hash) ([]byte, error)
+}
+
 // PoW is a consensus engine based on proof-of-work.
 type PoW interface {
 	Engine
@@ -96,6 +96,15 @@ type CoreEngine interface {
 	// pending request is populated right at the request stage so this would give us the earliest verification
 	// to avoid any race condition of coming propagated blocks
 	IsCurrentProposal(sealHash common.Hash) bool
+
+	// RoundState retrieve a snapshot of current round state, returns nil if the engine not started yet
+	RoundState() *RoundStateInfo
+
+	// Votes retrieve the messages collected from each validator in current view
+	Votes() map[common.Address]*VoteInfo
+
+	// BacklogSizes retrieve the size of backlog queue for each validator in current validator set
+	BacklogSizes() map[common.Address]int
 }
 
 type HotstuffProtocol string
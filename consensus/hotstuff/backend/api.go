@@ -19,8 +19,19 @@
 package backend
 
 import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	defaultParticipationBlocks = uint64(100)   // default number of blocks used to calculate participation
+	maxParticipationBlocks     = uint64(10000) // the max number of blocks used to calculate participation
 )
 
 // API is a user facing RPC API to allow controlling the address and voting
@@ -43,3 +54,112 @@ func (api *API) CurrentSequence() (uint64, uint64) {
 func (api *API) IsProposer() bool {
 	return api.hotstuff.core.IsProposer()
 }
+
+// RoundState retrieve current round state, includes view, state, locked QC, high QC, prepare QC and pending request hash.
+func (api *API) RoundState() (*hotstuff.RoundStateInfo, error) {
+	info := api.hotstuff.core.RoundState()
+	if info == nil {
+		return nil, ErrStoppedEngine
+	}
+	return info, nil
+}
+
+// Votes retrieve messages collected from each validator in current view.
+func (api *API) Votes() map[common.Address]*hotstuff.VoteInfo {
+	return api.hotstuff.core.Votes()
+}
+
+// Backlogs retrieve the size of backlog message queue for each validator.
+func (api *API) Backlogs() map[common.Address]int {
+	return api.hotstuff.core.BacklogSizes()
+}
+
+// ValidatorParticipation records the times of validator's committed seal exist or missing in block header.
+type ValidatorParticipation struct {
+	Signed     uint64 `json:"signed"`
+	Missed     uint64 `json:"missed"`
+	LastSigned uint64 `json:"lastSigned"`
+}
+
+// Participation denote the validators liveness in block range of [From, To].
+type Participation struct {
+	From       uint64                                     `json:"from"`
+	To         uint64                                     `json:"to"`
+	Validators map[common.Address]*ValidatorParticipation `json:"validators"`
+}
+
+// Participation retrieve the validators liveness which derived from committed seals in the last `n` blocks.
+func (api *API) Participation(n *uint64) (*Participation, error) {
+	blocks := defaultParticipationBlocks
+	if n != nil {
+		blocks = *n
+	}
+	if blocks == 0 || blocks > maxParticipationBlocks {
+		return nil, fmt.Errorf("invalid blocks number %d, should be in range of [1, %d]", blocks, maxParticipationBlocks)
+	}
+
+	current := api.chain.CurrentHeader()
+	if current == nil {
+		return nil, errUnknownBlock
+	}
+	to := current.Number.Uint64()
+	from := uint64(1)
+	if to > blocks {
+		from = to - blocks + 1
+	}
+
+	result := &Participation{
+		From:       from,
+		To:         to,
+		Validators: make(map[common.Address]*ValidatorParticipation),
+	}
+	for header := current; header != nil && header.Number.Uint64() >= from && header.Number.Uint64() > 0; header = api.chain.GetHeader(header.ParentHash, header.Number.Uint64()-1) {
+		height := header.Number.Uint64()
+		_, vals, err := api.hotstuff.getValidatorsByHeader(header, nil, api.chain)
+		if err != nil {
+			return nil, fmt.Errorf("get validators at height %d failed, err: %v", height, err)
+		}
+		committers, err := recoverCommitters(header, api.hotstuff.chainConfig.IsHotstuffVote(header.Number))
+		if err != nil {
+			return nil, fmt.Errorf("recover committers at height %d failed, err: %v", height, err)
+		}
+		for _, addr := range vals.AddressList() {
+			item, ok := result.Validators[addr]
+			if !ok {
+				item = new(ValidatorParticipation)
+				result.Validators[addr] = item
+			}
+			if _, ok := committers[addr]; ok {
+				item.Signed += 1
+				if item.LastSigned < height {
+					item.LastSigned = height
+				}
+			} else {
+				item.Missed += 1
+			}
+		}
+	}
+	return result, nil
+}
+
+// recoverCommitters extract committed seals from header and recover the signers address, the
+// seals are signed as domain separated votes if vote is true.
+func recoverCommitters(header *types.Header, vote bool) (map[common.Address]struct{}, error) {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	hash := types.SealHash(header).Bytes()
+	if vote {
+		hash = accounts.HotstuffVoteHash(header.Number.Uint64(), hotstuff.SealCodeCommitted, types.SealHash(header))
+	}
+	committers := make(map[common.Address]struct{})
+	for _, seal := range extra.CommittedSeal {
+		pubkey, err := crypto.SigToPub(hash, seal)
+		if err != nil {
+			return nil, err
+		}
+		committers[crypto.PubkeyToAddress(*pubkey)] = struct{}{}
+	}
+	return committers, nil
+}
@@ -72,11 +72,15 @@ type backend struct {
 }
 
 func New(chainConfig *params.ChainConfig, config *hotstuff.Config, privateKey *ecdsa.PrivateKey, db ethdb.Database, mock bool) *backend {
+	return NewWithSigner(chainConfig, config, snr.NewSigner(privateKey, chainConfig.HotstuffVoteBlock()), db, mock)
+}
+
+// NewWithSigner creates backend with the given signer, e.g. remote signer which holds validator key out of process.
+func NewWithSigner(chainConfig *params.ChainConfig, config *hotstuff.Config, signer hotstuff.Signer, db ethdb.Database, mock bool) *backend {
 	recents, _ := lru.NewARC(inmemorySnapshots)
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
 	knownMessages, _ := lru.NewARC(inmemoryMessages)
 
-	signer := snr.NewSigner(privateKey)
 	backend := &backend{
 		config:         config,
 		chainConfig:    chainConfig,
@@ -103,6 +107,12 @@ func (s *backend) Address() common.Address {
 	return s.signer.Address()
 }
 
+// SignHandshake implements consensus.HandshakeSigner, consensus peers are authenticated by the
+// validator key of hotstuff signer.
+func (s *backend) SignHandshake(hash common.Hash) ([]byte, error) {
+	return s.signer.SignHandshake(hash)
+}
+
 func (s *backend) SubscribeEvent(ch interface{}) event.Subscription {
 	switch c := ch.(type) {
 	case chan hotstuff.RequestEvent:
@@ -208,7 +218,14 @@ func (s *backend) Unicast(valSet hotstuff.ValidatorSet, payload []byte) error {
 			m.Add(hash, true)
 			s.recentMessages.Add(target, m)
 			go func() {
-				if err := p.Send(hotstuffMsg, payload); err != nil {
+				// the messages to leader gate the progress of round, deliver them first if possible
+				var err error
+				if pp, ok := p.(consensus.PriorityPeer); ok {
+					err = pp.SendUrgent(payload)
+				} else {
+					err = p.Send(hotstuffMsg, payload)
+				}
+				if err != nil {
 					s.logger.Error("unicast message failed", "err", err)
 				}
 			}()
@@ -209,8 +209,13 @@ func (s *backend) Seal(chain consensus.ChainHeaderReader, block *types.Block, re
 	// update the block header timestamp and signature and propose the block to core engine
 	header := block.Header()
 
-	// sign the sig hash and fill extra seal
-	seal, err := s.signer.SignHash(s.SealHash(header))
+	// sign the sig hash and fill extra seal, the round of current view is only informative for the
+	// remote signer, which never records the proposals.
+	var round uint64
+	if height, r := s.core.CurrentSequence(); height == header.Number.Uint64() {
+		round = r
+	}
+	seal, err := s.signer.SignVote(header.Number.Uint64(), round, hotstuff.SealCodeProposal, s.SealHash(header))
 	if err != nil {
 		return err
 	}
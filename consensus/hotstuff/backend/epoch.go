@@ -0,0 +1,112 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EpochRange implements consensus.EpochVerifier, the epoch start header carries its own height
+// as the start height, and the other headers carry the start height of the epoch they belong to.
+func (s *backend) EpochRange(header *types.Header) (uint64, uint64, error) {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return 0, 0, err
+	}
+	return extra.StartHeight, extra.EndHeight, nil
+}
+
+// VerifyEpochHeader implements consensus.EpochVerifier, the next epoch start header should be
+// the end of the trusted epoch, and its committed seals should reach the quorum of validators
+// elected in the trusted epoch start header.
+func (s *backend) VerifyEpochHeader(epoch, header *types.Header) error {
+	vals, end, err := epochValidators(epoch)
+	if err != nil {
+		return err
+	}
+	if header.Number == nil || header.Number.Uint64() != end {
+		return errInvalidEpochHeader
+	}
+	if _, _, err := epochValidators(header); err != nil {
+		return err
+	}
+	return s.verifyEpochSeals(header, vals)
+}
+
+// VerifyEpochMember implements consensus.EpochVerifier, the header should be inside of the
+// trusted epoch, and its committed seals should reach the quorum of validators elected in
+// the trusted epoch start header.
+func (s *backend) VerifyEpochMember(epoch, header *types.Header) error {
+	vals, end, err := epochValidators(epoch)
+	if err != nil {
+		return err
+	}
+	if header.Number == nil || header.Number.Uint64() <= epoch.Number.Uint64() || header.Number.Uint64() >= end {
+		return errOutOfEpoch
+	}
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	if extra.StartHeight != epoch.Number.Uint64() || extra.EndHeight != end {
+		return errOutOfEpoch
+	}
+	return s.verifyEpochSeals(header, vals)
+}
+
+// verifyEpochSeals checks the proposer seal and committed seals carried by the header itself
+// rather than the ones cached by the signer, as the headers are served by untrusted peers and
+// the cache is indexed by the seal hash which excludes the seals.
+func (s *backend) verifyEpochSeals(header *types.Header, vals []common.Address) error {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	var (
+		valset = NewDefaultValSet(vals)
+		hash   = types.SealHash(header)
+	)
+	proposer, err := s.signer.CheckSignature(valset, header.Number.Uint64(), hotstuff.SealCodeProposal, hash, extra.Seal)
+	if err != nil {
+		return err
+	}
+	if proposer != header.Coinbase {
+		return errUnauthorized
+	}
+	return s.signer.VerifyCommittedSeal(valset, header.Number.Uint64(), hash, extra.CommittedSeal)
+}
+
+// epochValidators returns the validators elected in the epoch start header and the height
+// of the next epoch start header.
+func epochValidators(epoch *types.Header) ([]common.Address, uint64, error) {
+	if epoch == nil || epoch.Number == nil || epoch.MixDigest != types.HotstuffDigest {
+		return nil, 0, errInvalidEpochHeader
+	}
+	extra, err := types.ExtractHotstuffExtra(epoch)
+	if err != nil {
+		return nil, 0, err
+	}
+	number := epoch.Number.Uint64()
+	if extra.StartHeight != number || extra.EndHeight <= number || len(extra.Validators) == 0 {
+		return nil, 0, errInvalidEpochHeader
+	}
+	return extra.Validators, extra.EndHeight, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeEpochKeys(t *testing.T, n int) ([]*ecdsa.PrivateKey, []common.Address) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	addrs := make([]common.Address, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		assert.NoError(t, err)
+		keys[i], addrs[i] = key, crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return keys, addrs
+}
+
+// makeEpochHeader creates a header proposed by the first signer and committed by all signers.
+func makeEpochHeader(t *testing.T, number, start, end uint64, vals []common.Address, signers []*ecdsa.PrivateKey) *types.Header {
+	header := &types.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Coinbase:   crypto.PubkeyToAddress(signers[0].PublicKey),
+		MixDigest:  types.HotstuffDigest,
+		Difficulty: defaultDifficulty,
+	}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, vals, start, end))
+
+	hash := types.SealHash(header)
+	seal, err := snr.NewSigner(signers[0], common.Big0).SignVote(number, 0, hotstuff.SealCodeProposal, hash)
+	assert.NoError(t, err)
+	assert.NoError(t, header.SetSeal(seal))
+
+	committed := make([][]byte, len(signers))
+	for i, key := range signers {
+		committed[i], err = snr.NewSigner(key, common.Big0).SignVote(number, 0, hotstuff.SealCodeCommitted, hash)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, header.SetCommittedSeal(committed))
+	return header
+}
+
+// go test -count=1 -v github.com/ethereum/go-ethereum/consensus/hotstuff/backend -run TestVerifyEpoch
+func TestVerifyEpoch(t *testing.T) {
+	keys, _ := makeEpochKeys(t, 1)
+	chainConfig := &params.ChainConfig{HotStuff: &params.HotStuffConfig{VoteBlock: common.Big0}}
+	engine := New(chainConfig, hotstuff.DefaultBasicConfig, keys[0], rawdb.NewMemoryDatabase(), true)
+	var verifier consensus.EpochVerifier = engine
+
+	oldKeys, oldVals := makeEpochKeys(t, 4)
+	newKeys, newVals := makeEpochKeys(t, 4)
+
+	genesis := &types.Header{Number: new(big.Int), MixDigest: types.HotstuffDigest, Difficulty: defaultDifficulty}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(genesis, oldVals, 0, 100))
+
+	// not reaching the quorum, which should not prevent the valid one with the same hash
+	assert.Error(t, verifier.VerifyEpochHeader(genesis, makeEpochHeader(t, 100, 100, 200, newVals, oldKeys[:2])))
+	// committed by the elected validators themselves
+	assert.Error(t, verifier.VerifyEpochHeader(genesis, makeEpochHeader(t, 100, 100, 200, newVals, newKeys)))
+
+	// the next epoch start header elects the new validators and is committed by the old ones
+	epoch := makeEpochHeader(t, 100, 100, 200, newVals, oldKeys)
+	assert.NoError(t, verifier.VerifyEpochHeader(genesis, epoch))
+	start, end, err := verifier.EpochRange(epoch)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), start)
+	assert.Equal(t, uint64(200), end)
+
+	// not the end of trusted epoch
+	assert.Equal(t, errInvalidEpochHeader, verifier.VerifyEpochHeader(genesis, makeEpochHeader(t, 99, 99, 200, newVals, oldKeys)))
+	// not an epoch start header
+	assert.Equal(t, errInvalidEpochHeader, verifier.VerifyEpochHeader(genesis, makeEpochHeader(t, 100, 0, 100, nil, oldKeys)))
+
+	// the headers inside of the epoch are committed by the elected validators
+	member := makeEpochHeader(t, 150, 100, 200, nil, newKeys)
+	assert.NoError(t, verifier.VerifyEpochMember(epoch, member))
+	start, end, err = verifier.EpochRange(member)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), start)
+	assert.Equal(t, uint64(200), end)
+
+	assert.Error(t, verifier.VerifyEpochMember(epoch, makeEpochHeader(t, 150, 100, 200, nil, oldKeys)))
+	assert.Equal(t, errOutOfEpoch, verifier.VerifyEpochMember(epoch, makeEpochHeader(t, 200, 100, 200, nil, newKeys)))
+	assert.Equal(t, errOutOfEpoch, verifier.VerifyEpochMember(epoch, makeEpochHeader(t, 150, 0, 100, nil, newKeys)))
+	assert.Equal(t, errInvalidEpochHeader, verifier.VerifyEpochMember(member, makeEpochHeader(t, 160, 100, 200, nil, newKeys)))
+}
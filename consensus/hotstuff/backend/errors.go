@@ -55,4 +55,8 @@ var (
 	errBADProposal = errors.New("bad proposal")
 	// errSnapNotExist
 	errSnapNotExist = errors.New("snap not exist")
+	// errInvalidEpochHeader is returned if the header is not the epoch start header it claims to be.
+	errInvalidEpochHeader = errors.New("invalid epoch start header")
+	// errOutOfEpoch is returned if the header is not inside of the trusted epoch.
+	errOutOfEpoch = errors.New("header out of epoch")
 )
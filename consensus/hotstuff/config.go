@@ -18,6 +18,8 @@
 
 package hotstuff
 
+import "github.com/ethereum/go-ethereum/common/mclock"
+
 type SelectProposerPolicy uint64
 
 const (
@@ -27,25 +29,37 @@ const (
 )
 
 type Config struct {
-	RequestTimeout uint64               `toml:",omitempty"` // The timeout for each Istanbul round in milliseconds.
-	BlockPeriod    uint64               `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second for basic hotstuff and mill-seconds for event-driven
-	LeaderPolicy   SelectProposerPolicy `toml:",omitempty"` // The policy for speaker selection
-	Test           bool                 `toml:",omitempty"`
-	Epoch          uint64               `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
+	RequestTimeout    uint64               `toml:",omitempty"` // The timeout for each Istanbul round in milliseconds.
+	BlockPeriod       uint64               `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second for basic hotstuff and mill-seconds for event-driven
+	LeaderPolicy      SelectProposerPolicy `toml:",omitempty"` // The policy for speaker selection
+	Test              bool                 `toml:",omitempty"`
+	Epoch             uint64               `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
+	BackoffBase       uint64               `toml:",omitempty"` // The base of round timeout exponential backoff in milliseconds
+	MaxBackoffTimeout uint64               `toml:",omitempty"` // The upper bound of round timeout backoff in milliseconds
+	Clock             mclock.Clock         `toml:"-"`          // The clock used by round timers, use system clock if nil
 }
 
+const (
+	DefaultBackoffBase       uint64 = 1000  // 1 second
+	DefaultMaxBackoffTimeout uint64 = 60000 // 1 minute
+)
+
 var DefaultBasicConfig = &Config{
-	RequestTimeout: 6000,
-	BlockPeriod:    3,
-	LeaderPolicy:   RoundRobin,
-	Epoch:          30000,
-	Test:           false,
+	RequestTimeout:    6000,
+	BlockPeriod:       3,
+	LeaderPolicy:      RoundRobin,
+	Epoch:             30000,
+	Test:              false,
+	BackoffBase:       DefaultBackoffBase,
+	MaxBackoffTimeout: DefaultMaxBackoffTimeout,
 }
 
 var DefaultEventDrivenConfig = &Config{
-	RequestTimeout: 4000,
-	BlockPeriod:    2000,
-	LeaderPolicy:   RoundRobin,
-	Epoch:          0,
-	Test:           false,
+	RequestTimeout:    4000,
+	BlockPeriod:       2000,
+	LeaderPolicy:      RoundRobin,
+	Epoch:             0,
+	Test:              false,
+	BackoffBase:       DefaultBackoffBase,
+	MaxBackoffTimeout: DefaultMaxBackoffTimeout,
 }
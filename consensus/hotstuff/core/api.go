@@ -0,0 +1,99 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// RoundState implement core.Engine.RoundState
+func (c *core) RoundState() *hotstuff.RoundStateInfo {
+	if c.current == nil || c.valSet == nil {
+		return nil
+	}
+
+	info := &hotstuff.RoundStateInfo{
+		Height:     c.current.HeightU64(),
+		Round:      c.current.RoundU64(),
+		State:      c.currentState().String(),
+		IsProposer: c.valSet.IsProposer(c.Address()),
+		LockQC:     qcInfo(c.current.LockQC()),
+		HighQC:     qcInfo(c.current.HighQC()),
+		PrepareQC:  qcInfo(c.current.PrepareQC()),
+	}
+	if proposer := c.valSet.GetProposer(); proposer != nil {
+		info.Proposer = proposer.Address()
+	}
+	if req := c.current.PendingRequest(); req != nil && req.block != nil {
+		info.PendingRequest = req.block.SealHash()
+	}
+	return info
+}
+
+// Votes implement core.Engine.Votes. only the leader collects votes in basic hotstuff,
+// so the vote flags are always false on repo nodes.
+func (c *core) Votes() map[common.Address]*hotstuff.VoteInfo {
+	if c.current == nil || c.valSet == nil {
+		return nil
+	}
+
+	votes := make(map[common.Address]*hotstuff.VoteInfo)
+	for _, addr := range c.valSet.AddressList() {
+		vote := &hotstuff.VoteInfo{
+			NewView:       c.current.newViews.Get(addr) != nil,
+			PrepareVote:   c.current.prepareVotes.Get(addr) != nil,
+			PreCommitVote: c.current.preCommitVotes.Get(addr) != nil,
+			CommitVote:    c.current.commitVotes.Get(addr) != nil,
+		}
+		for _, voted := range []bool{vote.NewView, vote.PrepareVote, vote.PreCommitVote, vote.CommitVote} {
+			if voted {
+				vote.Count += 1
+			}
+		}
+		votes[addr] = vote
+	}
+	return votes
+}
+
+// BacklogSizes implement core.Engine.BacklogSizes
+func (c *core) BacklogSizes() map[common.Address]int {
+	if c.valSet == nil {
+		return nil
+	}
+
+	sizes := make(map[common.Address]int)
+	for _, addr := range c.valSet.AddressList() {
+		sizes[addr] = c.backlogs.Size(addr)
+	}
+	return sizes
+}
+
+func qcInfo(qc *QuorumCert) *hotstuff.QCInfo {
+	if qc == nil {
+		return nil
+	}
+	return &hotstuff.QCInfo{
+		Height:   qc.HeightU64(),
+		Round:    qc.RoundU64(),
+		Code:     qc.code.String(),
+		Node:     qc.node,
+		Proposer: qc.proposer,
+	}
+}
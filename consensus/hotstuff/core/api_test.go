@@ -0,0 +1,70 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestRoundStateApi
+func TestRoundStateApi(t *testing.T) {
+	N, H, R := 4, 5, 1
+	c, vals := singerTestCore(t, N, int64(H), int64(R))
+
+	qc := newTestQCWithoutExtra(c, H-1, 0)
+	assert.NoError(t, c.current.SetPrepareQC(qc))
+	c.current.SetHighQC(qc)
+
+	info := c.RoundState()
+	assert.NotNil(t, info)
+	assert.Equal(t, uint64(H), info.Height)
+	assert.Equal(t, uint64(R), info.Round)
+	assert.Equal(t, StateAcceptRequest.String(), info.State)
+	assert.Equal(t, vals.GetProposer().Address(), info.Proposer)
+	assert.Nil(t, info.LockQC)
+	assert.Equal(t, qc.node, info.PrepareQC.Node)
+	assert.Equal(t, qc.node, info.HighQC.Node)
+	assert.Equal(t, uint64(H-1), info.HighQC.Height)
+}
+
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestVotesApi
+func TestVotesApi(t *testing.T) {
+	N, H, R := 4, 5, 0
+	c, vals := singerTestCore(t, N, int64(H), int64(R))
+	view := makeView(H, R)
+
+	voter := vals.GetByIndex(1).Address()
+	assert.NoError(t, c.current.AddNewViews(&Message{Code: MsgTypeNewView, View: view, address: voter}))
+	assert.NoError(t, c.current.AddPrepareVote(&Message{Code: MsgTypePrepareVote, View: view, address: voter}))
+
+	votes := c.Votes()
+	assert.Equal(t, N, len(votes))
+	assert.Equal(t, 2, votes[voter].Count)
+	assert.True(t, votes[voter].NewView)
+	assert.True(t, votes[voter].PrepareVote)
+	assert.False(t, votes[voter].CommitVote)
+	assert.Equal(t, 0, votes[vals.GetByIndex(2).Address()].Count)
+
+	c.backlogs.Push(&Message{Code: MsgTypePrepare, View: makeView(H+1, R), address: voter})
+	sizes := c.BacklogSizes()
+	assert.Equal(t, 1, sizes[voter])
+	assert.Equal(t, 0, sizes[vals.GetByIndex(2).Address()])
+}
@@ -142,6 +142,9 @@ func (c *core) handleCommit(data *Message) error {
 }
 
 func (c *core) acceptLockQC(qc *QuorumCert) error {
+	if err := c.wal.writeLock(qc, c.current.Node()); err != nil {
+		return err
+	}
 	if err := c.current.Lock(qc); err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func TestHandlePreCommitVote(t *testing.T) {
 				msg := newVoteMsg(node.Hash(), core.Address(), H, R)
 				val := validator.New(msg.address)
 				msg.PayloadNoSig()
-				sig, _ := v.engine.signer.SignHash(msg.hash)
+				sig, _ := v.engine.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), msg.Code.Value(), msg.hash)
 				msg.Signature = sig
 				votes[val] = msg
 			}
@@ -111,7 +111,7 @@ func TestHandleCommit(t *testing.T) {
 			View:    coreView,
 		}
 		msg.PayloadNoSig()
-		sig, _ := leader.signer.SignHash(msg.hash)
+		sig, _ := leader.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), msg.Code.Value(), msg.hash)
 		msg.Signature = sig
 		return sub, msg
 	}
@@ -21,9 +21,9 @@ package core
 import (
 	"math/big"
 	"sync"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/common/prque"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -37,16 +37,21 @@ type core struct {
 	logger log.Logger
 	config *hotstuff.Config
 
-	current  *roundState
-	backend  hotstuff.Backend
-	signer   hotstuff.Signer
-	valSet   hotstuff.ValidatorSet
-	backlogs *backlog
+	current   *roundState
+	sequence  [2]uint64     // height and round of current view, read by other routines
+	seqMu     *sync.RWMutex // protect sequence
+	backend   hotstuff.Backend
+	signer    hotstuff.Signer
+	valSet    hotstuff.ValidatorSet
+	backlogs  *backlog
+	pacemaker *pacemaker
+	wal       *wal
 
 	backlogFeed       event.Feed
 	newRoundFeed      event.Feed
 
-	roundChangeTimer *time.Timer
+	clock            mclock.Clock
+	roundChangeTimer mclock.Timer
 
 	pendingRequests   *prque.Prque
 	pendingRequestsMu *sync.Mutex
@@ -54,7 +59,7 @@ type core struct {
 	lastVals hotstuff.ValidatorSet // validator set for last epoch
 	point    uint64                // epoch start height, header's extra contains valset
 
-	validateFn   func(common.Hash, []byte) (common.Address, error)
+	validateFn   func(height, code uint64, hash common.Hash, sig []byte) (common.Address, error)
 	checkPointFn func(uint64) (uint64, bool)
 	isRunning    bool
 
@@ -71,12 +76,18 @@ func New(backend hotstuff.Backend, config *hotstuff.Config, signer hotstuff.Sign
 		backend:           backend,
 		signer:            signer,
 		backlogs:          newBackLog(),
+		pacemaker:         newPacemaker(),
+		wal:               newWAL(db),
 		pendingRequests:   prque.New(nil),
 		pendingRequestsMu: new(sync.Mutex),
+		seqMu:             new(sync.RWMutex),
 		exit:              make(chan struct{}),
 	}
 	c.validateFn = c.checkValidatorSignature
 	c.checkPointFn = checkPointFn
+	if c.clock = config.Clock; c.clock == nil {
+		c.clock = mclock.System{}
+	}
 	return c
 }
 
@@ -90,6 +101,7 @@ func (c *core) startNewRound(round *big.Int) {
 
 	var (
 		changeView                 = false
+		catchUp                    = false
 		lastProposal, lastProposer = c.backend.LastProposal()
 	)
 
@@ -110,6 +122,7 @@ func (c *core) startNewRound(round *big.Int) {
 		logger.Trace("Start for the initial round")
 	} else if lastProposal.NumberU64() >= c.HeightU64() {
 		logger.Trace("Catch up latest proposal", "number", lastProposal.NumberU64(), "hash", lastProposal.Hash())
+		catchUp = true
 	} else if lastProposal.NumberU64() < c.HeightU64()-1 {
 		logger.Warn("New height should be larger than current height", "new_height", lastProposal.NumberU64)
 		return
@@ -149,9 +162,25 @@ func (c *core) startNewRound(round *big.Int) {
 		logger.Error("Update round state failed", "state", c.currentState(), "newView", newView, "err", err)
 		return
 	}
-	if changeEpoch {
+	// the locked block is stale if the chain catch up it's height by block syncing. `checkBlock` and
+	// `handlePrepare` only accept the locked block at its own height, so the node would refuse every
+	// proposal of the new height and never vote again. Dropping the lock is safe, the lock only guards
+	// the decision of its height, which is already decided by the synced block carrying the committed
+	// seals of a quorum, and any other block of that height can't collect a quorum of commits as the
+	// two quorums intersect in an honest validator. The lock is kept on view change of the same height.
+	if changeEpoch || catchUp {
 		c.current.Unlock()
 	}
+	c.pacemaker.reset(newView.HeightU64())
+	if !changeView {
+		if err := c.wal.truncate(newView.HeightU64()); err != nil {
+			logger.Warn("Truncate wal failed", "height", newView.HeightU64(), "err", err)
+		}
+	}
+	if changeView {
+		viewChangeMeter.Mark(1)
+	}
+	roundGauge.Update(newView.Round.Int64())
 	logger.Debug("New round", "state", c.currentState(), "newView", newView, "new_proposer", c.valSet.GetProposer(), "valSet", c.valSet.List(), "size", c.valSet.Size(), "IsProposer", c.IsProposer())
 
 	// stop last timer and regenerate new timer
@@ -183,9 +212,13 @@ func (c *core) updateRoundState(lastProposal *types.Block, newView *View) error
 	if c.current == nil {
 		c.current = newRoundState(c.db, c.logger.New(), c.valSet, lastProposal, newView)
 		c.current.reload(newView)
+		c.replayWAL()
 	} else {
 		c.current = c.current.update(c.valSet, lastProposal, newView)
 	}
+	c.seqMu.Lock()
+	c.sequence = [2]uint64{c.current.HeightU64(), c.current.RoundU64()}
+	c.seqMu.Unlock()
 
 	if !c.isEpochStartQC(c.currentView(), nil) {
 		return nil
@@ -220,6 +253,6 @@ func (c *core) setCurrentState(s State) {
 	c.processBacklog()
 }
 
-func (c *core) checkValidatorSignature(hash common.Hash, sig []byte) (common.Address, error) {
-	return c.signer.CheckSignature(c.valSet, hash, sig)
+func (c *core) checkValidatorSignature(height, code uint64, hash common.Hash, sig []byte) (common.Address, error) {
+	return c.signer.CheckSignature(c.valSet, height, code, hash, sig)
 }
@@ -0,0 +1,64 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestStartNewRoundUnlock
+func TestStartNewRoundUnlock(t *testing.T) {
+	N, H, R := 4, 3, 0
+
+	newCore := func() (*core, func()) {
+		sys := NewTestSystemWithBackend(N, H, R)
+		closer := sys.Run(false)
+		c := sys.backends[0].engine
+		c.isRunning = true
+		c.current.lockedBlock = makeBlock(H)
+		c.current.proposalLocked = true
+		return c, func() {
+			c.stopTimer()
+			closer()
+		}
+	}
+	commit := func(c *core, number int) {
+		backend := c.backend.(*testSystemBackend)
+		backend.committedMsgs = append(backend.committedMsgs, testCommittedMsgs{commitProposal: makeBlock(number)})
+	}
+
+	// view change at the same height keeps the locked proposal
+	c, stop := newCore()
+	commit(c, H-1)
+	c.startNewRound(big.NewInt(1))
+	assert.Equal(t, uint64(H), c.HeightU64())
+	assert.NotNil(t, c.current.LockedBlock())
+	stop()
+
+	// the locked proposal is stale once the chain catches up beyond it by block syncing
+	c, stop = newCore()
+	commit(c, H+2)
+	c.startNewRound(new(big.Int))
+	assert.Equal(t, uint64(H+3), c.HeightU64())
+	assert.Nil(t, c.current.LockedBlock())
+	stop()
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -64,7 +65,7 @@ func (c *core) handleCommitVote(data *Message) error {
 	}
 	// validateFn used to check block hash signature, it is an closure function which can be nil in unit test.
 	if c.validateFn != nil {
-		if addr, err := c.validateFn(lockedBlock.SealHash(), data.CommittedSeal); err != nil {
+		if addr, err := c.validateFn(lockedBlock.NumberU64(), hotstuff.SealCodeCommitted, lockedBlock.SealHash(), data.CommittedSeal); err != nil {
 			logger.Trace("Failed to check vote", "msg", code, "src", src, "err", err, "expect", src, "got", addr)
 			return err
 		}
@@ -173,7 +174,7 @@ func (c *core) handleDecide(data *Message) error {
 		return errInvalidBlock
 	}
 
-	if err := c.signer.VerifyCommittedSeal(c.valSet, msg.BlockHash, msg.CommittedSeals); err != nil {
+	if err := c.signer.VerifyCommittedSeal(c.valSet, lockedBlock.NumberU64(), msg.BlockHash, msg.CommittedSeals); err != nil {
 		logger.Trace("Failed to verify committed seals", "msg", code, "src", src, "err", err)
 		return errInvalidQC
 	}
@@ -213,6 +214,9 @@ func (c *core) acceptCommitQC(sealedBlock *types.Block, commitQC *QuorumCert) er
 	if err := c.current.SetSealedBlock(sealedBlock); err != nil {
 		return err
 	}
+	if err := c.wal.writeQC(commitQC); err != nil {
+		return err
+	}
 	if err := c.current.SetCommittedQC(commitQC); err != nil {
 		return err
 	}
@@ -60,7 +60,7 @@ func TestHandleCommitVote(t *testing.T) {
 				core.current.Lock(&QuorumCert{node: node.Hash()})
 				msg := newVoteMsg(node.Hash(), core.Address(), H, R)
 				msg.PayloadNoSig()
-				sig, _ := v.engine.signer.SignHash(msg.hash)
+				sig, _ := v.engine.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), msg.Code.Value(), msg.hash)
 				msg.Signature = sig
 				votes[validator.New(msg.address)] = msg
 			}
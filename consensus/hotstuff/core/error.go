@@ -53,4 +53,12 @@ var (
 	errAddPrepareVote         = errors.New("add prepare vote error")
 	errAddPreCommitVote       = errors.New("add pre commit vote error")
 	errNilHighQC              = errors.New("highQC is nil")
+	// errFailedDecodeTimeout is returned when the TIMEOUT Message is malformed.
+	errFailedDecodeTimeout = errors.New("failed to decode TIMEOUT")
+	errInvalidTimeoutSeal  = errors.New("invalid timeout seal")
+	errInvalidTimeoutCert  = errors.New("invalid timeout cert")
+	errAddTimeout          = errors.New("add timeout vote error")
+	// errDoubleVote is returned when the validator try to vote for different node in the same view.
+	errDoubleVote      = errors.New("double vote")
+	errInvalidWALEntry = errors.New("invalid wal entry")
 )
@@ -30,6 +30,12 @@ import (
 func (c *core) Start(chain consensus.ChainReader) {
 	c.isRunning = true
 	c.current = nil
+	c.seqMu.Lock()
+	c.sequence = [2]uint64{}
+	c.seqMu.Unlock()
+	if err := c.wal.open(); err != nil {
+		c.logger.Warn("Open wal failed", "err", err)
+	}
 
 	c.wg.Add(1)
 	c.exit = make(chan struct{})
@@ -68,8 +74,9 @@ func (c *core) IsCurrentProposal(sealhash common.Hash) bool {
 }
 
 func (c *core) CurrentSequence() (uint64, uint64) {
-	view := c.currentView()
-	return view.HeightU64(), view.RoundU64()
+	c.seqMu.RLock()
+	defer c.seqMu.RUnlock()
+	return c.sequence[0], c.sequence[1]
 }
 
 func (c *core) handleEvents() {
@@ -163,6 +170,8 @@ func (c *core) handleCheckedMsg(msg *Message) (err error) {
 		err = c.handleCommitVote(msg)
 	case MsgTypeDecide:
 		err = c.handleDecide(msg)
+	case MsgTypeTimeout:
+		err = c.handleTimeout(msg)
 	default:
 		err = errInvalidMessage
 		c.logger.Error("msg type invalid", "unknown type", msg.Code)
@@ -178,6 +187,7 @@ func (c *core) handleNewRoundMsg(evt newRoundEvent) {
 	c.logger.Trace("handleTimeout", "state", c.currentState(), "view", c.currentView())
 	round := common.Big0
 	if !evt.Initial {
+		c.sendTimeout()
 		round = new(big.Int).Add(c.current.Round(), common.Big1)
 	}
 	c.startNewRound(round)
@@ -191,6 +201,12 @@ func (c *core) broadcast(code MsgType, payload []byte) {
 		return
 	}
 
+	// persist vote before it sent, and never vote for different node in the same view after restarted.
+	if err := c.writeVoteAhead(code, payload); err != nil {
+		logger.Warn("Failed to write vote ahead", "code", code, "err", err)
+		return
+	}
+
 	msg := NewCleanMessage(c.currentView(), code, payload)
 	payload, err := c.finalizeMessage(msg)
 	if err != nil {
@@ -224,7 +240,13 @@ func (c *core) finalizeMessage(msg *Message) ([]byte, error) {
 	// Add proof of consensus
 	node := c.current.Node()
 	if msg.Code == MsgTypeCommitVote && node != nil && node.Block != nil {
-		if seal, err = c.signer.SignHash(node.Block.SealHash()); err != nil {
+		if seal, err = c.signer.SignVote(node.Block.NumberU64(), msg.View.RoundU64(), hotstuff.SealCodeCommitted, node.Block.SealHash()); err != nil {
+			return nil, err
+		}
+		msg.CommittedSeal = seal
+	}
+	if msg.Code == MsgTypeTimeout {
+		if seal, err = c.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), hotstuff.SealCodeTimeout, timeoutSealHash(msg.View)); err != nil {
 			return nil, err
 		}
 		msg.CommittedSeal = seal
@@ -234,7 +256,7 @@ func (c *core) finalizeMessage(msg *Message) ([]byte, error) {
 	if _, err = msg.PayloadNoSig(); err != nil {
 		return nil, err
 	}
-	if sig, err = c.signer.SignHash(msg.hash); err != nil {
+	if sig, err = c.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), msg.Code.Value(), msg.hash); err != nil {
 		return nil, err
 	} else {
 		msg.Signature = sig
@@ -0,0 +1,246 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// pacemaker synchronize views between validators. every node broadcast an timeout vote when the round
+// timer expired, and the vote carries the highest timeout cert of the sender. nodes aggregate `Q` timeout
+// votes of the same view into an timeout cert, and lagging nodes jump to the next round of the highest
+// timeout cert directly instead of waiting for their own timers.
+
+// maxFutureTimeoutRounds limit the round distance of timeout votes collected in memory.
+const maxFutureTimeoutRounds = 64
+
+var (
+	viewChangeMeter  = metrics.NewRegisteredMeter("consensus/hotstuff/core/viewchange", nil)
+	timeoutMeter     = metrics.NewRegisteredMeter("consensus/hotstuff/core/timeout", nil)
+	timeoutCertMeter = metrics.NewRegisteredMeter("consensus/hotstuff/core/timeoutcert", nil)
+	viewSyncMeter    = metrics.NewRegisteredMeter("consensus/hotstuff/core/viewsync", nil)
+	roundGauge       = metrics.NewRegisteredGauge("consensus/hotstuff/core/round", nil)
+)
+
+type pacemaker struct {
+	height   uint64
+	timeouts map[uint64]*MessageSet // timeout votes collected by round in current height
+	highTC   *TimeoutCert           // the highest timeout cert in current height
+}
+
+func newPacemaker() *pacemaker {
+	return &pacemaker{
+		timeouts: make(map[uint64]*MessageSet),
+	}
+}
+
+// reset drop all timeout votes and certs if the height changed.
+func (p *pacemaker) reset(height uint64) {
+	if p.height == height {
+		return
+	}
+	p.height = height
+	p.timeouts = make(map[uint64]*MessageSet)
+	p.highTC = nil
+}
+
+// sendTimeout validator broadcast timeout vote of current view with the highest timeout cert, formula as follow:
+// 	MSG(timeout, _, highTC)
+// the vote is signed in the field of `committedSeal` so that votes from different validators can be aggregated.
+func (c *core) sendTimeout() {
+	logger := c.newLogger()
+	code := MsgTypeTimeout
+
+	// forbid unConsensus nodes send timeout
+	if index, _ := c.valSet.GetByAddress(c.Address()); index < 0 {
+		return
+	}
+
+	highTC := c.pacemaker.highTC
+	if highTC == nil {
+		highTC = &TimeoutCert{View: c.currentView(), Seals: [][]byte{}}
+	}
+	payload, err := Encode(highTC)
+	if err != nil {
+		logger.Trace("Failed to encode", "msg", code, "err", err)
+		return
+	}
+
+	msg := NewCleanMessage(c.currentView(), code, payload)
+	raw, err := c.finalizeMessage(msg)
+	if err != nil {
+		logger.Error("Failed to finalize Message", "msg", msg, "err", err)
+		return
+	}
+	if err := c.backend.Gossip(c.valSet, raw); err != nil {
+		logger.Error("Failed to gossip Message", "msg", msg, "err", err)
+	}
+
+	// self vote should be collected before round changed.
+	msg.address = c.Address()
+	if _, err := c.addTimeout(msg); err != nil {
+		logger.Trace("Failed to add self timeout", "msg", code, "err", err)
+	}
+	timeoutMeter.Mark(1)
+	logger.Trace("sendTimeout", "msg", code, "highTC", highTC)
+}
+
+// handleTimeout collect timeout votes and jump to the next round of the highest valid timeout cert.
+// timeout messages are not checked by `checkView`, because that lagging nodes need them to catch up
+// the highest round in the same height.
+func (c *core) handleTimeout(data *Message) error {
+	var (
+		logger = c.newLogger()
+		code   = data.Code
+		src    = data.address
+		tc     *TimeoutCert
+	)
+
+	if data.View == nil || data.View.Height == nil || data.View.Round == nil {
+		return errInvalidMessage
+	}
+	if hdiff, _ := data.View.Sub(c.currentView()); hdiff < 0 {
+		return errOldMessage
+	} else if hdiff > 0 {
+		// block sync will catch up the height
+		return errFarAwayFutureMessage
+	}
+	if err := data.Decode(&tc); err != nil {
+		logger.Trace("Failed to decode", "msg", code, "src", src, "err", err)
+		return errFailedDecodeTimeout
+	}
+
+	// jump to the next round of remote timeout cert
+	if !tc.Empty() {
+		if err := c.verifyTimeoutCert(tc); err != nil {
+			logger.Trace("Failed to verify timeout cert", "msg", code, "src", src, "err", err)
+			return err
+		}
+		c.advanceByTimeoutCert(tc, true)
+	}
+
+	// collect timeout vote
+	if data.View.Round.Cmp(c.current.Round()) < 0 {
+		return nil
+	}
+	if data.View.RoundU64() > c.current.RoundU64()+maxFutureTimeoutRounds {
+		return errFarAwayFutureMessage
+	}
+	if signer, err := c.validateFn(data.View.HeightU64(), hotstuff.SealCodeTimeout, timeoutSealHash(data.View), data.CommittedSeal); err != nil {
+		logger.Trace("Failed to verify timeout seal", "msg", code, "src", src, "err", err)
+		return errInvalidTimeoutSeal
+	} else if signer != src {
+		return errInvalidTimeoutSeal
+	}
+	newTC, err := c.addTimeout(data)
+	if err != nil {
+		logger.Trace("Failed to add timeout", "msg", code, "src", src, "err", err)
+		return errAddTimeout
+	}
+
+	logger.Trace("handleTimeout", "msg", code, "src", src, "round", data.View.Round)
+
+	if newTC != nil {
+		c.advanceByTimeoutCert(newTC, false)
+	}
+	return nil
+}
+
+// addTimeout add timeout vote into message set, and assemble timeout cert if the size of votes reached quorum.
+func (c *core) addTimeout(data *Message) (*TimeoutCert, error) {
+	c.pacemaker.reset(c.current.HeightU64())
+
+	round := data.View.RoundU64()
+	set, ok := c.pacemaker.timeouts[round]
+	if !ok {
+		set = NewMessageSet(c.valSet)
+		c.pacemaker.timeouts[round] = set
+	}
+	if set.Get(data.address) != nil {
+		return nil, nil
+	}
+	if err := set.Add(data); err != nil {
+		return nil, err
+	}
+	if set.Size() != c.Q() {
+		return nil, nil
+	}
+
+	tc := &TimeoutCert{
+		View: &View{
+			Height: new(big.Int).Set(data.View.Height),
+			Round:  new(big.Int).Set(data.View.Round),
+		},
+		Seals: make([][]byte, 0, set.Size()),
+	}
+	for _, msg := range set.Values() {
+		tc.Seals = append(tc.Seals, msg.CommittedSeal)
+	}
+	timeoutCertMeter.Mark(1)
+	return tc, nil
+}
+
+// verifyTimeoutCert check that the timeout cert signed by `Q` validators in current height.
+func (c *core) verifyTimeoutCert(tc *TimeoutCert) error {
+	if tc.Empty() || tc.View.Height == nil || tc.View.Round == nil {
+		return errInvalidTimeoutCert
+	}
+	if tc.View.Height.Cmp(c.current.Height()) != 0 {
+		return errInvalidTimeoutCert
+	}
+
+	var (
+		hash    = tc.SealHash()
+		signers = make(map[common.Address]struct{})
+	)
+	for _, seal := range tc.Seals {
+		signer, err := c.validateFn(tc.View.HeightU64(), hotstuff.SealCodeTimeout, hash, seal)
+		if err != nil {
+			return errInvalidTimeoutCert
+		}
+		signers[signer] = struct{}{}
+	}
+	if len(signers) < c.Q() {
+		return errInvalidTimeoutCert
+	}
+	return nil
+}
+
+// advanceByTimeoutCert keep the highest timeout cert and start the next round of the cert's view
+// if current round is not greater than it.
+func (c *core) advanceByTimeoutCert(tc *TimeoutCert, remote bool) {
+	c.pacemaker.reset(c.current.HeightU64())
+	if highTC := c.pacemaker.highTC; highTC == nil || highTC.View.Cmp(tc.View) < 0 {
+		c.pacemaker.highTC = tc
+	}
+
+	next := new(big.Int).Add(tc.View.Round, common.Big1)
+	if next.Cmp(c.current.Round()) <= 0 {
+		return
+	}
+	if remote {
+		viewSyncMeter.Mark(1)
+	}
+	c.logger.Debug("Jump to next round by timeout cert", "view", c.currentView(), "tc", tc, "remote", remote)
+	c.startNewRound(next)
+}
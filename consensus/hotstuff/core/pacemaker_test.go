@@ -0,0 +1,110 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestRoundTimeout
+func TestRoundTimeout(t *testing.T) {
+	config := &hotstuff.Config{
+		RequestTimeout:    4000,
+		BackoffBase:       1000,
+		MaxBackoffTimeout: 30000,
+	}
+
+	assert.Equal(t, 4*time.Second, roundTimeout(config, 0))
+	assert.Equal(t, 6*time.Second, roundTimeout(config, 1))
+	assert.Equal(t, 12*time.Second, roundTimeout(config, 3))
+	assert.Equal(t, 34*time.Second, roundTimeout(config, 5))
+	assert.Equal(t, 34*time.Second, roundTimeout(config, 64))
+	assert.Equal(t, 34*time.Second, roundTimeout(config, 1<<40))
+
+	// use default backoff parameters
+	config = &hotstuff.Config{RequestTimeout: 4000}
+	assert.Equal(t, 6*time.Second, roundTimeout(config, 1))
+	assert.Equal(t, 64*time.Second, roundTimeout(config, 100))
+}
+
+func makeTimeoutMessage(t *testing.T, key *ecdsa.PrivateKey, view *View, tc *TimeoutCert) *Message {
+	if tc == nil {
+		tc = &TimeoutCert{View: view, Seals: [][]byte{}}
+	}
+	payload, err := Encode(tc)
+	assert.NoError(t, err)
+	seal, err := signer.NewSigner(key, new(big.Int)).SignVote(view.HeightU64(), view.RoundU64(), hotstuff.SealCodeTimeout, timeoutSealHash(view))
+	assert.NoError(t, err)
+	msg := NewCleanMessage(view, MsgTypeTimeout, payload)
+	msg.CommittedSeal = seal
+	msg.address = crypto.PubkeyToAddress(key.PublicKey)
+	return msg
+}
+
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestHandleTimeout
+func TestHandleTimeout(t *testing.T) {
+	N, H, R := 4, 5, 2
+	vals, keys := newTestValidatorSet(N)
+	newCore := func(round int64) *core {
+		c := New(&testSystemBackend{}, hotstuff.DefaultBasicConfig, signer.NewSigner(keys[0], new(big.Int)), nil, nil)
+		c.valSet = vals
+		c.current = newRoundState(nil, nil, vals, nil, makeView(H, int(round)))
+		return c
+	}
+	view := makeView(H, R)
+
+	c := newCore(int64(R))
+	for i := 1; i < c.Q(); i++ {
+		assert.NoError(t, c.handleTimeout(makeTimeoutMessage(t, keys[i], view, nil)))
+		assert.Nil(t, c.pacemaker.highTC)
+	}
+
+	// invalid seal signed by another validator
+	invalid := makeTimeoutMessage(t, keys[c.Q()], view, nil)
+	invalid.address = crypto.PubkeyToAddress(keys[1].PublicKey)
+	assert.Equal(t, errInvalidTimeoutSeal, c.handleTimeout(invalid))
+
+	// quorum reached
+	assert.NoError(t, c.handleTimeout(makeTimeoutMessage(t, keys[c.Q()], view, nil)))
+	tc := c.pacemaker.highTC
+	assert.NotNil(t, tc)
+	assert.Equal(t, 0, tc.View.Cmp(view))
+	assert.Equal(t, c.Q(), len(tc.Seals))
+	assert.NoError(t, c.verifyTimeoutCert(tc))
+
+	// lagging node keep the remote timeout cert
+	lagging := newCore(0)
+	assert.NoError(t, lagging.handleTimeout(makeTimeoutMessage(t, keys[1], makeView(H, R+1), tc)))
+	assert.Equal(t, 0, lagging.pacemaker.highTC.View.Cmp(view))
+
+	// timeout cert without enough seals
+	broken := &TimeoutCert{View: view, Seals: tc.Seals[:1]}
+	assert.Equal(t, errInvalidTimeoutCert, lagging.verifyTimeoutCert(broken))
+
+	// old height
+	assert.Equal(t, errOldMessage, c.handleTimeout(makeTimeoutMessage(t, keys[1], makeView(H-1, R), nil)))
+}
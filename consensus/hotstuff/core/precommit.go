@@ -147,6 +147,9 @@ func (c *core) acceptPrepareQC(prepareQC *QuorumCert) error {
 	if err := c.current.SetNode(c.current.Node()); err != nil {
 		return err
 	}
+	if err := c.wal.writeQC(prepareQC); err != nil {
+		return err
+	}
 	if err := c.current.SetPrepareQC(prepareQC); err != nil {
 		return err
 	}
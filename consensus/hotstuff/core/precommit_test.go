@@ -60,7 +60,7 @@ func TestHandlePrepareVote(t *testing.T) {
 				msg.address = core.Address()
 				val := validator.New(msg.address)
 				msg.PayloadNoSig()
-				sig, _ := v.engine.signer.SignHash(msg.hash)
+				sig, _ := v.engine.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), msg.Code.Value(), msg.hash)
 				msg.Signature = sig
 				votes[val] = msg
 			}
@@ -109,7 +109,7 @@ func TestHandlePreCommit(t *testing.T) {
 			View:    coreView,
 		}
 		msg.PayloadNoSig()
-		sig, _ := leader.signer.SignHash(msg.hash)
+		sig, _ := leader.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), msg.Code.Value(), msg.hash)
 		msg.Signature = sig
 		return sub, msg
 	}
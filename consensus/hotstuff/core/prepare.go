@@ -68,7 +68,7 @@ func (c *core) sendPrepare() {
 	// todo(fuk): waiting in `startNewRound`
 	if block.Time() > uint64(time.Now().Unix()) {
 		delay := time.Unix(int64(block.Time()), 0).Sub(time.Now())
-		time.Sleep(delay)
+		c.clock.Sleep(delay)
 		logger.Trace("delay to broadcast proposal", "msg", code, "time", delay.Milliseconds())
 	}
 
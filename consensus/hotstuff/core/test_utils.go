@@ -274,7 +274,7 @@ func NewTestSystemWithBackend(n, h, r int) *testSystem {
 		backend.peers = vset
 		backend.address = vset.GetByIndex(uint64(i)).Address()
 
-		core := New(backend, config, signer.NewSigner(keys[i]), nil, nil)
+		core := New(backend, config, signer.NewSigner(keys[i], common.Big0), nil, nil)
 		core.current = newRoundState(nil, nil, vset, nil, makeView(h, r))
 		core.valSet = vset
 		core.logger = testLogger
@@ -373,7 +373,10 @@ type testSigner struct {
 func (ts *testSigner) Address() common.Address                         { return ts.address }
 func (ts *testSigner) Sign(data []byte) ([]byte, error)                { return common.EmptyHash.Bytes(), nil }
 func (ts *testSigner) SigHash(header *types.Header) (hash common.Hash) { return common.EmptyHash }
-func (ts *testSigner) SignHash(hash common.Hash) ([]byte, error) {
+func (ts *testSigner) SignVote(height, round, code uint64, hash common.Hash) ([]byte, error) {
+	return common.EmptyHash.Bytes(), nil
+}
+func (ts *testSigner) SignHandshake(hash common.Hash) ([]byte, error) {
 	return common.EmptyHash.Bytes(), nil
 }
 func (ts *testSigner) SignTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
@@ -390,13 +393,13 @@ func (ts *testSigner) VerifyHeader(header *types.Header, valSet hotstuff.Validat
 func (ts *testSigner) VerifyQC(qc hotstuff.QC, valSet hotstuff.ValidatorSet, epoch bool) error {
 	return nil
 }
-func (ts *testSigner) CheckSignature(valSet hotstuff.ValidatorSet, data []byte, signature []byte) (common.Address, error) {
+func (ts *testSigner) CheckSignature(valSet hotstuff.ValidatorSet, height, code uint64, hash common.Hash, signature []byte) (common.Address, error) {
 	return common.EmptyAddress, nil
 }
 func (ts *testSigner) VerifyHash(valSet hotstuff.ValidatorSet, hash common.Hash, sig []byte) error {
 	return nil
 }
-func (ts *testSigner) VerifyCommittedSeal(valSet hotstuff.ValidatorSet, hash common.Hash, committedSeals [][]byte) error {
+func (ts *testSigner) VerifyCommittedSeal(valSet hotstuff.ValidatorSet, height uint64, hash common.Hash, committedSeals [][]byte) error {
 	return nil
 }
 
@@ -422,8 +425,10 @@ func singerTestCore(t *testing.T, n int, height, round int64) (*core, hotstuff.V
 			Height: big.NewInt(height),
 			Round:  big.NewInt(round),
 		}),
-		signer:   signer.NewSigner(keys[0]),
-		backlogs: newBackLog(),
+		signer:    signer.NewSigner(keys[0], common.Big0),
+		backlogs:  newBackLog(),
+		pacemaker: newPacemaker(),
+		wal:       newWAL(nil),
 	}
 
 	return c, vals
@@ -466,11 +471,11 @@ func newTestQCWithExtra(t *testing.T, s *testSystem, node common.Hash, code MsgT
 		proposer: leader.Address(),
 	}
 	sealhash := qc.SealHash()
-	seal, _ := leader.signer.SignHash(sealhash)
+	seal, _ := leader.signer.SignVote(uint64(h), uint64(r), code.Value(), sealhash)
 	qc.seal = seal
 	committedSeal := make([][]byte, N-1)
 	for i, v := range s.getRepos() {
-		sig, err := v.signer.SignHash(sealhash)
+		sig, err := v.signer.SignVote(uint64(h), uint64(r), code.Value(), sealhash)
 		if err != nil {
 			t.Errorf("sign block hash failed, err: %v", err)
 		}
@@ -19,32 +19,56 @@
 package core
 
 import (
-	"math"
 	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 )
 
 // we use timeout in every view to ensure consensus liveness.  and the view timeout
 // calculating format as follow:
-// *	t = requestTimeout + 2^round
-// the round started from 0.
+// *	t = requestTimeout + min(backoffBase * 2^round, maxBackoffTimeout)
+// the round started from 0, and there is no backoff in round 0.
 //
-// the waiting time in every round is greater than the last one, so that all nodes can catch up
-// the same round.
+// the waiting time in every round is greater than the last one until the backoff reach the
+// upper bound, so that all nodes can catch up the same round without waiting hours after a
+// few dozen failed rounds.
+
+// maxBackoffExponent prevent the left shift of backoff base from overflowing.
+const maxBackoffExponent = 32
 
 func (c *core) newRoundChangeTimer() {
 	c.stopTimer()
 
-	// set timeout based on the round number
-	timeout := time.Duration(c.config.RequestTimeout) * time.Millisecond
-	round := c.current.Round().Uint64()
-	if round > 0 {
-		timeout += time.Duration(math.Pow(2, float64(round))) * time.Second
-	}
-	c.roundChangeTimer = time.AfterFunc(timeout, func() {
+	timeout := roundTimeout(c.config, c.current.Round().Uint64())
+	c.roundChangeTimer = c.clock.AfterFunc(timeout, func() {
 		c.newRoundFeed.Send(newRoundEvent{})
 	})
 }
 
+// roundTimeout calculate the timeout duration of the given round with capped exponential backoff.
+func roundTimeout(config *hotstuff.Config, round uint64) time.Duration {
+	timeout := time.Duration(config.RequestTimeout) * time.Millisecond
+	if round == 0 {
+		return timeout
+	}
+
+	base, limit := config.BackoffBase, config.MaxBackoffTimeout
+	if base == 0 {
+		base = hotstuff.DefaultBackoffBase
+	}
+	if limit == 0 {
+		limit = hotstuff.DefaultMaxBackoffTimeout
+	}
+
+	backoff := limit
+	if round < maxBackoffExponent {
+		if exp := base << round; exp>>round == base && exp < limit {
+			backoff = exp
+		}
+	}
+	return timeout + time.Duration(backoff)*time.Millisecond
+}
+
 func (c *core) stopTimer() {
 	if c.roundChangeTimer != nil {
 		c.roundChangeTimer.Stop()
@@ -43,6 +43,7 @@ const (
 	MsgTypeCommit        MsgType = 6
 	MsgTypeCommitVote    MsgType = 7
 	MsgTypeDecide        MsgType = 8
+	MsgTypeTimeout       MsgType = 9
 )
 
 func (m MsgType) String() string {
@@ -63,6 +64,8 @@ func (m MsgType) String() string {
 		return "CommitVote"
 	case MsgTypeDecide:
 		return "Decide"
+	case MsgTypeTimeout:
+		return "Timeout"
 	default:
 		return "Unknown"
 	}
@@ -269,6 +272,11 @@ func (qc *QuorumCert) SealHash() common.Hash {
 	return msg.hash
 }
 
+// Code retrieve the message type of votes which assembled the qc.
+func (qc *QuorumCert) Code() uint64 {
+	return qc.code.Value()
+}
+
 func (qc *QuorumCert) NodeHash() common.Hash {
 	return qc.node
 }
@@ -301,6 +309,36 @@ func (qc *QuorumCert) Copy() *QuorumCert {
 	return newQC
 }
 
+// TimeoutCert aggregated from at least `Q` timeout votes of the same view, nodes which received
+// an valid timeout cert can jump to the next round of the cert's view directly.
+type TimeoutCert struct {
+	View  *View
+	Seals [][]byte
+}
+
+// SealHash retrieve the hash which signed by validators in timeout vote.
+func (tc *TimeoutCert) SealHash() common.Hash {
+	return timeoutSealHash(tc.View)
+}
+
+// Empty returns true if the cert not assembled yet.
+func (tc *TimeoutCert) Empty() bool {
+	return tc == nil || tc.View == nil || len(tc.Seals) == 0
+}
+
+func (tc *TimeoutCert) String() string {
+	if tc.Empty() {
+		return "{TimeoutCert: empty}"
+	}
+	return fmt.Sprintf("{TimeoutCert View: %v, Seals: %d}", tc.View, len(tc.Seals))
+}
+
+func timeoutSealHash(view *View) common.Hash {
+	msg := NewCleanMessage(view, MsgTypeTimeout, []byte{})
+	msg.PayloadNoSig()
+	return msg.hash
+}
+
 type Subject struct {
 	Node *Node
 	QC   *QuorumCert
@@ -373,7 +411,7 @@ func (m *Message) DecodeRLP(s *rlp.Stream) error {
 //
 // define the functions that needs to be provided for core.
 
-func (m *Message) FromPayload(src common.Address, payload []byte, validateFn func(common.Hash, []byte) (common.Address, error)) error {
+func (m *Message) FromPayload(src common.Address, payload []byte, validateFn func(uint64, uint64, common.Hash, []byte) (common.Address, error)) error {
 	// Decode Message
 	if err := rlp.DecodeBytes(payload, &m); err != nil {
 		return err
@@ -389,7 +427,7 @@ func (m *Message) FromPayload(src common.Address, payload []byte, validateFn fun
 		return err
 	}
 	if validateFn != nil {
-		signer, err := validateFn(m.hash, m.Signature)
+		signer, err := validateFn(m.View.HeightU64(), m.Code.Value(), m.hash, m.Signature)
 		if err != nil {
 			return err
 		}
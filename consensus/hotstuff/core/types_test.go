@@ -28,7 +28,9 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 )
@@ -45,8 +47,8 @@ func TestMessage(t *testing.T) {
 		msg        = []byte{'a', 'b'}
 		key, _     = crypto.GenerateKey()
 		signer     = crypto.PubkeyToAddress(key.PublicKey)
-		validateFn = func(hash common.Hash, sig []byte) (addresses common.Address, e error) {
-			pubkey, err := crypto.SigToPub(hash.Bytes(), sig)
+		validateFn = func(height, code uint64, hash common.Hash, sig []byte) (addresses common.Address, e error) {
+			pubkey, err := crypto.SigToPub(accounts.HotstuffVoteHash(height, code, hash), sig)
 			if err != nil {
 				return common.Address{}, err
 			}
@@ -67,7 +69,7 @@ func TestMessage(t *testing.T) {
 	{
 		t.Log("-----test message with signature only-----")
 		// generate signer and sign message
-		sig, _ := crypto.Sign(expect.hash.Bytes(), key)
+		sig, _ := crypto.Sign(accounts.HotstuffVoteHash(view.HeightU64(), code.Value(), expect.hash), key)
 		expect.Signature = sig
 		payload, err := expect.Payload()
 		assert.NoError(t, err)
@@ -82,9 +84,9 @@ func TestMessage(t *testing.T) {
 	{
 		t.Log("-----test message with committed seal-----")
 		proposalHash := common.HexToHash("0xab12ba3")
-		sig, _ := crypto.Sign(expect.hash.Bytes(), key)
+		sig, _ := crypto.Sign(accounts.HotstuffVoteHash(view.HeightU64(), code.Value(), expect.hash), key)
 		t.Logf("%s sign msg %s", signer.Hex(), expect.hash.Hex())
-		seal, _ := crypto.Sign(proposalHash.Bytes(), key)
+		seal, _ := crypto.Sign(accounts.HotstuffVoteHash(view.HeightU64(), hotstuff.SealCodeCommitted, proposalHash), key)
 		t.Logf("%s sign proposal %s", signer.Hex(), proposalHash.Hex())
 		expect.Signature = sig
 		expect.CommittedSeal = seal
@@ -97,7 +99,7 @@ func TestMessage(t *testing.T) {
 		got := new(Message)
 		err = got.FromPayload(signer, payload, validateFn)
 		assert.NoError(t, err)
-		proposer, err := validateFn(proposalHash, got.CommittedSeal)
+		proposer, err := validateFn(view.HeightU64(), hotstuff.SealCodeCommitted, proposalHash, got.CommittedSeal)
 		assert.NoError(t, err)
 		assert.Equal(t, signer, proposer)
 	}
@@ -283,7 +285,7 @@ func TestQuorumCertWithSig(t *testing.T) {
 	// 2. Decode test
 	// 2.1 Test normal validate func
 	decodedMsg := new(Message)
-	if err := decodedMsg.FromPayload(address, msgPayload, func(data common.Hash, sig []byte) (common.Address, error) {
+	if err := decodedMsg.FromPayload(address, msgPayload, func(height, code uint64, data common.Hash, sig []byte) (common.Address, error) {
 		return address, nil
 	}); err != nil {
 		t.Errorf("error mismatch: have %v, want nil", err)
@@ -304,7 +306,7 @@ func TestQuorumCertWithSig(t *testing.T) {
 
 	// 2.3 Test failed validate func
 	decodedMsg = new(Message)
-	if err := decodedMsg.FromPayload(address, msgPayload, func(data common.Hash, sig []byte) (common.Address, error) {
+	if err := decodedMsg.FromPayload(address, msgPayload, func(height, code uint64, data common.Hash, sig []byte) (common.Address, error) {
 		return common.Address{}, errInvalidSigner
 	}); err != errInvalidSigner {
 		t.Errorf("error mismatch: have %v, want %v", err, errInvalidSigner)
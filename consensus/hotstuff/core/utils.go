@@ -235,7 +235,7 @@ func (c *core) messages2qc(code MsgType) (*QuorumCert, error) {
 
 	// proposer self vote should be add in message set first.
 	if qc.seal == nil {
-		if sig, err := c.signer.SignHash(sealHash); err != nil {
+		if sig, err := c.signer.SignVote(view.HeightU64(), view.RoundU64(), code.Value(), sealHash); err != nil {
 			return nil, err
 		} else {
 			qc.seal = sig
@@ -0,0 +1,355 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// -----------------------------------------------------------------------
+//
+// write ahead log of round state
+//
+// -----------------------------------------------------------------------
+
+const walSuffix = "wal-"
+
+type walEntryType uint8
+
+const (
+	walEntryVote walEntryType = iota + 1 // outgoing vote, hash is the voted node
+	walEntryLock                         // lockQC and locked node
+	walEntryQC                           // received prepareQC or commitQC
+)
+
+func (t walEntryType) String() string {
+	switch t {
+	case walEntryVote:
+		return "vote"
+	case walEntryLock:
+		return "lock"
+	case walEntryQC:
+		return "qc"
+	default:
+		return "unknown"
+	}
+}
+
+// walEntry is the record persisted in wal before the action is performed. the field of `Data` contains
+// the encoded qc for `qc` entry, and the encoded qc and node for `lock` entry.
+type walEntry struct {
+	Type walEntryType
+	Code MsgType
+	View *View
+	Hash common.Hash
+	Data []byte
+}
+
+func (e *walEntry) String() string {
+	return fmt.Sprintf("{Type: %v, Code: %v, View: %v, Hash: %v}", e.Type, e.Code, e.View, e.Hash.Hex())
+}
+
+// walLock is the payload of `lock` entry.
+type walLock struct {
+	QC   *QuorumCert
+	Node *Node
+}
+
+type walVoteKey struct {
+	height uint64
+	round  uint64
+	code   MsgType
+}
+
+// wal records every outgoing vote, lock and received qc of current height before it is acted on, so that
+// a restarted validator can recover the lock state and never vote for different nodes in the same view.
+// entries are stored in sequence with keys of `round-state-wal-{seq}`, and the entries of lower heights
+// are truncated after the chain grows.
+type wal struct {
+	db ethdb.Database
+	mu sync.Mutex
+
+	seq     uint64
+	keys    map[uint64][]byte // entry key with sequence
+	entries map[uint64]*walEntry
+	votes   map[walVoteKey]common.Hash
+}
+
+func newWAL(db ethdb.Database) *wal {
+	return &wal{
+		db:      db,
+		keys:    make(map[uint64][]byte),
+		entries: make(map[uint64]*walEntry),
+		votes:   make(map[walVoteKey]common.Hash),
+	}
+}
+
+// open load all entries in database, it should be called before the engine started.
+func (w *wal) open() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.seq = 0
+	w.keys = make(map[uint64][]byte)
+	w.entries = make(map[uint64]*walEntry)
+	w.votes = make(map[walVoteKey]common.Hash)
+	if w.db == nil {
+		return nil
+	}
+
+	prefix := walKeyPrefix()
+	it := w.db.NewIterator(prefix, nil)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+8 {
+			continue
+		}
+		seq := binary.BigEndian.Uint64(key[len(prefix):])
+		entry := new(walEntry)
+		if err := rlp.DecodeBytes(it.Value(), entry); err != nil {
+			return fmt.Errorf("decode wal entry %d failed: %v", seq, err)
+		}
+		w.track(seq, entry)
+		if seq >= w.seq {
+			w.seq = seq + 1
+		}
+	}
+	return it.Error()
+}
+
+// write persist entry, the caller should NOT act on it if the error is not nil.
+func (w *wal) write(entry *walEntry) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.write0(entry)
+}
+
+func (w *wal) write0(entry *walEntry) error {
+	if entry == nil || entry.View == nil || entry.View.Height == nil || entry.View.Round == nil {
+		return errInvalidWALEntry
+	}
+	if w.db != nil {
+		raw, err := rlp.EncodeToBytes(entry)
+		if err != nil {
+			return err
+		}
+		if err := w.db.Put(walKey(w.seq), raw); err != nil {
+			return err
+		}
+	}
+	w.track(w.seq, entry)
+	w.seq += 1
+	return nil
+}
+
+func (w *wal) track(seq uint64, entry *walEntry) {
+	w.keys[seq] = walKey(seq)
+	w.entries[seq] = entry
+	if entry.Type == walEntryVote {
+		w.votes[walVoteKey{entry.View.HeightU64(), entry.View.RoundU64(), entry.Code}] = entry.Hash
+	}
+}
+
+// writeVote persist outgoing vote, and refuse to vote for different node in the same view.
+func (w *wal) writeVote(code MsgType, view *View, vote common.Hash) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	key := walVoteKey{view.HeightU64(), view.RoundU64(), code}
+	if last, ok := w.votes[key]; ok {
+		if last != vote {
+			return fmt.Errorf("%w, view %v, code %v, voted %v, got %v", errDoubleVote, view, code, last.Hex(), vote.Hex())
+		}
+		return nil
+	}
+	return w.write0(&walEntry{Type: walEntryVote, Code: code, View: view, Hash: vote})
+}
+
+func (w *wal) writeLock(qc *QuorumCert, node *Node) error {
+	if qc == nil || node == nil {
+		return errInvalidWALEntry
+	}
+	raw, err := rlp.EncodeToBytes(&walLock{QC: qc, Node: node})
+	if err != nil {
+		return err
+	}
+	return w.write(&walEntry{Type: walEntryLock, Code: qc.code, View: qc.view, Hash: qc.node, Data: raw})
+}
+
+func (w *wal) writeQC(qc *QuorumCert) error {
+	if qc == nil {
+		return errInvalidWALEntry
+	}
+	raw, err := Encode(qc)
+	if err != nil {
+		return err
+	}
+	return w.write(&walEntry{Type: walEntryQC, Code: qc.code, View: qc.view, Hash: qc.node, Data: raw})
+}
+
+// Vote returns the voted node in the view, and false if the validator never voted it.
+func (w *wal) vote(code MsgType, view *View) (common.Hash, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	hash, ok := w.votes[walVoteKey{view.HeightU64(), view.RoundU64(), code}]
+	return hash, ok
+}
+
+// replay returns entries of the given height in writing sequence.
+func (w *wal) replay(height uint64) []*walEntry {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	list := make([]*walEntry, 0)
+	for seq := uint64(0); seq < w.seq; seq++ {
+		if entry, ok := w.entries[seq]; ok && entry.View.HeightU64() == height {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
+// truncate delete entries lower than the given height.
+func (w *wal) truncate(height uint64) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var batch ethdb.Batch
+	if w.db != nil {
+		batch = w.db.NewBatch()
+	}
+	for seq, entry := range w.entries {
+		if entry.View.HeightU64() >= height {
+			continue
+		}
+		if batch != nil {
+			if err := batch.Delete(w.keys[seq]); err != nil {
+				return err
+			}
+		}
+		delete(w.entries, seq)
+		delete(w.keys, seq)
+	}
+	for key := range w.votes {
+		if key.height < height {
+			delete(w.votes, key)
+		}
+	}
+	if batch != nil {
+		return batch.Write()
+	}
+	return nil
+}
+
+// size returns the number of entries in wal.
+func (w *wal) size() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.entries)
+}
+
+func walKeyPrefix() []byte {
+	return append([]byte(dbRoundStatePrefix), []byte(walSuffix)...)
+}
+
+func walKey(seq uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, seq)
+	return append(walKeyPrefix(), enc...)
+}
+
+// -----------------------------------------------------------------------
+//
+// replay wal on round state
+//
+// -----------------------------------------------------------------------
+
+// writeVoteAhead persist the vote before it sent, and returns error if the validator has voted for a different
+// node in the same view.
+func (c *core) writeVoteAhead(code MsgType, payload []byte) error {
+	switch code {
+	case MsgTypePrepareVote, MsgTypePreCommitVote, MsgTypeCommitVote:
+		return c.wal.writeVote(code, c.currentView(), common.BytesToHash(payload))
+	default:
+		return nil
+	}
+}
+
+// replayWAL recover lockQC, locked node and received qc of current height after round state reloaded from
+// database. the entries were written before the actions performed, so that wal may be ahead of snapshot.
+func (c *core) replayWAL() {
+	if c.current == nil {
+		return
+	}
+
+	var (
+		logger = c.newLogger()
+		s      = c.current
+	)
+	for _, entry := range c.wal.replay(s.HeightU64()) {
+		switch entry.Type {
+		case walEntryLock:
+			data := new(walLock)
+			if err := rlp.DecodeBytes(entry.Data, data); err != nil {
+				logger.Warn("Failed to decode wal lock", "entry", entry, "err", err)
+				continue
+			}
+			if data.QC == nil || data.Node == nil || data.Node.Block == nil || data.Node.Hash() != data.QC.node {
+				logger.Warn("Invalid wal lock", "entry", entry)
+				continue
+			}
+			if s.lockQC != nil && s.lockQC.view.Cmp(data.QC.view) > 0 {
+				continue
+			}
+			s.lockQC = data.QC
+			s.node.node = data.Node
+			s.lockedBlock = data.Node.Block
+			s.proposalLocked = true
+
+		case walEntryQC:
+			qc := new(QuorumCert)
+			if err := rlp.DecodeBytes(entry.Data, qc); err != nil {
+				logger.Warn("Failed to decode wal qc", "entry", entry, "err", err)
+				continue
+			}
+			switch qc.code {
+			case MsgTypePrepareVote:
+				if s.prepareQC == nil || s.prepareQC.view.Cmp(qc.view) <= 0 {
+					s.prepareQC = qc
+				}
+			case MsgTypeCommitVote:
+				if s.committedQC == nil || s.committedQC.view.Cmp(qc.view) <= 0 {
+					s.committedQC = qc
+				}
+			}
+		}
+		logger.Trace("Replay wal", "entry", entry)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestWALDoubleVote
+func TestWALDoubleVote(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	view := makeView(5, 1)
+	vote1 := common.HexToHash("0x01")
+	vote2 := common.HexToHash("0x02")
+
+	w := newWAL(db)
+	assert.NoError(t, w.open())
+	assert.NoError(t, w.writeVote(MsgTypePrepareVote, view, vote1))
+	assert.NoError(t, w.writeVote(MsgTypePrepareVote, view, vote1))
+	assert.True(t, errors.Is(w.writeVote(MsgTypePrepareVote, view, vote2), errDoubleVote))
+	assert.NoError(t, w.writeVote(MsgTypePrepareVote, makeView(5, 2), vote2))
+	assert.NoError(t, w.writeVote(MsgTypePreCommitVote, view, vote1))
+	assert.Equal(t, 3, w.size())
+
+	// validator restarted
+	w = newWAL(db)
+	assert.NoError(t, w.open())
+	assert.Equal(t, 3, w.size())
+	assert.True(t, errors.Is(w.writeVote(MsgTypePrepareVote, view, vote2), errDoubleVote))
+	hash, ok := w.vote(MsgTypePreCommitVote, view)
+	assert.True(t, ok)
+	assert.Equal(t, vote1, hash)
+
+	// entries lower than height 6 should be deleted
+	assert.NoError(t, w.writeVote(MsgTypePrepareVote, makeView(6, 0), vote1))
+	assert.NoError(t, w.truncate(6))
+	assert.Equal(t, 1, w.size())
+	assert.NoError(t, w.writeVote(MsgTypePrepareVote, view, vote2))
+
+	w = newWAL(db)
+	assert.NoError(t, w.open())
+	assert.Equal(t, 2, w.size())
+	assert.Len(t, w.replay(6), 1)
+}
+
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestWALReplay
+func TestWALReplay(t *testing.T) {
+	H, R := 5, 1
+	db := rawdb.NewMemoryDatabase()
+	c, _ := singerTestCore(t, 4, int64(H), int64(R))
+
+	node := NewNode(common.HexToHash("0x1234"), makeBlock(H))
+	prepareQC := newTestQCWithoutExtra(c, H, R)
+	prepareQC.code = MsgTypePrepareVote
+	prepareQC.node = node.Hash()
+	lockQC := prepareQC.Copy()
+	lockQC.code = MsgTypePreCommitVote
+
+	w := newWAL(db)
+	assert.NoError(t, w.open())
+	assert.NoError(t, w.writeQC(prepareQC))
+	assert.NoError(t, w.writeLock(lockQC, node))
+	assert.NoError(t, w.writeQC(newTestQCWithoutExtra(c, H-1, 0)))
+
+	// replay on the round state of restarted validator
+	c.wal = newWAL(db)
+	assert.NoError(t, c.wal.open())
+	assert.Len(t, c.wal.replay(uint64(H)), 2)
+	c.replayWAL()
+
+	assert.Equal(t, prepareQC.node, c.current.PrepareQC().node)
+	assert.Equal(t, lockQC.node, c.current.LockQC().node)
+	assert.Equal(t, MsgTypePreCommitVote, c.current.LockQC().code)
+	assert.NotNil(t, c.current.LockedBlock())
+	assert.Equal(t, node.Block.SealHash(), c.current.LockedBlock().SealHash())
+	assert.Equal(t, node.Hash(), c.current.Vote())
+}
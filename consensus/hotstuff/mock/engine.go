@@ -33,7 +33,10 @@ type Engine consensus.Engine
 
 // backend is engine but also hotstuff engine and consensus handler.
 func makeEngine(privateKey *ecdsa.PrivateKey, db ethdb.Database) Engine {
-	config := hotstuff.DefaultBasicConfig
+	return makeEngineWithConfig(privateKey, db, hotstuff.DefaultBasicConfig)
+}
+
+func makeEngineWithConfig(privateKey *ecdsa.PrivateKey, db ethdb.Database, config *hotstuff.Config) Engine {
 	chainConfig := &params.ChainConfig{
 		ChainID:             big.NewInt(60801),
 		HomesteadBlock:      big.NewInt(0),
@@ -46,7 +49,7 @@ func makeEngine(privateKey *ecdsa.PrivateKey, db ethdb.Database) Engine {
 		IstanbulBlock:       big.NewInt(0),
 		BerlinBlock:         big.NewInt(0),
 		LondonBlock:         big.NewInt(0),
-		HotStuff:            &params.HotStuffConfig{Protocol: "basic"},
+		HotStuff:            &params.HotStuffConfig{Protocol: "basic", VoteBlock: big.NewInt(0)},
 	}
 	engine := backend.New(chainConfig, config, privateKey, db, true)
 	broadcaster := makeBroadcaster(engine.Address(), engine)
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core"
@@ -37,6 +38,7 @@ type miner struct {
 	chain  *core.BlockChain
 	engine consensus.HotStuff
 	geth   *Geth
+	clock  mclock.Clock
 
 	current *environment
 
@@ -68,11 +70,12 @@ type task struct {
 	block    *types.Block
 }
 
-func makeMiner(address common.Address, chain *core.BlockChain, engine consensus.HotStuff) *miner {
+func makeMiner(address common.Address, chain *core.BlockChain, engine consensus.HotStuff, clock mclock.Clock) *miner {
 	miner := &miner{
 		addr:         address,
 		chain:        chain,
 		engine:       engine,
+		clock:        clock,
 		headCh:       make(chan core.ChainHeadEvent, 1),
 		nodesCh:      make(chan consensus.StaticNodesEvent, 1),
 		executedCh:   make(chan consensus.ExecutedBlock, 1),
@@ -88,7 +91,9 @@ func makeMiner(address common.Address, chain *core.BlockChain, engine consensus.
 }
 
 func (m *miner) Start() {
-	timer := time.NewTimer(0 * time.Second)
+	go m.resultLoop()
+
+	timer := m.clock.NewTimer(0 * time.Second)
 
 	for {
 		select {
@@ -98,13 +103,10 @@ func (m *miner) Start() {
 			}
 			m.newWork()
 
-		case <-timer.C:
+		case <-timer.C():
 			m.newWork()
 			timer.Reset(2 * time.Second)
 
-		case data := <-m.executedCh:
-			m.commit(&data)
-
 			// ensure that backend nodes feed wont be blocked.
 		case <-m.nodesCh:
 
@@ -115,6 +117,20 @@ func (m *miner) Start() {
 	}
 }
 
+// resultLoop write executed blocks in a standalone loop, writing block sends chain head event
+// to `headCh`, it would be blocked if the events are consumed in the same loop.
+func (m *miner) resultLoop() {
+	for {
+		select {
+		case data := <-m.executedCh:
+			m.commit(&data)
+
+		case <-m.exit:
+			return
+		}
+	}
+}
+
 func (m *miner) Stop() {
 	m.chainHeadSub.Unsubscribe()
 	m.nodesSub.Unsubscribe()
@@ -128,13 +128,21 @@ func (p *MockPeer) Send(msgcode uint64, data interface{}) error {
 			data, send = p.geth.hook(p.geth, raw)
 		}
 	}
-	if send {
-		if err := p2p.Send(p.rw, msgcode, data); err != nil {
-			log.Error("Failed to send msg", "local", p.local, "remote", p.remote, "err", err)
-			return err
-		}
+	if !send {
+		return nil
+	}
+	if p.geth.router != nil && msgcode == hotstuffMsg {
+		p.geth.router(p, data.([]byte))
+		return nil
 	}
+	return p.deliver(msgcode, data)
+}
 
+func (p *MockPeer) deliver(msgcode uint64, data interface{}) error {
+	if err := p2p.Send(p.rw, msgcode, data); err != nil {
+		log.Error("Failed to send msg", "local", p.local, "remote", p.remote, "err", err)
+		return err
+	}
 	return nil
 }
 
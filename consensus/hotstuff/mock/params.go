@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
@@ -33,11 +34,13 @@ import (
 
 const (
 	EpochStart = uint64(0)
-	EpochEnd   = uint64(10000000000)
 
 	hotstuffMsg = 0x11
 )
 
+// EpochEnd the genesis epoch should end at the default block per epoch of node manager.
+var EpochEnd = node_manager.GenesisBlockPerEpoch.Uint64()
+
 func init() {
 	glogger := log.NewGlogHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
 	glogger.Verbosity(log.LvlTrace)
@@ -58,7 +61,7 @@ func MakeGenesis(vals []common.Address) *core.Genesis {
 			IstanbulBlock:       big.NewInt(0),
 			BerlinBlock:         big.NewInt(0),
 			LondonBlock:         big.NewInt(0),
-			HotStuff:            &params.HotStuffConfig{Protocol: "basic"},
+			HotStuff:            &params.HotStuffConfig{Protocol: "basic", VoteBlock: common.Big0},
 		},
 		CommunityRate:    big.NewInt(2000),
 		CommunityAddress: common.HexToAddress("0x79ad3ca3faa0F30f4A0A2839D2DaEb4Eb6B6820D"),
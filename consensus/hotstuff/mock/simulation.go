@@ -0,0 +1,520 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mock
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/mclock"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	hcore "github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Simulation drives a group of mock nodes with a virtual clock. the consensus round timers and the
+// network latency are scheduled on the same virtual timescale, and all fault decisions(latency, drop,
+// reorder) are made by a seeded random source, so that the same scenario can be replayed with the
+// same seed. the go scheduler is still out of control, the simulation yields to nodes after every
+// tick until no more message routed, so that the virtual clock never advance while nodes are busy.
+//
+// faults injected before GST(global stabilization time) are cleared after `Stabilize` called, and
+// the invariant checkers can be used to ensure:
+// * safety: there are no conflicting blocks committed at the same height.
+// * liveness: honest nodes keep making progress after GST.
+type Simulation struct {
+	config *SimConfig
+	clock  *mclock.Simulated
+	rand   *rand.Rand
+	sys    *System
+
+	activity uint64 // count of routed and delivered messages, used to find out that nodes are idle
+
+	mu        sync.Mutex
+	index     map[common.Address]int
+	groups    map[common.Address]int // partition group id of nodes, nodes in different groups can't reach each other
+	crashed   map[common.Address]bool
+	byzantine map[common.Address]Behaviour
+	stable    bool           // denote that GST reached, messages won't be dropped or reordered any more
+	gst       mclock.AbsTime // virtual time of GST
+
+	commits    map[uint64]common.Hash // committed block hash by height
+	checked    []uint64               // checked chain height of nodes
+	lastHeight []uint64               // chain height of nodes in last tick, used to find stalled nodes
+	progress   []mclock.AbsTime       // virtual time of nodes' last chain growing
+	violations []string
+}
+
+type SimConfig struct {
+	Nodes          int
+	Seed           int64
+	MinLatency     time.Duration // minimum virtual network latency
+	MaxLatency     time.Duration // maximum virtual network latency
+	DropRate       float64       // probability of dropping messages before GST
+	ReorderRate    float64       // probability of delaying messages with an extra max latency before GST
+	Step           time.Duration // virtual time advanced in each tick
+	SettleRounds   int           // scheduler yields without any message routed before nodes regarded as idle
+	RequestTimeout uint64        // consensus round timeout in virtual milliseconds
+}
+
+var DefaultSimConfig = &SimConfig{
+	Nodes:          4,
+	Seed:           1,
+	MinLatency:     10 * time.Millisecond,
+	MaxLatency:     50 * time.Millisecond,
+	DropRate:       0,
+	ReorderRate:    0,
+	Step:           10 * time.Millisecond,
+	SettleRounds:   1000,
+	RequestTimeout: 2000,
+}
+
+// Behaviour describe byzantine node actions, it converts an outgoing message to zero or more messages
+// and the results will be re-signed by the node before sending.
+type Behaviour func(node *Geth, msg *hcore.Message) []*hcore.Message
+
+func NewSimulation(config *SimConfig) *Simulation {
+	clock := new(mclock.Simulated)
+	hsConfig := *hotstuff.DefaultBasicConfig
+	hsConfig.BlockPeriod = 0
+	hsConfig.RequestTimeout = config.RequestTimeout
+	hsConfig.Clock = clock
+
+	pks, addrs := NewAccountLists(config.Nodes)
+	nodes := make([]*Geth, config.Nodes)
+	sim := &Simulation{
+		config:     config,
+		clock:      clock,
+		rand:       rand.New(rand.NewSource(config.Seed)),
+		index:      make(map[common.Address]int),
+		groups:     make(map[common.Address]int),
+		crashed:    make(map[common.Address]bool),
+		byzantine:  make(map[common.Address]Behaviour),
+		commits:    make(map[uint64]common.Hash),
+		checked:    make([]uint64, config.Nodes),
+		lastHeight: make([]uint64, config.Nodes),
+		progress:   make([]mclock.AbsTime, config.Nodes),
+	}
+	for i := 0; i < config.Nodes; i++ {
+		node := makeGethWithConfig(pks[i], addrs, &hsConfig)
+		node.router = sim.route(node)
+		nodes[i] = node
+		sim.index[node.addr] = i
+	}
+	sim.sys = &System{nodes: nodes, exit: make(chan struct{})}
+	return sim
+}
+
+func (s *Simulation) Start() {
+	s.sys.Start()
+}
+
+func (s *Simulation) Stop() {
+	s.sys.Stop()
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (s *Simulation) Node(i int) *Geth {
+	return s.sys.nodes[i]
+}
+
+// Now retrieve the virtual time
+func (s *Simulation) Now() mclock.AbsTime {
+	return s.clock.Now()
+}
+
+// Run advance the virtual clock by duration of `d` tick by tick.
+func (s *Simulation) Run(d time.Duration) {
+	for elapsed := time.Duration(0); elapsed < d; elapsed += s.config.Step {
+		s.tick()
+	}
+}
+
+// RunUntil advance the virtual clock until all alive honest nodes reached the height, returns error
+// if the height can't be reached in the virtual duration of `timeout`.
+func (s *Simulation) RunUntil(height uint64, timeout time.Duration) error {
+	for elapsed := time.Duration(0); elapsed < timeout; elapsed += s.config.Step {
+		s.tick()
+		if s.minHonestHeight() >= height {
+			return nil
+		}
+	}
+	return fmt.Errorf("liveness violated, expect height %d in %v, got %d", height, timeout, s.minHonestHeight())
+}
+
+func (s *Simulation) tick() {
+	s.clock.Run(s.config.Step)
+	s.settle()
+	s.syncStalledNodes()
+	s.checkSafety()
+}
+
+// settle yields to the nodes until no message routed or delivered in `SettleRounds` consecutive
+// yields, nodes handling delivered messages would route new messages before they are idle.
+func (s *Simulation) settle() {
+	last, idle := atomic.LoadUint64(&s.activity), 0
+	for idle < s.config.SettleRounds {
+		runtime.Gosched()
+		if cur := atomic.LoadUint64(&s.activity); cur != last {
+			last, idle = cur, 0
+		} else {
+			idle++
+		}
+	}
+}
+
+// Partition split nodes into groups, nodes which not listed in any group are isolated.
+func (s *Simulation) Partition(groups ...[]int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for addr := range s.index {
+		s.groups[addr] = -1 - s.index[addr]
+	}
+	for id, group := range groups {
+		for _, i := range group {
+			s.groups[s.sys.nodes[i].addr] = id
+		}
+	}
+}
+
+// Heal remove all network partitions.
+func (s *Simulation) Heal() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.groups = make(map[common.Address]int)
+}
+
+// Stabilize heal the network and stop dropping or reordering messages, the virtual time is recorded as GST.
+func (s *Simulation) Stabilize() {
+	s.Heal()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stable = true
+	s.gst = s.clock.Now()
+}
+
+// GST retrieve the global stabilization time, returns false if the network not stable yet.
+func (s *Simulation) GST() (mclock.AbsTime, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gst, s.stable
+}
+
+// Crash stop the consensus engine of node, messages from or to the node will be dropped.
+func (s *Simulation) Crash(i int) {
+	node := s.sys.nodes[i]
+	s.mu.Lock()
+	s.crashed[node.addr] = true
+	s.mu.Unlock()
+
+	if err := node.hotstuff.Stop(); err != nil {
+		log.Error("Failed to crash node", "node", i, "err", err)
+	}
+}
+
+// Restart start the consensus engine of crashed node again, the round state will be reloaded from the node's db.
+func (s *Simulation) Restart(i int) {
+	node := s.sys.nodes[i]
+	if err := node.hotstuff.Start(node.chain, nil); err != nil {
+		log.Error("Failed to restart node", "node", i, "err", err)
+		return
+	}
+	s.mu.Lock()
+	delete(s.crashed, node.addr)
+	s.mu.Unlock()
+}
+
+// SetByzantine set the node's behaviour, and the node will be skipped in liveness checking.
+func (s *Simulation) SetByzantine(i int, behaviour Behaviour) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.byzantine[s.sys.nodes[i].addr] = behaviour
+}
+
+// Heights retrieve the chain height of all nodes.
+func (s *Simulation) Heights() []uint64 {
+	heights := make([]uint64, len(s.sys.nodes))
+	for i, node := range s.sys.nodes {
+		heights[i] = node.chain.CurrentBlock().NumberU64()
+	}
+	return heights
+}
+
+// CheckSafety returns error if there are conflicting blocks committed at the same height.
+func (s *Simulation) CheckSafety() error {
+	s.checkSafety()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.violations) > 0 {
+		return fmt.Errorf("safety violated: %v", s.violations)
+	}
+	return nil
+}
+
+func (s *Simulation) checkSafety() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, node := range s.sys.nodes {
+		current := node.chain.CurrentBlock().NumberU64()
+		for h := s.checked[i] + 1; h <= current; h++ {
+			block := node.chain.GetBlockByNumber(h)
+			if block == nil {
+				break
+			}
+			if hash, ok := s.commits[h]; !ok {
+				s.commits[h] = block.Hash()
+			} else if hash != block.Hash() {
+				s.violations = append(s.violations, fmt.Sprintf("node %d committed block %v at height %d, expect %v", i, block.Hash(), h, hash))
+			}
+			s.checked[i] = h
+		}
+	}
+}
+
+// syncStalledNodes the mock nodes don't sync blocks with each other, and the node which crashed or partitioned
+// may fall behind forever. insert blocks of the highest node into nodes which stalled longer than a round
+// timeout to simulate block syncing.
+func (s *Simulation) syncStalledNodes() {
+	type syncTask struct {
+		index  int
+		node   *Geth
+		blocks types.Blocks
+	}
+
+	s.mu.Lock()
+	var best *Geth
+	for _, node := range s.sys.nodes {
+		if s.crashed[node.addr] || s.byzantine[node.addr] != nil {
+			continue
+		}
+		if best == nil || node.chain.CurrentBlock().NumberU64() > best.chain.CurrentBlock().NumberU64() {
+			best = node
+		}
+	}
+	if best == nil {
+		s.mu.Unlock()
+		return
+	}
+
+	var (
+		tasks  []*syncTask
+		now    = s.clock.Now()
+		limit  = time.Duration(s.config.RequestTimeout) * time.Millisecond
+		target = best.chain.CurrentBlock().NumberU64()
+	)
+	for i, node := range s.sys.nodes {
+		height := node.chain.CurrentBlock().NumberU64()
+		if height != s.lastHeight[i] {
+			s.lastHeight[i] = height
+			s.progress[i] = now
+		}
+		stalled := time.Duration(now-s.progress[i]) > limit
+		if s.crashed[node.addr] || !stalled || height >= target || !s.reachable(best.addr, node.addr) {
+			continue
+		}
+
+		task := &syncTask{index: i, node: node}
+		for h := height + 1; h <= target; h++ {
+			block := best.chain.GetBlockByNumber(h)
+			if block == nil {
+				break
+			}
+			task.blocks = append(task.blocks, block)
+		}
+		tasks = append(tasks, task)
+	}
+	s.mu.Unlock()
+
+	// insert blocks without lock, because that the chain head event will trigger nodes sending messages.
+	for _, task := range tasks {
+		if _, err := task.node.chain.InsertChain(task.blocks); err != nil {
+			log.Warn("Failed to sync blocks", "node", task.index, "blocks", len(task.blocks), "err", err)
+		}
+	}
+}
+
+func (s *Simulation) minHonestHeight() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		height uint64
+		first  = true
+	)
+	for _, node := range s.sys.nodes {
+		if s.crashed[node.addr] || s.byzantine[node.addr] != nil {
+			continue
+		}
+		if current := node.chain.CurrentBlock().NumberU64(); first || current < height {
+			height = current
+			first = false
+		}
+	}
+	return height
+}
+
+func (s *Simulation) reachable(from, to common.Address) bool {
+	if s.crashed[from] || s.crashed[to] {
+		return false
+	}
+	if len(s.groups) == 0 {
+		return true
+	}
+	return s.groups[from] == s.groups[to]
+}
+
+// route replace the p2p sending of hotstuff messages with the virtual network.
+func (s *Simulation) route(node *Geth) func(peer *MockPeer, raw []byte) {
+	return func(peer *MockPeer, raw []byte) {
+		atomic.AddUint64(&s.activity, 1)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !s.reachable(node.addr, peer.remote) {
+			return
+		}
+
+		payloads := [][]byte{raw}
+		if behaviour := s.byzantine[node.addr]; behaviour != nil {
+			payloads = s.misbehave(node, behaviour, raw)
+		}
+
+		for _, payload := range payloads {
+			if !s.stable && s.rand.Float64() < s.config.DropRate {
+				continue
+			}
+			data := payload
+			s.clock.AfterFunc(s.latency(), func() {
+				s.mu.Lock()
+				reachable := s.reachable(node.addr, peer.remote)
+				s.mu.Unlock()
+				if reachable {
+					peer.deliver(hotstuffMsg, data)
+					atomic.AddUint64(&s.activity, 1)
+				}
+			})
+		}
+	}
+}
+
+func (s *Simulation) latency() time.Duration {
+	delay := s.config.MinLatency
+	if span := s.config.MaxLatency - s.config.MinLatency; span > 0 {
+		delay += time.Duration(s.rand.Int63n(int64(span)))
+	}
+	if !s.stable && s.rand.Float64() < s.config.ReorderRate {
+		delay += s.config.MaxLatency
+	}
+	return delay
+}
+
+func (s *Simulation) misbehave(node *Geth, behaviour Behaviour, raw []byte) [][]byte {
+	var msg hcore.Message
+	if err := rlp.DecodeBytes(raw, &msg); err != nil {
+		log.Error("Failed to decode message", "err", err)
+		return nil
+	}
+
+	var payloads [][]byte
+	for _, m := range behaviour(node, &msg) {
+		payload, err := node.resignMsg(m)
+		if err != nil {
+			log.Error("Failed to resign message", "err", err)
+			continue
+		}
+		payloads = append(payloads, payload)
+	}
+	return payloads
+}
+
+// Withhold drop messages of the given types.
+func Withhold(codes ...hcore.MsgType) Behaviour {
+	return func(node *Geth, msg *hcore.Message) []*hcore.Message {
+		for _, code := range codes {
+			if msg.Code == code {
+				return nil
+			}
+		}
+		return []*hcore.Message{msg}
+	}
+}
+
+// DoubleVote send an extra vote for a random node hash besides the original vote, the committed seal
+// of the extra commit vote is signed over the random hash with the node's key as well.
+func DoubleVote() Behaviour {
+	return func(node *Geth, msg *hcore.Message) []*hcore.Message {
+		switch msg.Code {
+		case hcore.MsgTypePrepareVote, hcore.MsgTypePreCommitVote, hcore.MsgTypeCommitVote:
+			var hash common.Hash
+			rand.Read(hash[:])
+
+			fake := msg.Copy()
+			fake.Msg = hash.Bytes()
+			if msg.Code == hcore.MsgTypeCommitVote {
+				seal, err := node.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), hotstuff.SealCodeCommitted, hash)
+				if err != nil {
+					log.Error("Failed to sign committed seal", "err", err)
+					return []*hcore.Message{msg}
+				}
+				fake.CommittedSeal = seal
+			}
+			return []*hcore.Message{msg, fake}
+		default:
+			return []*hcore.Message{msg}
+		}
+	}
+}
+
+// InvalidProposal leader tamper the proposed block, so that the proposer seal in block header is invalid.
+func InvalidProposal() Behaviour {
+	return func(node *Geth, msg *hcore.Message) []*hcore.Message {
+		if msg.Code != hcore.MsgTypePrepare {
+			return []*hcore.Message{msg}
+		}
+
+		var sub *hcore.Subject
+		if err := msg.Decode(&sub); err != nil || sub.Node == nil || sub.Node.Block == nil {
+			return []*hcore.Message{msg}
+		}
+		header := sub.Node.Block.Header()
+		header.GasUsed += 1
+		tampered := hcore.NewSubject(hcore.NewNode(sub.Node.Parent, sub.Node.Block.WithSeal(header)), sub.QC)
+		payload, err := rlp.EncodeToBytes(tampered)
+		if err != nil {
+			return []*hcore.Message{msg}
+		}
+
+		fake := msg.Copy()
+		fake.Msg = payload
+		return []*hcore.Message{fake}
+	}
+}
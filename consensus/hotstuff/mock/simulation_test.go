@@ -0,0 +1,117 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/contracts/native/boot"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSimulation(modify func(config *SimConfig)) *Simulation {
+	node_manager.InitABI()
+	boot.InitNativeContracts()
+
+	config := *DefaultSimConfig
+	if modify != nil {
+		modify(&config)
+	}
+	sim := NewSimulation(&config)
+	sim.Start()
+	return sim
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/consensus/hotstuff/mock -run TestSimulationLossyNetwork
+// messages are dropped and reordered before GST, and nodes should keep committing blocks after GST.
+func TestSimulationLossyNetwork(t *testing.T) {
+	sim := newTestSimulation(func(config *SimConfig) {
+		config.DropRate = 0.05
+		config.ReorderRate = 0.2
+	})
+	defer sim.Stop()
+
+	sim.Run(10 * time.Second)
+	sim.Stabilize()
+
+	start := sim.minHonestHeight()
+	assert.NoError(t, sim.RunUntil(start+3, time.Minute))
+	assert.NoError(t, sim.CheckSafety())
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/consensus/hotstuff/mock -run TestSimulationPartition
+// network split into 2 halves and none of them has quorum, consensus recover after the partition healed.
+func TestSimulationPartition(t *testing.T) {
+	sim := newTestSimulation(nil)
+	defer sim.Stop()
+
+	assert.NoError(t, sim.RunUntil(2, time.Minute))
+	sim.Partition([]int{0, 1}, []int{2, 3})
+	sim.Run(10 * time.Second)
+	stalled := sim.Heights()
+	sim.Run(10 * time.Second)
+	assert.Equal(t, stalled, sim.Heights())
+
+	sim.Stabilize()
+	start := sim.minHonestHeight()
+	assert.NoError(t, sim.RunUntil(start+3, 2*time.Minute))
+	assert.NoError(t, sim.CheckSafety())
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/consensus/hotstuff/mock -run TestSimulationCrashRestart
+// one of 4 nodes crashed and restarted with the round state persisted in db.
+func TestSimulationCrashRestart(t *testing.T) {
+	sim := newTestSimulation(nil)
+	defer sim.Stop()
+
+	assert.NoError(t, sim.RunUntil(2, time.Minute))
+	sim.Crash(3)
+	start := sim.minHonestHeight()
+	assert.NoError(t, sim.RunUntil(start+2, 2*time.Minute))
+
+	sim.Restart(3)
+	sim.Stabilize()
+	start = sim.minHonestHeight()
+	assert.NoError(t, sim.RunUntil(start+3, 2*time.Minute))
+	assert.NoError(t, sim.CheckSafety())
+}
+
+// go test -v -count=1 github.com/ethereum/go-ethereum/consensus/hotstuff/mock -run TestSimulationByzantine
+// one of 4 nodes is byzantine, and the honest nodes should keep safety and liveness.
+func TestSimulationByzantine(t *testing.T) {
+	cases := map[string]Behaviour{
+		"DoubleVote":      DoubleVote(),
+		"Withhold":        Withhold(core.MsgTypeNewView, core.MsgTypePrepareVote, core.MsgTypePreCommitVote, core.MsgTypeCommitVote),
+		"InvalidProposal": InvalidProposal(),
+	}
+	for name, behaviour := range cases {
+		t.Run(name, func(t *testing.T) {
+			sim := newTestSimulation(nil)
+			defer sim.Stop()
+
+			sim.SetByzantine(0, behaviour)
+			sim.Stabilize()
+			assert.NoError(t, sim.RunUntil(4, 3*time.Minute))
+			assert.NoError(t, sim.CheckSafety())
+		})
+	}
+}
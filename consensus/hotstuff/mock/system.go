@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/backend"
@@ -49,17 +50,26 @@ type Geth struct {
 	broadcaster *broadcaster
 	signer      hotstuff.Signer
 	hook        func(node *Geth, raw []byte) ([]byte, bool)
+	router      func(peer *MockPeer, raw []byte) // deliver hotstuff messages by simulation network if not nil
 }
 
 func MakeGeth(privateKey *ecdsa.PrivateKey, vals []common.Address) *Geth {
+	return makeGethWithConfig(privateKey, vals, hotstuff.DefaultBasicConfig)
+}
+
+func makeGethWithConfig(privateKey *ecdsa.PrivateKey, vals []common.Address, config *hotstuff.Config) *Geth {
 	db := rawdb.NewMemoryDatabase()
-	engine := makeEngine(privateKey, db)
+	engine := makeEngineWithConfig(privateKey, db, config)
 	chain := makeChain(db, engine, vals)
 
 	hotstuffEngine := engine.(consensus.HotStuff)
 	broadcaster := engine.(consensus.Handler).GetBroadcaster().(*broadcaster)
 	api := engine.APIs(chain)[0].Service.(*backend.API)
-	miner := makeMiner(broadcaster.addr, chain, hotstuffEngine)
+	clock := config.Clock
+	if clock == nil {
+		clock = mclock.System{}
+	}
+	miner := makeMiner(broadcaster.addr, chain, hotstuffEngine, clock)
 	geth := &Geth{
 		miner:       miner,
 		chain:       chain,
@@ -67,7 +77,7 @@ func MakeGeth(privateKey *ecdsa.PrivateKey, vals []common.Address) *Geth {
 		api:         api,
 		hotstuff:    hotstuffEngine,
 		broadcaster: broadcaster,
-		signer:      signer.NewSigner(privateKey),
+		signer:      signer.NewSigner(privateKey, chain.Config().HotstuffVoteBlock()),
 	}
 	geth.addr = geth.signer.Address()
 	miner.geth = geth
@@ -147,7 +157,7 @@ func (g *Geth) resignMsg(msg *hcore.Message) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	sig, err := g.signer.SignHash(hash)
+	sig, err := g.signer.SignVote(msg.View.HeightU64(), msg.View.RoundU64(), msg.Code.Value(), hash)
 	if err != nil {
 		return nil, err
 	}
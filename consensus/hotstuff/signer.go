@@ -23,17 +23,36 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
+// Seal codes denote the signatures out of consensus messages, they are numbered apart from
+// the message types so that the seal and the vote of the same view are never taken as double
+// signing by the remote signer.
+const (
+	SealCodeProposal  uint64 = 0x80 // proposer seal of block header
+	SealCodeCommitted uint64 = 0x81 // committed seal of block header
+	SealCodeTimeout   uint64 = 0x82 // seal of timeout vote
+	SealCodeHandshake uint64 = 0x83 // signature of consensus peer handshake
+)
+
+// Signer signs and verifies the consensus hashes. the hash is never signed directly but within
+// the domain of `accounts.HotstuffVoteHash` along with it's height and code, and the round is
+// excluded since that the seals in block header can't tell it.
 type Signer interface {
 	Address() common.Address
 
-	// SignHash returns an signature of wrapped proposal hash which used as an vote
-	SignHash(hash common.Hash) ([]byte, error)
+	// SignVote returns an signature of consensus hash with it's view and message type or seal code,
+	// remote signer use them to refuse signing different hashes in the same view.
+	SignVote(height, round, code uint64, hash common.Hash) ([]byte, error)
+
+	// SignHandshake returns an signature of the handshake hash of consensus peers, it's always signed as
+	// vote of seal code `SealCodeHandshake`, so that it could be signed by remote signer before vote fork.
+	SignHandshake(hash common.Hash) ([]byte, error)
 
 	// SignTx sign transaction and full fill it with signature
 	SignTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error)
 
-	// CheckSignature extract address from signature and check if the address exist in validator set
-	CheckSignature(valSet ValidatorSet, hash common.Hash, signature []byte) (common.Address, error)
+	// CheckSignature extract address from signature of the consensus hash with it's height and code,
+	// and check if the address exist in validator set
+	CheckSignature(valSet ValidatorSet, height, code uint64, hash common.Hash, signature []byte) (common.Address, error)
 
 	// Recover extracts the proposer address from a signed header.
 	Recover(h *types.Header) (common.Address, *types.HotstuffExtra, error)
@@ -44,6 +63,6 @@ type Signer interface {
 	// VerifyQC verify quorum cert in consensus procedure
 	VerifyQC(qc QC, valSet ValidatorSet, epoch bool) error
 
-	// VerifyCommittedSeal verify signatures in header's extra
-	VerifyCommittedSeal(valset ValidatorSet, hash common.Hash, committedSeal [][]byte) error
+	// VerifyCommittedSeal verify committed seals of the block seal hash at height
+	VerifyCommittedSeal(valset ValidatorSet, height uint64, hash common.Hash, committedSeal [][]byte) error
 }
@@ -0,0 +1,123 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package signer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// dataSigner is the subset of clef client used by remote signer.
+type dataSigner interface {
+	SignData(account accounts.Account, mimeType string, data []byte) ([]byte, error)
+	SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// RemoteSigner delegates signing to an external signer(e.g. clef) over the `account_signData`
+// protocol, the validator key is never held in process memory. consensus hashes are sent with
+// their view and message type or seal code so that the signer is able to refuse double signing.
+// signature verification is the same as `SignerImpl`. The external signer only signs the
+// domain separated votes, so that it's not available before the vote fork block.
+type RemoteSigner struct {
+	*SignerImpl
+
+	account accounts.Account
+	client  dataSigner
+}
+
+// NewRemoteSigner connects to the external signer and ensures that the validator account exists.
+func NewRemoteSigner(endpoint string, address common.Address, voteBlock *big.Int) (*RemoteSigner, error) {
+	if voteBlock == nil {
+		return nil, fmt.Errorf("remote signer requires the hotstuff vote fork block")
+	}
+	client, err := external.NewExternalSigner(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	account := accounts.Account{Address: address}
+	if !client.Contains(account) {
+		return nil, fmt.Errorf("account %s not found in external signer %s", address.Hex(), endpoint)
+	}
+	return newRemoteSigner(client, address, voteBlock), nil
+}
+
+func newRemoteSigner(client dataSigner, address common.Address, voteBlock *big.Int) *RemoteSigner {
+	signatures, _ := lru.NewARC(inmemorySignatures)
+	return &RemoteSigner{
+		SignerImpl: &SignerImpl{
+			address:    address,
+			signatures: signatures,
+			voteBlock:  voteBlock,
+		},
+		account: accounts.Account{Address: address},
+		client:  client,
+	}
+}
+
+func (s *RemoteSigner) SignVote(height, round, code uint64, hash common.Hash) ([]byte, error) {
+	if hash == common.EmptyHash {
+		return nil, ErrInvalidRawHash
+	}
+	if !s.isVote(height) {
+		return nil, fmt.Errorf("remote signer is not available before the vote fork block %s", s.voteBlock)
+	}
+	return s.signData(&core.HotstuffVote{Height: height, Round: round, Code: code, Hash: hash}, s.voteHash(height, code, hash))
+}
+
+func (s *RemoteSigner) SignHandshake(hash common.Hash) ([]byte, error) {
+	if hash == common.EmptyHash {
+		return nil, ErrInvalidRawHash
+	}
+	return s.signData(&core.HotstuffVote{Code: hotstuff.SealCodeHandshake, Hash: hash}, HandshakeHash(hash))
+}
+
+// signData requests the external signer to sign the vote, and ensures that it's signed over `sighash`
+// with the validator account.
+func (s *RemoteSigner) signData(vote *core.HotstuffVote, sighash common.Hash) ([]byte, error) {
+	data, err := rlp.EncodeToBytes(vote)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := s.client.SignData(s.account, accounts.MimetypeHotstuff, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != types.HotstuffExtraSeal {
+		return nil, ErrInvalidSignature
+	}
+	if signer, err := getSignatureAddress(sighash, sig); err != nil {
+		return nil, err
+	} else if signer != s.address {
+		return nil, fmt.Errorf("remote signature address mismatch, expect %s, got %s", s.address.Hex(), signer.Hex())
+	}
+	return sig, nil
+}
+
+func (s *RemoteSigner) SignTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	return s.client.SignTx(s.account, tx, signer.ChainID())
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package signer
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDataSigner mock the external signer, it signs the hotstuff vote with the key and records requests.
+type testDataSigner struct {
+	key   *ecdsa.PrivateKey
+	votes []*core.HotstuffVote
+}
+
+func (s *testDataSigner) SignData(account accounts.Account, mimeType string, data []byte) ([]byte, error) {
+	if mimeType != accounts.MimetypeHotstuff {
+		return nil, fmt.Errorf("unexpected mimetype %s", mimeType)
+	}
+	vote := new(core.HotstuffVote)
+	if err := rlp.DecodeBytes(data, vote); err != nil {
+		return nil, err
+	}
+	s.votes = append(s.votes, vote)
+	return crypto.Sign(accounts.HotstuffVoteHash(vote.Height, vote.Code, vote.Hash), s.key)
+}
+
+func (s *testDataSigner) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
+
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/signer -run TestRemoteSigner
+func TestRemoteSigner(t *testing.T) {
+	vset, keys := newTestValidatorSet(4)
+	client := &testDataSigner{key: keys[0]}
+	address := crypto.PubkeyToAddress(keys[0].PublicKey)
+	s := newRemoteSigner(client, address, big.NewInt(5))
+	assert.Equal(t, address, s.Address())
+
+	// the external signer only signs votes since the vote fork block
+	_, err := s.SignVote(4, 2, 3, common.HexToHash("0x1234"))
+	assert.Error(t, err)
+	assert.Empty(t, client.votes)
+
+	hash := common.HexToHash("0x1234")
+	sig, err := s.SignVote(10, 2, 3, hash)
+	assert.NoError(t, err)
+	assert.Equal(t, &core.HotstuffVote{Height: 10, Round: 2, Code: 3, Hash: hash}, client.votes[0])
+
+	signer, err := s.CheckSignature(vset, 10, 3, hash, sig)
+	assert.NoError(t, err)
+	assert.Equal(t, address, signer)
+
+	// the seal is signed with it's seal code
+	_, err = s.SignVote(10, 2, hotstuff.SealCodeCommitted, hash)
+	assert.NoError(t, err)
+	assert.Equal(t, hotstuff.SealCodeCommitted, client.votes[1].Code)
+	_, err = s.SignVote(10, 2, 3, common.EmptyHash)
+	assert.Equal(t, ErrInvalidRawHash, err)
+
+	// the signature of different account should be refused
+	client.key = keys[1]
+	_, err = s.SignVote(10, 2, 3, hash)
+	assert.Error(t, err)
+
+	// the handshake is signed before the vote fork block, and it's the same as local signer
+	client.key = keys[0]
+	sig, err = s.SignHandshake(hash)
+	assert.NoError(t, err)
+	assert.Equal(t, &core.HotstuffVote{Code: hotstuff.SealCodeHandshake, Hash: hash}, client.votes[len(client.votes)-1])
+	local, err := NewSigner(keys[0], big.NewInt(5)).SignHandshake(hash)
+	assert.NoError(t, err)
+	assert.Equal(t, local, sig)
+
+	// sign transaction
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	txSigner := types.LatestSignerForChainID(big.NewInt(1))
+	signed, err := s.SignTx(tx, txSigner)
+	assert.NoError(t, err)
+	from, err := types.Sender(txSigner, signed)
+	assert.NoError(t, err)
+	assert.Equal(t, address, from)
+}
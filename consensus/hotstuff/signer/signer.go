@@ -21,7 +21,9 @@ package signer
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -44,15 +46,19 @@ type SignerImpl struct {
 	privateKey    *ecdsa.PrivateKey
 	signatures    *lru.ARCCache // Signatures of recent blocks to speed up mining
 	commitSigSalt []byte
+	voteBlock     *big.Int // Height since which the hashes are signed as domain separated votes
 }
 
-func NewSigner(privateKey *ecdsa.PrivateKey) *SignerImpl {
+// NewSigner creates the local signer, the consensus hashes are signed directly before
+// voteBlock and as domain separated votes since it, nil voteBlock means never.
+func NewSigner(privateKey *ecdsa.PrivateKey, voteBlock *big.Int) *SignerImpl {
 	signatures, _ := lru.NewARC(inmemorySignatures)
 	address := crypto.PubkeyToAddress(privateKey.PublicKey)
 	return &SignerImpl{
 		address:    address,
 		privateKey: privateKey,
 		signatures: signatures,
+		voteBlock:  voteBlock,
 	}
 }
 
@@ -60,14 +66,24 @@ func (s *SignerImpl) Address() common.Address {
 	return s.address
 }
 
-func (s *SignerImpl) SignHash(hash common.Hash) ([]byte, error) {
+func (s *SignerImpl) SignVote(height, round, code uint64, hash common.Hash) ([]byte, error) {
 	if hash == common.EmptyHash {
 		return nil, ErrInvalidRawHash
 	}
 	if s.privateKey == nil {
 		return nil, ErrInvalidSigner
 	}
-	return crypto.Sign(hash.Bytes(), s.privateKey)
+	return crypto.Sign(s.voteHash(height, code, hash).Bytes(), s.privateKey)
+}
+
+func (s *SignerImpl) SignHandshake(hash common.Hash) ([]byte, error) {
+	if hash == common.EmptyHash {
+		return nil, ErrInvalidRawHash
+	}
+	if s.privateKey == nil {
+		return nil, ErrInvalidSigner
+	}
+	return crypto.Sign(HandshakeHash(hash).Bytes(), s.privateKey)
 }
 
 func (s *SignerImpl) SignTx(tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
@@ -80,7 +96,7 @@ func (s *SignerImpl) SignTx(tx *types.Transaction, signer types.Signer) (*types.
 	return types.SignTx(tx, signer, s.privateKey)
 }
 
-func (s *SignerImpl) CheckSignature(valSet hotstuff.ValidatorSet, hash common.Hash, sig []byte) (common.Address, error) {
+func (s *SignerImpl) CheckSignature(valSet hotstuff.ValidatorSet, height, code uint64, hash common.Hash, sig []byte) (common.Address, error) {
 	if valSet == nil {
 		return common.EmptyAddress, ErrInvalidValset
 	}
@@ -91,7 +107,7 @@ func (s *SignerImpl) CheckSignature(valSet hotstuff.ValidatorSet, hash common.Ha
 		return common.EmptyAddress, ErrInvalidSignature
 	}
 
-	signer, err := getSignatureAddress(hash, sig)
+	signer, err := getSignatureAddress(s.voteHash(height, code, hash), sig)
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -129,7 +145,7 @@ func (s *SignerImpl) Recover(header *types.Header) (common.Address, *types.Hotst
 		return common.EmptyAddress, nil, ErrInvalidExtraDataFormat
 	}
 
-	addr, err := getSignatureAddress(hash, extra.Seal)
+	addr, err := getSignatureAddress(s.voteHash(header.Number.Uint64(), hotstuff.SealCodeProposal, hash), extra.Seal)
 	if err != nil {
 		return common.EmptyAddress, nil, err
 	}
@@ -173,7 +189,7 @@ func (s *SignerImpl) VerifyHeader(header *types.Header, valSet hotstuff.Validato
 
 	if seal {
 		sealHash := types.SealHash(header)
-		if err := s.VerifyCommittedSeal(valSet, sealHash, extra.CommittedSeal); err != nil {
+		if err := s.VerifyCommittedSeal(valSet, number, sealHash, extra.CommittedSeal); err != nil {
 			return extra, err
 		}
 	}
@@ -198,11 +214,17 @@ func (s *SignerImpl) VerifyQC(qc hotstuff.QC, valSet hotstuff.ValidatorSet, epoc
 	if hash == common.EmptyHash {
 		return fmt.Errorf("seal hash is empty")
 	}
+
+	// epoch start qc is assembled with the seals of block header
+	sealHash := s.voteHash(qc.HeightU64(), qc.Code(), hash)
+	committedHash := sealHash
 	if epoch {
 		hash = qc.NodeHash()
+		sealHash = s.voteHash(qc.HeightU64(), hotstuff.SealCodeProposal, hash)
+		committedHash = s.voteHash(qc.HeightU64(), hotstuff.SealCodeCommitted, hash)
 	}
 
-	addr, err := getSignatureAddress(hash, seal)
+	addr, err := getSignatureAddress(sealHash, seal)
 	if err != nil {
 		return err
 	}
@@ -213,10 +235,10 @@ func (s *SignerImpl) VerifyQC(qc hotstuff.QC, valSet hotstuff.ValidatorSet, epoc
 		return fmt.Errorf("proposer not in validator set")
 	}
 
-	return s.checkQuorum(valSet, hash, committedSeal)
+	return s.checkQuorum(valSet, committedHash, committedSeal)
 }
 
-func (s *SignerImpl) VerifyCommittedSeal(valset hotstuff.ValidatorSet, hash common.Hash, committedSeal [][]byte) error {
+func (s *SignerImpl) VerifyCommittedSeal(valset hotstuff.ValidatorSet, height uint64, hash common.Hash, committedSeal [][]byte) error {
 	if hash == common.EmptyHash {
 		return ErrInvalidRawHash
 	}
@@ -224,7 +246,7 @@ func (s *SignerImpl) VerifyCommittedSeal(valset hotstuff.ValidatorSet, hash comm
 		return ErrInvalidCommittedSeals
 	}
 
-	return s.checkQuorum(valset, hash, committedSeal)
+	return s.checkQuorum(valset, s.voteHash(height, hotstuff.SealCodeCommitted, hash), committedSeal)
 }
 
 func (s *SignerImpl) checkQuorum(valset hotstuff.ValidatorSet, hash common.Hash, seals [][]byte) error {
@@ -241,6 +263,25 @@ func (s *SignerImpl) checkQuorum(valset hotstuff.ValidatorSet, hash common.Hash,
 	return valset.CheckQuorum(addrs)
 }
 
+// isVote returns true if the consensus hashes at height are signed as domain separated votes.
+func (s *SignerImpl) isVote(height uint64) bool {
+	return s.voteBlock != nil && s.voteBlock.Uint64() <= height
+}
+
+// voteHash returns the hash actually signed by validator for the consensus hash, which is the
+// hash itself before the vote fork block.
+func (s *SignerImpl) voteHash(height, code uint64, hash common.Hash) common.Hash {
+	if !s.isVote(height) {
+		return hash
+	}
+	return common.BytesToHash(accounts.HotstuffVoteHash(height, code, hash))
+}
+
+// HandshakeHash returns the hash actually signed by validator for the handshake hash of consensus peers.
+func HandshakeHash(hash common.Hash) common.Hash {
+	return common.BytesToHash(accounts.HotstuffVoteHash(0, hotstuff.SealCodeHandshake, hash))
+}
+
 // getSignatureAddress gets the address address from the signature
 func getSignatureAddress(hash common.Hash, sig []byte) (common.Address, error) {
 	if hash == common.EmptyHash {
@@ -20,10 +20,12 @@ package signer
 
 import (
 	"crypto/ecdsa"
+	"math/big"
 	"sort"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
@@ -36,11 +38,11 @@ func TestSign(t *testing.T) {
 	s := newTestSigner()
 	data := []byte("Here is a string....")
 	hashData := crypto.Keccak256(data)
-	sig, err := s.SignHash(common.BytesToHash(hashData))
+	sig, err := s.SignVote(10, 1, 3, common.BytesToHash(hashData))
 	assert.NoError(t, err, "error mismatch: have %v, want nil", err)
 
 	//Check signature recover
-	pubkey, _ := crypto.Ecrecover(hashData, sig)
+	pubkey, _ := crypto.Ecrecover(accounts.HotstuffVoteHash(10, 3, common.BytesToHash(hashData)), sig)
 	var signer common.Address
 	copy(signer[:], crypto.Keccak256(pubkey[1:])[12:])
 	assert.Equal(t, signer, getAddress(), "address mismatch: have %v, want %s", signer.Hex(), getAddress().Hex())
@@ -53,18 +55,37 @@ func TestCheckValidatorSignature(t *testing.T) {
 	// 1. Positive test: sign with validator's key should succeed
 	data := []byte("dummy data")
 	hashData := crypto.Keccak256([]byte(data))
+	sigHash := accounts.HotstuffVoteHash(10, 3, common.BytesToHash(hashData))
 	for i, k := range keys {
 		// Sign
-		sig, err := crypto.Sign(hashData, k)
+		sig, err := crypto.Sign(sigHash, k)
 		assert.NoError(t, err, "error mismatch: have %v, want nil", err)
 
 		// CheckValidatorSignature should succeed
-		signer := NewSigner(k)
-		addr, err := signer.CheckSignature(vset, common.BytesToHash(hashData), sig)
+		signer := NewSigner(k, big.NewInt(10))
+		addr, err := signer.CheckSignature(vset, 10, 3, common.BytesToHash(hashData), sig)
 		assert.NoError(t, err, "error mismatch: have %v, want nil", err)
 
 		val := vset.GetByIndex(uint64(i))
 		assert.Equal(t, addr, val.Address(), "validator address mismatch: have %v, want %v", addr, val.Address())
+
+		// the signature is bound to the height and code, and never valid for the raw hash
+		addr, _ = signer.CheckSignature(vset, 11, 3, common.BytesToHash(hashData), sig)
+		assert.NotEqual(t, val.Address(), addr)
+		addr, _ = signer.CheckSignature(vset, 10, 4, common.BytesToHash(hashData), sig)
+		assert.NotEqual(t, val.Address(), addr)
+		raw, err := crypto.Sign(hashData, k)
+		assert.NoError(t, err)
+		addr, _ = signer.CheckSignature(vset, 10, 3, common.BytesToHash(hashData), raw)
+		assert.NotEqual(t, val.Address(), addr)
+
+		// the raw hash is signed before the vote fork block
+		addr, err = signer.CheckSignature(vset, 9, 3, common.BytesToHash(hashData), raw)
+		assert.NoError(t, err)
+		assert.Equal(t, val.Address(), addr)
+		legacy, err := NewSigner(k, nil).SignVote(10, 1, 3, common.BytesToHash(hashData))
+		assert.NoError(t, err)
+		assert.Equal(t, raw, legacy)
 	}
 
 	// 2. Negative test: sign with any key other than validator's key should return error
@@ -72,12 +93,12 @@ func TestCheckValidatorSignature(t *testing.T) {
 	assert.NoError(t, err, "error mismatch: have %v, want nil", err)
 
 	// Sign
-	sig, err := crypto.Sign(hashData, key)
+	sig, err := crypto.Sign(sigHash, key)
 	assert.NoError(t, err, "error mismatch: have %v, want nil", err)
 
 	// CheckValidatorSignature should return ErrUnauthorizedAddress
-	signer := NewSigner(key)
-	addr, err := signer.CheckSignature(vset, common.BytesToHash(hashData), sig)
+	signer := NewSigner(key, common.Big0)
+	addr, err := signer.CheckSignature(vset, 10, 3, common.BytesToHash(hashData), sig)
 	assert.Equal(t, err, ErrUnauthorizedAddress, "error mismatch: have %v, want %v", err, ErrUnauthorizedAddress)
 
 	emptyAddr := common.Address{}
@@ -129,5 +150,5 @@ func generatePrivateKey() (*ecdsa.PrivateKey, error) {
 
 func newTestSigner() hotstuff.Signer {
 	key, _ := generatePrivateKey()
-	return NewSigner(key)
+	return NewSigner(key, common.Big0)
 }
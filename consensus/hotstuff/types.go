@@ -57,6 +57,7 @@ type Proposal interface {
 type QC interface {
 	Height() *big.Int
 	HeightU64() uint64
+	Code() uint64
 	NodeHash() common.Hash
 	SealHash() common.Hash
 	Proposer() common.Address
@@ -70,3 +71,34 @@ func RLPHash(v interface{}) (h common.Hash) {
 	hw.Sum(h[:0])
 	return h
 }
+
+// QCInfo is a readable summary of quorum cert which used in rpc api
+type QCInfo struct {
+	Height   uint64         `json:"height"`
+	Round    uint64         `json:"round"`
+	Code     string         `json:"code"`
+	Node     common.Hash    `json:"node"`
+	Proposer common.Address `json:"proposer"`
+}
+
+// RoundStateInfo is a snapshot of consensus core round state which used for debugging
+type RoundStateInfo struct {
+	Height         uint64         `json:"height"`
+	Round          uint64         `json:"round"`
+	State          string         `json:"state"`
+	Proposer       common.Address `json:"proposer"`
+	IsProposer     bool           `json:"isProposer"`
+	LockQC         *QCInfo        `json:"lockQC"`
+	HighQC         *QCInfo        `json:"highQC"`
+	PrepareQC      *QCInfo        `json:"prepareQC"`
+	PendingRequest common.Hash    `json:"pendingRequest"`
+}
+
+// VoteInfo denote that whether the validator's messages exist in leader's message sets in current view
+type VoteInfo struct {
+	NewView       bool `json:"newView"`
+	PrepareVote   bool `json:"prepareVote"`
+	PreCommitVote bool `json:"preCommitVote"`
+	CommitVote    bool `json:"commitVote"`
+	Count         int  `json:"count"`
+}
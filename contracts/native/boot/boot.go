@@ -25,8 +25,10 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native/governance/proposal_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/signature_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/treasury"
 	"github.com/ethereum/go-ethereum/contracts/native/info_sync"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -39,6 +41,8 @@ func InitNativeContracts() {
 	neo3_state_manager.InitNeo3StateManager()
 	signature_manager.InitSignatureManager()
 	proposal_manager.InitProposalManager()
+	vesting.InitVesting()
+	treasury.InitTreasury()
 
 	log.Info("Initialize main chain native contracts",
 		"node manager", utils.NodeManagerContractAddress.Hex(),
@@ -50,6 +54,8 @@ func InitNativeContracts() {
 		"neo3 state manager", utils.Neo3StateManagerContractAddress.Hex(),
 		"signature manager", utils.SignatureManagerContractAddress.Hex(),
 		"proposal manager", utils.ProposalManagerContractAddress.Hex(),
+		"vesting", utils.VestingContractAddress.Hex(),
+		"treasury", utils.TreasuryContractAddress.Hex(),
 	)
 
 }
@@ -33,11 +33,18 @@ func ValidateOwner(n *native.NativeContract, address common.Address) error {
 	return nil
 }
 
-func NativeTransfer(s *state.StateDB, from, to common.Address, amount *big.Int) error {
+// NativeTransfer transfers the native token at the block of height, the locked balance
+// of vesting account can only be transferred to the native contracts tracking it as
+// delegated vesting, which is the same as the value transfer of evm call.
+func NativeTransfer(s *state.StateDB, height *big.Int, from, to common.Address, amount *big.Int) error {
 	if amount.Sign() == -1 {
 		return fmt.Errorf("amount can not be negative")
 	}
-	if !core.CanTransfer(s, from, amount) {
+	canTransfer := core.CanTransferAt(height)
+	if native.AcceptsLockedBalance(to) {
+		canTransfer = core.CanTransfer
+	}
+	if !canTransfer(s, from, amount) {
 		return fmt.Errorf("%s insufficient balance", from.Hex())
 	}
 	core.Transfer(s, from, to, amount)
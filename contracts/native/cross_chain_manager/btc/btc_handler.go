@@ -0,0 +1,434 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package btc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// method name of lock proxy to release the asset on target chain
+	unlockMethod = "unlock"
+	// outputs lower than dust limit are not relayed by bitcoin nodes
+	dustLimit = 546
+)
+
+// BtcHandler handles the transfers between bitcoin and other chains. the deposit to the P2WSH address of
+// federation is verified with the merkle proof against the synced headers, and the withdraw is paid from
+// the utxos of federation by the tx signed by the federation members.
+type BtcHandler struct{}
+
+func NewBtcHandler() *BtcHandler {
+	return &BtcHandler{}
+}
+
+// MakeDepositProposal verifies the deposit tx in `Extra` with the merkle proof in `Proof`. the tx pays to
+// the federation and carries the `DepositData` in its OP_RETURN output.
+func (this *BtcHandler) MakeDepositProposal(service *native.NativeContract) (*scom.MakeTxParam, error) {
+	ctx := service.ContractRef().CurrentContext()
+	params := &scom.EntranceParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	info, err := side_chain_manager.GetBtcExtraInfo(service, params.SourceChainID)
+	if err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, GetBtcExtraInfo error: %v", err)
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.Deserialize(bytes.NewReader(params.Extra)); err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, deserialize tx error: %v", err)
+	}
+	// 64 bytes tx could be forged as an inner node of merkle tree
+	if tx.SerializeSizeStripped() == 2*chainhash.HashSize {
+		return nil, fmt.Errorf("btc MakeDepositProposal, tx of 64 bytes is not allowed")
+	}
+	txHash := tx.TxHash()
+
+	proof := new(MerkleProof)
+	if err := rlp.DecodeBytes(params.Proof, proof); err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, deserialize merkle proof error: %v", err)
+	}
+	blockHash, err := chainhash.NewHash(proof.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, invalid block hash: %v", err)
+	}
+	header, err := checkInBestChain(service, params.SourceChainID, *blockHash, info.MinConfirmations)
+	if err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, checkInBestChain error: %v", err)
+	}
+	if err := verifyMerkleProof(txHash, proof, header.MerkleRoot); err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, verifyMerkleProof error: %v", err)
+	}
+
+	if err := scom.CheckDoneTx(service, txHash[:], params.SourceChainID); err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, check done transaction error: %v", err)
+	}
+	if err := scom.PutDoneTx(service, txHash[:], params.SourceChainID); err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, PutDoneTx error: %v", err)
+	}
+
+	script, err := witnessScript(info.RedeemScript)
+	if err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, witnessScript error: %v", err)
+	}
+	utxos, err := GetUtxos(service, params.SourceChainID)
+	if err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, GetUtxos error: %v", err)
+	}
+	var amount uint64
+	var deposit *DepositData
+	for i, out := range tx.TxOut {
+		if bytes.Equal(out.PkScript, script) {
+			amount += uint64(out.Value)
+			utxos.List = append(utxos.List, &Utxo{TxHash: txHash[:], Index: uint32(i), Value: uint64(out.Value)})
+		} else if deposit == nil && txscript.GetScriptClass(out.PkScript) == txscript.NullDataTy {
+			pushes, err := txscript.PushedData(out.PkScript)
+			if err != nil {
+				continue
+			}
+			data := new(DepositData)
+			if err := rlp.DecodeBytes(bytes.Join(pushes, nil), data); err == nil {
+				deposit = data
+			}
+		}
+	}
+	if amount == 0 {
+		return nil, fmt.Errorf("btc MakeDepositProposal, tx %s does not pay to federation", txHash)
+	}
+	if deposit == nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, deposit data not found in tx %s", txHash)
+	}
+	if err := putUtxos(service, params.SourceChainID, utxos); err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, putUtxos error: %v", err)
+	}
+
+	assetBind, err := side_chain_manager.GetAssetBind(service, params.SourceChainID)
+	if err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, GetAssetBind error: %v", err)
+	}
+	lockProxy, ok := assetBind.LockProxyMap[deposit.ToChainID]
+	if !ok {
+		return nil, fmt.Errorf("btc MakeDepositProposal, lock proxy map of chain %d is not registered", deposit.ToChainID)
+	}
+	asset, ok := assetBind.AssetMap[deposit.ToChainID]
+	if !ok {
+		return nil, fmt.Errorf("btc MakeDepositProposal, asset map of chain %d is not registered", deposit.ToChainID)
+	}
+	args, err := scom.EncodeTxArgs(&scom.TxArgs{
+		ToAssetHash: asset,
+		ToAddress:   deposit.ToAddress,
+		Amount:      new(big.Int).SetUint64(amount),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("btc MakeDepositProposal, EncodeTxArgs error: %v", err)
+	}
+	return &scom.MakeTxParam{
+		TxHash:              txHash[:],
+		CrossChainID:        txHash[:],
+		FromContractAddress: script[2:],
+		ToChainID:           deposit.ToChainID,
+		ToContractAddress:   lockProxy,
+		Method:              unlockMethod,
+		Args:                args,
+	}, nil
+}
+
+func verifyMerkleProof(txHash chainhash.Hash, proof *MerkleProof, root chainhash.Hash) error {
+	if len(proof.Siblings) >= 32 || proof.TxIndex>>uint(len(proof.Siblings)) != 0 {
+		return fmt.Errorf("tx index %d out of range", proof.TxIndex)
+	}
+	hash := txHash
+	var buf [chainhash.HashSize * 2]byte
+	for i, sibling := range proof.Siblings {
+		if len(sibling) != chainhash.HashSize {
+			return fmt.Errorf("invalid sibling length %d", len(sibling))
+		}
+		// the duplicated last node is rejected to avoid the CVE-2012-2459 forgery
+		if proof.TxIndex>>uint(i)&1 == 1 {
+			if bytes.Equal(sibling, hash[:]) {
+				return fmt.Errorf("duplicated sibling at level %d", i)
+			}
+			copy(buf[:], sibling)
+			copy(buf[chainhash.HashSize:], hash[:])
+		} else {
+			copy(buf[:], hash[:])
+			copy(buf[chainhash.HashSize:], sibling)
+		}
+		hash = chainhash.DoubleHashH(buf[:])
+	}
+	if hash != root {
+		return fmt.Errorf("merkle root %s does not match with %s", hash, root)
+	}
+	return nil
+}
+
+// MakeTransaction builds the withdraw tx spending the utxos of federation, the bitcoin fee calculated
+// with the fee rate (satoshi per vbyte) in side chain manager is deducted from the amount.
+func (this *BtcHandler) MakeTransaction(service *native.NativeContract, param *scom.MakeTxParam,
+	fromChainID uint64) error {
+
+	info, err := side_chain_manager.GetBtcExtraInfo(service, param.ToChainID)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, GetBtcExtraInfo error: %v", err)
+	}
+	net, err := GetNetParams(info.NetParams)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, %v", err)
+	}
+	script, err := witnessScript(info.RedeemScript)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, witnessScript error: %v", err)
+	}
+	if !bytes.Equal(param.ToContractAddress, script[2:]) {
+		return fmt.Errorf("btc MakeTransaction, to contract %x is not the federation %x", param.ToContractAddress, script[2:])
+	}
+	if param.Method != unlockMethod {
+		return fmt.Errorf("btc MakeTransaction, method %s is not supported", param.Method)
+	}
+	args, err := scom.DecodeTxArgs(param.Args)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, DecodeTxArgs error: %v", err)
+	}
+	if !args.Amount.IsUint64() {
+		return fmt.Errorf("btc MakeTransaction, amount %s overflows", args.Amount)
+	}
+	amount := args.Amount.Uint64()
+	addr, err := btcutil.DecodeAddress(string(args.ToAddress), net)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, decode address %s error: %v", args.ToAddress, err)
+	}
+	if !addr.IsForNet(net) {
+		return fmt.Errorf("btc MakeTransaction, address %s is not for %s", args.ToAddress, net.Name)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, PayToAddrScript error: %v", err)
+	}
+
+	feeRate, err := side_chain_manager.GetFeeObj(service, param.ToChainID)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, side_chain_manager.GetFeeObj error: %v", err)
+	}
+	if feeRate.View == 0 {
+		return fmt.Errorf("btc MakeTransaction, fee rate is not initialized")
+	}
+	_, quorum, err := txscript.CalcMultiSigStats(info.RedeemScript)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, CalcMultiSigStats error: %v", err)
+	}
+
+	// select the largest utxos first to keep the tx small
+	utxos, err := GetUtxos(service, param.ToChainID)
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, GetUtxos error: %v", err)
+	}
+	sort.SliceStable(utxos.List, func(i, j int) bool {
+		return utxos.List[i].Value > utxos.List[j].Value
+	})
+	tx := wire.NewMsgTx(wire.TxVersion)
+	var amounts []uint64
+	var total uint64
+	for _, utxo := range utxos.List {
+		if total >= amount {
+			break
+		}
+		hash, err := chainhash.NewHash(utxo.TxHash)
+		if err != nil {
+			return fmt.Errorf("btc MakeTransaction, invalid utxo hash: %v", err)
+		}
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, utxo.Index), nil, nil))
+		amounts = append(amounts, utxo.Value)
+		total += utxo.Value
+	}
+	if total < amount {
+		return fmt.Errorf("btc MakeTransaction, insufficient utxos %d for amount %d", total, amount)
+	}
+	utxos.List = utxos.List[len(amounts):]
+
+	fee := new(big.Int).Mul(feeRate.Fee, new(big.Int).SetUint64(estimateVSize(len(amounts), 2, quorum, len(info.RedeemScript))))
+	if !fee.IsUint64() || fee.Uint64()+dustLimit > amount {
+		return fmt.Errorf("btc MakeTransaction, amount %d is less than fee %s", amount, fee)
+	}
+	tx.AddTxOut(wire.NewTxOut(int64(amount-fee.Uint64()), pkScript))
+	if change := total - amount; change >= dustLimit {
+		tx.AddTxOut(wire.NewTxOut(int64(change), script))
+	}
+	txHash := tx.TxHash()
+	if len(tx.TxOut) > 1 {
+		utxos.List = append(utxos.List, &Utxo{TxHash: txHash[:], Index: 1, Value: uint64(tx.TxOut[1].Value)})
+	}
+	if err := putUtxos(service, param.ToChainID, utxos); err != nil {
+		return fmt.Errorf("btc MakeTransaction, putUtxos error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return fmt.Errorf("btc MakeTransaction, serialize tx error: %v", err)
+	}
+	withdraw := &WithdrawTx{
+		FromChainID: fromChainID,
+		TxHash:      param.TxHash,
+		Tx:          buf.Bytes(),
+		Amounts:     amounts,
+	}
+	if err := putWithdrawTx(service, param.ToChainID, txHash[:], withdraw); err != nil {
+		return fmt.Errorf("btc MakeTransaction, putWithdrawTx error: %v", err)
+	}
+	err = service.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventBtcTx}, fromChainID, param.ToChainID,
+		hex.EncodeToString(param.TxHash), hex.EncodeToString(buf.Bytes()))
+	if err != nil {
+		return fmt.Errorf("btc MakeTransaction, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+// estimateVSize estimates the virtual size of tx spending P2WSH multisig inputs, the signatures are
+// counted with the max length.
+func estimateVSize(inputs, outputs int, quorum int, redeemScriptLen int) uint64 {
+	base := 4 + 1 + 1 + 4 + inputs*(32+4+1+4) + outputs*(8+1+34)
+	witness := 2 + inputs*(1+1+quorum*(1+73)+wire.VarIntSerializeSize(uint64(redeemScriptLen))+redeemScriptLen)
+	return uint64((base*4 + witness + 3) / 4)
+}
+
+// MultiSign collects the signatures of federation member for all inputs of withdraw tx, the signed tx
+// is emitted once the quorum of redeem script is reached.
+func (this *BtcHandler) MultiSign(service *native.NativeContract) error {
+	ctx := service.ContractRef().CurrentContext()
+	params := &scom.MultiSignBtcParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodMultiSignBtc, params, ctx.Payload); err != nil {
+		return fmt.Errorf("btc MultiSign, unpack params error: %v", err)
+	}
+
+	info, err := side_chain_manager.GetBtcExtraInfo(service, params.ChainID)
+	if err != nil {
+		return fmt.Errorf("btc MultiSign, GetBtcExtraInfo error: %v", err)
+	}
+	withdraw, err := getWithdrawTx(service, params.ChainID, params.TxHash)
+	if err != nil {
+		return fmt.Errorf("btc MultiSign, getWithdrawTx error: %v", err)
+	}
+	if withdraw == nil {
+		return fmt.Errorf("btc MultiSign, withdraw tx %x not found", params.TxHash)
+	}
+	multisignInfo, err := getMultisignInfo(service, params.ChainID, params.TxHash)
+	if err != nil {
+		return fmt.Errorf("btc MultiSign, getMultisignInfo error: %v", err)
+	}
+	if multisignInfo.Status {
+		return nil
+	}
+
+	pubKeys, err := txscript.PushedData(info.RedeemScript)
+	if err != nil {
+		return fmt.Errorf("btc MultiSign, parse redeem script error: %v", err)
+	}
+	flag := false
+	for _, v := range pubKeys {
+		if bytes.Equal(v, params.PubKey) {
+			flag = true
+			break
+		}
+	}
+	if !flag {
+		return fmt.Errorf("btc MultiSign, signer is not in redeem script")
+	}
+	pubKey, err := btcec.ParsePubKey(params.PubKey, btcec.S256())
+	if err != nil {
+		return fmt.Errorf("btc MultiSign, parse public key error: %v", err)
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.Deserialize(bytes.NewReader(withdraw.Tx)); err != nil {
+		return fmt.Errorf("btc MultiSign, deserialize tx error: %v", err)
+	}
+	if len(params.Sigs) != len(tx.TxIn) {
+		return fmt.Errorf("btc MultiSign, signatures count %d does not match with inputs count %d", len(params.Sigs), len(tx.TxIn))
+	}
+	sigHashes := txscript.NewTxSigHashes(tx)
+	for i, sig := range params.Sigs {
+		if len(sig) == 0 || txscript.SigHashType(sig[len(sig)-1]) != txscript.SigHashAll {
+			return fmt.Errorf("btc MultiSign, signature of input %d is not SIGHASH_ALL", i)
+		}
+		hash, err := txscript.CalcWitnessSigHash(info.RedeemScript, sigHashes, txscript.SigHashAll, tx, i, int64(withdraw.Amounts[i]))
+		if err != nil {
+			return fmt.Errorf("btc MultiSign, CalcWitnessSigHash error: %v", err)
+		}
+		signature, err := btcec.ParseDERSignature(sig[:len(sig)-1], btcec.S256())
+		if err != nil {
+			return fmt.Errorf("btc MultiSign, parse signature of input %d error: %v", i, err)
+		}
+		if !signature.Verify(hash, pubKey) {
+			return fmt.Errorf("btc MultiSign, signature of input %d is invalid", i)
+		}
+	}
+	multisignInfo.put(params.PubKey, params.Sigs)
+
+	_, quorum, err := txscript.CalcMultiSigStats(info.RedeemScript)
+	if err != nil {
+		return fmt.Errorf("btc MultiSign, CalcMultiSigStats error: %v", err)
+	}
+	if len(multisignInfo.Sigs) >= quorum {
+		fillWitness(tx, info.RedeemScript, pubKeys, quorum, multisignInfo)
+		var buf bytes.Buffer
+		if err := tx.Serialize(&buf); err != nil {
+			return fmt.Errorf("btc MultiSign, serialize tx error: %v", err)
+		}
+		txHash := tx.TxHash()
+		err = service.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventBtcMultiSign}, params.ChainID,
+			txHash.String(), hex.EncodeToString(buf.Bytes()))
+		if err != nil {
+			return fmt.Errorf("btc MultiSign, AddNotify error: %v", err)
+		}
+		multisignInfo.Status = true
+	}
+	if err := putMultisignInfo(service, params.ChainID, params.TxHash, multisignInfo); err != nil {
+		return fmt.Errorf("btc MultiSign, putMultisignInfo error: %v", err)
+	}
+	return nil
+}
+
+// fillWitness fills the witness of inputs with the signatures ordered as the public keys in redeem script.
+func fillWitness(tx *wire.MsgTx, redeemScript []byte, pubKeys [][]byte, quorum int, multisignInfo *MultisignInfo) {
+	for i, in := range tx.TxIn {
+		witness := wire.TxWitness{nil}
+		for _, pk := range pubKeys {
+			if sigs := multisignInfo.get(pk); sigs != nil && len(witness) <= quorum {
+				witness = append(witness, sigs[i])
+			}
+		}
+		in.Witness = append(witness, redeemScript)
+	}
+}
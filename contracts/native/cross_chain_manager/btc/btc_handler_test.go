@@ -0,0 +1,321 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package btc
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	btcChainID = uint64(3)
+	dstChainID = uint64(2)
+)
+
+var net = &chaincfg.RegressionNetParams
+
+func newContract(sdb *state.StateDB, payload []byte) *native.NativeContract {
+	contractRef := native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 10000000, nil)
+	contractRef.PushContext(&native.Context{ContractAddress: utils.CrossChainManagerContractAddress, Payload: payload})
+	return native.NewNativeContract(sdb, contractRef)
+}
+
+// mine solves the header with the min difficulty of regtest
+func mine(prev chainhash.Hash, root chainhash.Hash, timestamp time.Time) []byte {
+	header := wire.NewBlockHeader(1, &prev, &root, net.PowLimitBits, 0)
+	header.Timestamp = timestamp
+	target := blockchain.CompactToBig(header.Bits)
+	for {
+		hash := header.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			break
+		}
+		header.Nonce++
+	}
+	var buf bytes.Buffer
+	header.Serialize(&buf)
+	return buf.Bytes()
+}
+
+func merkleProof(txs []*wire.MsgTx, index int) (chainhash.Hash, [][]byte) {
+	var level []chainhash.Hash
+	for _, tx := range txs {
+		level = append(level, tx.TxHash())
+	}
+	var siblings [][]byte
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		sibling := level[index^1]
+		siblings = append(siblings, sibling[:])
+		var next []chainhash.Hash
+		for i := 0; i < len(level); i += 2 {
+			next = append(next, chainhash.DoubleHashH(append(level[i][:], level[i+1][:]...)))
+		}
+		level, index = next, index/2
+	}
+	return level[0], siblings
+}
+
+func syncHeaders(t *testing.T, sdb *state.StateDB, headers ...[]byte) error {
+	payload, err := (&scom.SyncBtcHeadersParam{ChainID: btcChainID, Headers: headers}).Encode()
+	assert.Nil(t, err)
+	return SyncHeaders(newContract(sdb, payload))
+}
+
+func TestBtcHandler(t *testing.T) {
+	sdb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	var keys []*btcec.PrivateKey
+	var pubKeys []*btcutil.AddressPubKey
+	for i := 0; i < 3; i++ {
+		key, _ := btcec.PrivKeyFromBytes(btcec.S256(), bytes.Repeat([]byte{byte(i + 1)}, 32))
+		pub, err := btcutil.NewAddressPubKey(key.PubKey().SerializeCompressed(), net)
+		assert.Nil(t, err)
+		keys = append(keys, key)
+		pubKeys = append(pubKeys, pub)
+	}
+	redeemScript, err := txscript.MultiSigScript(pubKeys, 2)
+	assert.Nil(t, err)
+	federation, err := witnessScript(redeemScript)
+	assert.Nil(t, err)
+
+	var startHeader bytes.Buffer
+	assert.Nil(t, net.GenesisBlock.Header.Serialize(&startHeader))
+	extra, err := rlp.EncodeToBytes(&side_chain_manager.BtcExtraInfo{
+		NetParams:        net.Name,
+		RedeemScript:     redeemScript,
+		StartHeader:      startHeader.Bytes(),
+		MinConfirmations: 3,
+	})
+	assert.Nil(t, err)
+	contract := newContract(sdb, nil)
+	assert.Nil(t, side_chain_manager.PutSideChain(contract, &side_chain_manager.SideChain{
+		ChainID:   btcChainID,
+		Router:    utils.BTC_ROUTER,
+		Name:      "bitcoin",
+		ExtraInfo: extra,
+	}))
+	lockProxy, asset := common.HexToAddress("0x01").Bytes(), common.HexToAddress("0x02").Bytes()
+	assert.Nil(t, side_chain_manager.PutAssetBind(contract, btcChainID, &side_chain_manager.AssetBind{
+		AssetMap:     map[uint64][]byte{dstChainID: asset},
+		LockProxyMap: map[uint64][]byte{dstChainID: lockProxy},
+	}))
+	assert.Nil(t, side_chain_manager.PutFee(contract, btcChainID, &side_chain_manager.Fee{View: 1, Fee: big.NewInt(2)}))
+
+	// deposit tx in block 1
+	toAddress := common.HexToAddress("0x03").Bytes()
+	data, err := rlp.EncodeToBytes(&DepositData{ToChainID: dstChainID, ToAddress: toAddress})
+	assert.Nil(t, err)
+	opReturn, err := txscript.NullDataScript(data)
+	assert.Nil(t, err)
+	deposit := wire.NewMsgTx(wire.TxVersion)
+	deposit.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	deposit.AddTxOut(wire.NewTxOut(100000, federation))
+	deposit.AddTxOut(wire.NewTxOut(0, opReturn))
+	other := wire.NewMsgTx(wire.TxVersion)
+	other.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 0), nil, nil))
+	other.AddTxOut(wire.NewTxOut(1000, federation))
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0xffffffff), []byte{1, 2, 3}, nil))
+	root, siblings := merkleProof([]*wire.MsgTx{coinbase, deposit, other}, 1)
+
+	genesis := net.GenesisBlock.Header.BlockHash()
+	timestamp := net.GenesisBlock.Header.Timestamp
+	var headers [][]byte
+	prev := genesis
+	for i := 0; i < 3; i++ {
+		merkleRoot := chainhash.Hash{byte(i)}
+		if i == 0 {
+			merkleRoot = root
+		}
+		timestamp = timestamp.Add(10 * time.Minute)
+		headers = append(headers, mine(prev, merkleRoot, timestamp))
+		prev = chainhash.DoubleHashH(headers[i])
+	}
+	// parent missing
+	assert.NotNil(t, syncHeaders(t, sdb, headers[1]))
+	assert.Nil(t, syncHeaders(t, sdb, headers[:2]...))
+
+	var rawDeposit bytes.Buffer
+	assert.Nil(t, deposit.Serialize(&rawDeposit))
+	blockHash := chainhash.DoubleHashH(headers[0])
+	proof, err := rlp.EncodeToBytes(&MerkleProof{BlockHash: blockHash[:], TxIndex: 1, Siblings: siblings})
+	assert.Nil(t, err)
+	payload, err := (&scom.EntranceParam{SourceChainID: btcChainID, Extra: rawDeposit.Bytes(), Proof: proof}).Encode()
+	assert.Nil(t, err)
+
+	// not enough confirmations
+	_, err = NewBtcHandler().MakeDepositProposal(newContract(sdb, payload))
+	assert.NotNil(t, err)
+
+	assert.Nil(t, syncHeaders(t, sdb, headers[2]))
+	tip, err := GetBestTip(newContract(sdb, nil), btcChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), tip.Height)
+
+	txParam, err := NewBtcHandler().MakeDepositProposal(newContract(sdb, payload))
+	assert.Nil(t, err)
+	depositHash := deposit.TxHash()
+	assert.Equal(t, depositHash[:], txParam.CrossChainID)
+	assert.Equal(t, dstChainID, txParam.ToChainID)
+	assert.Equal(t, lockProxy, txParam.ToContractAddress)
+	args, err := scom.DecodeTxArgs(txParam.Args)
+	assert.Nil(t, err)
+	assert.Equal(t, &scom.TxArgs{ToAssetHash: asset, ToAddress: toAddress, Amount: big.NewInt(100000)}, args)
+
+	// replay
+	_, err = NewBtcHandler().MakeDepositProposal(newContract(sdb, payload))
+	assert.NotNil(t, err)
+
+	// wrong tx index
+	proof, err = rlp.EncodeToBytes(&MerkleProof{BlockHash: blockHash[:], TxIndex: 0, Siblings: siblings})
+	assert.Nil(t, err)
+	var rawOther bytes.Buffer
+	assert.Nil(t, other.Serialize(&rawOther))
+	payload, err = (&scom.EntranceParam{SourceChainID: btcChainID, Extra: rawOther.Bytes(), Proof: proof}).Encode()
+	assert.Nil(t, err)
+	_, err = NewBtcHandler().MakeDepositProposal(newContract(sdb, payload))
+	assert.NotNil(t, err)
+
+	// withdraw
+	contract = newContract(sdb, nil)
+	receiver, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(keys[0].PubKey().SerializeCompressed()), net)
+	assert.Nil(t, err)
+	withdrawArgs, err := scom.EncodeTxArgs(&scom.TxArgs{ToAddress: []byte(receiver.EncodeAddress()), Amount: big.NewInt(60000)})
+	assert.Nil(t, err)
+	withdrawParam := &scom.MakeTxParam{
+		TxHash:            []byte{1},
+		ToChainID:         btcChainID,
+		ToContractAddress: federation[2:],
+		Method:            unlockMethod,
+		Args:              withdrawArgs,
+	}
+	assert.Nil(t, NewBtcHandler().MakeTransaction(contract, withdrawParam, dstChainID))
+	utxos, err := GetUtxos(contract, btcChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(utxos.List))
+	assert.Equal(t, uint64(40000), utxos.List[0].Value)
+	withdrawHash := utxos.List[0].TxHash
+
+	withdraw, err := getWithdrawTx(contract, btcChainID, withdrawHash)
+	assert.Nil(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	assert.Nil(t, tx.Deserialize(bytes.NewReader(withdraw.Tx)))
+	assert.Equal(t, 1, len(tx.TxIn))
+	assert.Equal(t, depositHash, tx.TxIn[0].PreviousOutPoint.Hash)
+	fee := 2 * estimateVSize(1, 2, 2, len(redeemScript))
+	assert.Equal(t, int64(60000-fee), tx.TxOut[0].Value)
+
+	// insufficient utxos
+	withdrawArgs, err = scom.EncodeTxArgs(&scom.TxArgs{ToAddress: []byte(receiver.EncodeAddress()), Amount: big.NewInt(50000)})
+	assert.Nil(t, err)
+	withdrawParam.Args = withdrawArgs
+	assert.NotNil(t, NewBtcHandler().MakeTransaction(contract, withdrawParam, dstChainID))
+
+	sigHashes := txscript.NewTxSigHashes(tx)
+	sign := func(key *btcec.PrivateKey) [][]byte {
+		sig, err := txscript.RawTxInWitnessSignature(tx, sigHashes, 0, int64(withdraw.Amounts[0]), redeemScript, txscript.SigHashAll, key)
+		assert.Nil(t, err)
+		return [][]byte{sig}
+	}
+	multiSign := func(pubKey []byte, sigs [][]byte) error {
+		payload, err := (&scom.MultiSignBtcParam{ChainID: btcChainID, TxHash: withdrawHash, PubKey: pubKey, Sigs: sigs}).Encode()
+		assert.Nil(t, err)
+		return NewBtcHandler().MultiSign(newContract(sdb, payload))
+	}
+	assert.Nil(t, multiSign(pubKeys[0].ScriptAddress(), sign(keys[0])))
+	// signature of other key
+	assert.NotNil(t, multiSign(pubKeys[1].ScriptAddress(), sign(keys[0])))
+	// not a federation member
+	outsider, _ := btcec.PrivKeyFromBytes(btcec.S256(), bytes.Repeat([]byte{9}, 32))
+	assert.NotNil(t, multiSign(outsider.PubKey().SerializeCompressed(), sign(outsider)))
+
+	info, err := getMultisignInfo(newContract(sdb, nil), btcChainID, withdrawHash)
+	assert.Nil(t, err)
+	assert.False(t, info.Status)
+	assert.Nil(t, multiSign(pubKeys[2].ScriptAddress(), sign(keys[2])))
+	info, err = getMultisignInfo(newContract(sdb, nil), btcChainID, withdrawHash)
+	assert.Nil(t, err)
+	assert.True(t, info.Status)
+
+	// the signed tx is valid for bitcoin script engine
+	pushes, err := txscript.PushedData(redeemScript)
+	assert.Nil(t, err)
+	fillWitness(tx, redeemScript, pushes, 2, info)
+	engine, err := txscript.NewEngine(federation, tx, 0, txscript.StandardVerifyFlags, nil, sigHashes, int64(withdraw.Amounts[0]))
+	assert.Nil(t, err)
+	assert.Nil(t, engine.Execute())
+
+	// the fork with more work reorganizes the best chain
+	var fork [][]byte
+	prev, timestamp = genesis, net.GenesisBlock.Header.Timestamp
+	for i := 0; i < 4; i++ {
+		timestamp = timestamp.Add(10 * time.Minute)
+		fork = append(fork, mine(prev, chainhash.Hash{0xff, byte(i)}, timestamp))
+		prev = chainhash.DoubleHashH(fork[i])
+	}
+	assert.Nil(t, syncHeaders(t, sdb, fork[:3]...))
+	tip, err = GetBestTip(newContract(sdb, nil), btcChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, chainhash.DoubleHashH(headers[2]), tip.Hash())
+	assert.Nil(t, syncHeaders(t, sdb, fork[3]))
+	tip, err = GetBestTip(newContract(sdb, nil), btcChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(4), tip.Height)
+	assert.Equal(t, prev, tip.Hash())
+	_, err = checkInBestChain(newContract(sdb, nil), btcChainID, blockHash, 1)
+	assert.NotNil(t, err)
+	_, err = checkInBestChain(newContract(sdb, nil), btcChainID, chainhash.DoubleHashH(fork[0]), 4)
+	assert.Nil(t, err)
+
+	// invalid proof of work
+	header, err := decodeHeader(mine(prev, chainhash.Hash{}, timestamp.Add(time.Minute)))
+	assert.Nil(t, err)
+	for {
+		header.Nonce++
+		hash := header.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(blockchain.CompactToBig(header.Bits)) > 0 {
+			break
+		}
+	}
+	var invalid bytes.Buffer
+	assert.Nil(t, header.Serialize(&invalid))
+	assert.NotNil(t, syncHeaders(t, sdb, invalid.Bytes()))
+}
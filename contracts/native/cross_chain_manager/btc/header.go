@@ -0,0 +1,332 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package btc
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const (
+	// max headers synced in one tx, which bounds the storage access of method
+	maxHeadersPerSync = 100
+	medianTimeBlocks  = 11
+)
+
+// SyncHeaders appends the bitcoin headers to the header chain of side chain, anyone can sync since the
+// headers are verified by proof of work. the chain with most cumulative work is the best chain.
+func SyncHeaders(service *native.NativeContract) error {
+	ctx := service.ContractRef().CurrentContext()
+	params := &scom.SyncBtcHeadersParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSyncBtcHeaders, params, ctx.Payload); err != nil {
+		return fmt.Errorf("SyncHeaders, unpack params error: %v", err)
+	}
+	if len(params.Headers) == 0 || len(params.Headers) > maxHeadersPerSync {
+		return fmt.Errorf("SyncHeaders, headers count should be in [1, %d]", maxHeadersPerSync)
+	}
+
+	info, err := side_chain_manager.GetBtcExtraInfo(service, params.ChainID)
+	if err != nil {
+		return fmt.Errorf("SyncHeaders, GetBtcExtraInfo error: %v", err)
+	}
+	net, err := GetNetParams(info.NetParams)
+	if err != nil {
+		return fmt.Errorf("SyncHeaders, %v", err)
+	}
+	tip, err := GetBestTip(service, params.ChainID)
+	if err != nil {
+		return fmt.Errorf("SyncHeaders, GetBestTip error: %v", err)
+	}
+	if tip == nil {
+		if tip, err = initHeaders(service, params.ChainID, info); err != nil {
+			return fmt.Errorf("SyncHeaders, initHeaders error: %v", err)
+		}
+	}
+
+	for _, raw := range params.Headers {
+		header, err := decodeHeader(raw)
+		if err != nil {
+			return fmt.Errorf("SyncHeaders, decode header error: %v", err)
+		}
+		hash := header.BlockHash()
+		exist, err := getHeader(service, params.ChainID, hash)
+		if err != nil {
+			return fmt.Errorf("SyncHeaders, %v", err)
+		}
+		if exist != nil {
+			continue
+		}
+		parent, err := getHeader(service, params.ChainID, header.PrevBlock)
+		if err != nil {
+			return fmt.Errorf("SyncHeaders, %v", err)
+		}
+		if parent == nil {
+			return fmt.Errorf("SyncHeaders, parent %s of header %s not found", header.PrevBlock, hash)
+		}
+		if err := checkHeader(service, params.ChainID, net, parent, header); err != nil {
+			return fmt.Errorf("SyncHeaders, header %s is invalid: %v", hash, err)
+		}
+		node := &HeaderInfo{
+			Header: raw,
+			Height: parent.Height + 1,
+			Work:   new(big.Int).Add(parent.Work, blockchain.CalcWork(header.Bits)),
+		}
+		if err := putHeader(service, params.ChainID, node); err != nil {
+			return fmt.Errorf("SyncHeaders, %v", err)
+		}
+		if node.Work.Cmp(tip.Work) > 0 {
+			if err := reorganize(service, params.ChainID, tip, node); err != nil {
+				return fmt.Errorf("SyncHeaders, reorganize error: %v", err)
+			}
+			tip = node
+		}
+	}
+	return nil
+}
+
+// initHeaders stores the start header as the trusted checkpoint of header chain.
+func initHeaders(service *native.NativeContract, chainID uint64, info *side_chain_manager.BtcExtraInfo) (*HeaderInfo, error) {
+	header, err := decodeHeader(info.StartHeader)
+	if err != nil {
+		return nil, fmt.Errorf("decode start header error: %v", err)
+	}
+	node := &HeaderInfo{
+		Header: info.StartHeader,
+		Height: info.StartHeight,
+		Work:   blockchain.CalcWork(header.Bits),
+	}
+	if err := putHeader(service, chainID, node); err != nil {
+		return nil, err
+	}
+	hash := node.Hash()
+	putBestHash(service, chainID, node.Height, hash)
+	putBestTip(service, chainID, hash)
+	return node, nil
+}
+
+// reorganize switches the best chain index to the branch ending with `newTip`.
+func reorganize(service *native.NativeContract, chainID uint64, oldTip, newTip *HeaderInfo) error {
+	for height := newTip.Height + 1; height <= oldTip.Height; height++ {
+		delBestHash(service, chainID, height)
+	}
+	node := newTip
+	for {
+		hash := node.Hash()
+		best, err := getBestHash(service, chainID, node.Height)
+		if err != nil {
+			return err
+		}
+		if best != nil && *best == hash {
+			break
+		}
+		putBestHash(service, chainID, node.Height, hash)
+		header, err := node.BlockHeader()
+		if err != nil {
+			return err
+		}
+		if node, err = getHeader(service, chainID, header.PrevBlock); err != nil {
+			return err
+		}
+		if node == nil {
+			return fmt.Errorf("fork point of %s is beyond the start header", hash)
+		}
+	}
+	putBestTip(service, chainID, newTip.Hash())
+	return nil
+}
+
+// getAncestor returns the ancestor of node at height, the headers are walked back until the best chain
+// is reached, from where the best chain index is used.
+func getAncestor(service *native.NativeContract, chainID uint64, node *HeaderInfo, height uint32) (*HeaderInfo, error) {
+	for node.Height > height {
+		best, err := getBestHash(service, chainID, node.Height)
+		if err != nil {
+			return nil, err
+		}
+		if best != nil && *best == node.Hash() {
+			if best, err = getBestHash(service, chainID, height); err != nil {
+				return nil, err
+			}
+			if best == nil {
+				return nil, fmt.Errorf("ancestor at height %d is not synced", height)
+			}
+			return getHeader(service, chainID, *best)
+		}
+		header, err := node.BlockHeader()
+		if err != nil {
+			return nil, err
+		}
+		if node, err = getHeader(service, chainID, header.PrevBlock); err != nil {
+			return nil, err
+		}
+		if node == nil {
+			return nil, fmt.Errorf("ancestor at height %d is not synced", height)
+		}
+	}
+	return node, nil
+}
+
+func checkHeader(service *native.NativeContract, chainID uint64, net *chaincfg.Params, parent *HeaderInfo,
+	header *wire.BlockHeader) error {
+
+	target := blockchain.CompactToBig(header.Bits)
+	if target.Sign() <= 0 || target.Cmp(net.PowLimit) > 0 {
+		return fmt.Errorf("target %064x is out of range", target)
+	}
+	hash := header.BlockHash()
+	if blockchain.HashToBig(&hash).Cmp(target) > 0 {
+		return fmt.Errorf("hash is higher than target %064x", target)
+	}
+
+	bits, err := requiredBits(service, chainID, net, parent, header)
+	if err != nil {
+		return err
+	}
+	if header.Bits != bits {
+		return fmt.Errorf("bits %08x does not match with required %08x", header.Bits, bits)
+	}
+
+	median, err := medianTime(service, chainID, parent)
+	if err != nil {
+		return err
+	}
+	if !header.Timestamp.After(median) {
+		return fmt.Errorf("timestamp %v is not after median time %v", header.Timestamp, median)
+	}
+	return nil
+}
+
+// requiredBits calculates the difficulty of the header following parent, the same as bitcoin core.
+func requiredBits(service *native.NativeContract, chainID uint64, net *chaincfg.Params, parent *HeaderInfo,
+	header *wire.BlockHeader) (uint32, error) {
+
+	prev, err := parent.BlockHeader()
+	if err != nil {
+		return 0, err
+	}
+	// regtest does not retarget in bitcoin core
+	if net.Net == wire.TestNet {
+		return prev.Bits, nil
+	}
+	interval := uint32(net.TargetTimespan / net.TargetTimePerBlock)
+	if (parent.Height+1)%interval != 0 {
+		if !net.ReduceMinDifficulty {
+			return prev.Bits, nil
+		}
+		powLimitBits := blockchain.BigToCompact(net.PowLimit)
+		if header.Timestamp.After(prev.Timestamp.Add(net.MinDiffReductionTime)) {
+			return powLimitBits, nil
+		}
+		// the last header which is not mined with the min difficulty
+		node := parent
+		for node.Height%interval != 0 && prev.Bits == powLimitBits {
+			if node, err = getHeader(service, chainID, prev.PrevBlock); err != nil {
+				return 0, err
+			}
+			if node == nil {
+				break
+			}
+			if prev, err = node.BlockHeader(); err != nil {
+				return 0, err
+			}
+		}
+		return prev.Bits, nil
+	}
+
+	first, err := getAncestor(service, chainID, parent, parent.Height-(interval-1))
+	if err != nil {
+		return 0, err
+	}
+	firstHeader, err := first.BlockHeader()
+	if err != nil {
+		return 0, err
+	}
+	minTimespan := int64(net.TargetTimespan/time.Second) / net.RetargetAdjustmentFactor
+	maxTimespan := int64(net.TargetTimespan/time.Second) * net.RetargetAdjustmentFactor
+	timespan := prev.Timestamp.Unix() - firstHeader.Timestamp.Unix()
+	if timespan < minTimespan {
+		timespan = minTimespan
+	} else if timespan > maxTimespan {
+		timespan = maxTimespan
+	}
+	target := blockchain.CompactToBig(prev.Bits)
+	target.Mul(target, big.NewInt(timespan))
+	target.Div(target, big.NewInt(int64(net.TargetTimespan/time.Second)))
+	if target.Cmp(net.PowLimit) > 0 {
+		target.Set(net.PowLimit)
+	}
+	return blockchain.BigToCompact(target), nil
+}
+
+// medianTime returns the median timestamp of the last 11 headers ending with node, the headers before
+// the start header are not available, so the median is taken from fewer headers right after start.
+func medianTime(service *native.NativeContract, chainID uint64, node *HeaderInfo) (time.Time, error) {
+	timestamps := make([]int64, 0, medianTimeBlocks)
+	for i := 0; i < medianTimeBlocks && node != nil; i++ {
+		header, err := node.BlockHeader()
+		if err != nil {
+			return time.Time{}, err
+		}
+		timestamps = append(timestamps, header.Timestamp.Unix())
+		if node, err = getHeader(service, chainID, header.PrevBlock); err != nil {
+			return time.Time{}, err
+		}
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+	return time.Unix(timestamps[len(timestamps)/2], 0), nil
+}
+
+// checkInBestChain verifies the block is in the best chain with enough confirmations and returns its header.
+func checkInBestChain(service *native.NativeContract, chainID uint64, blockHash chainhash.Hash,
+	minConfirmations uint32) (*wire.BlockHeader, error) {
+
+	node, err := getHeader(service, chainID, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, fmt.Errorf("block %s is not synced", blockHash)
+	}
+	best, err := getBestHash(service, chainID, node.Height)
+	if err != nil {
+		return nil, err
+	}
+	if best == nil || *best != blockHash {
+		return nil, fmt.Errorf("block %s is not in the best chain", blockHash)
+	}
+	tip, err := GetBestTip(service, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if confirmations := tip.Height - node.Height + 1; confirmations < minConfirmations {
+		return nil, fmt.Errorf("block %s has %d confirmations, less than %d", blockHash, confirmations, minConfirmations)
+	}
+	return node.BlockHeader()
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package btc
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// HeaderInfo is the synced bitcoin header with its height and the cumulative work of the chain it ends.
+type HeaderInfo struct {
+	Header []byte
+	Height uint32
+	Work   *big.Int
+}
+
+func (m *HeaderInfo) BlockHeader() (*wire.BlockHeader, error) {
+	return decodeHeader(m.Header)
+}
+
+func (m *HeaderInfo) Hash() chainhash.Hash {
+	return chainhash.DoubleHashH(m.Header)
+}
+
+func (m *HeaderInfo) Decode(payload []byte) error {
+	var data struct {
+		Header []byte
+	}
+	if err := utils.UnpackOutputs(scom.ABI, scom.MethodGetBtcBestHeader, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Header, m)
+}
+
+func decodeHeader(raw []byte) (*wire.BlockHeader, error) {
+	if len(raw) != wire.MaxBlockHeaderPayload {
+		return nil, fmt.Errorf("invalid header length %d", len(raw))
+	}
+	header := new(wire.BlockHeader)
+	if err := header.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// Utxo is the unspent output held by the federation, `TxHash` is in the internal byte order.
+type Utxo struct {
+	TxHash []byte
+	Index  uint32
+	Value  uint64
+}
+
+type Utxos struct {
+	List []*Utxo
+}
+
+func (m *Utxos) Decode(payload []byte) error {
+	var data struct {
+		Utxos []byte
+	}
+	if err := utils.UnpackOutputs(scom.ABI, scom.MethodGetBtcUtxos, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Utxos, m)
+}
+
+// MerkleProof proves the inclusion of deposit tx in the block, `Siblings` are the hashes from the leaf to
+// the root in the internal byte order.
+type MerkleProof struct {
+	BlockHash []byte
+	TxIndex   uint32
+	Siblings  [][]byte
+}
+
+// DepositData is carried by the OP_RETURN output of deposit tx.
+type DepositData struct {
+	ToChainID uint64
+	ToAddress []byte
+}
+
+// WithdrawTx is the unsigned withdraw tx waiting for the signatures of federation, `Amounts` are the
+// values of the spent outputs which are committed by the witness signatures.
+type WithdrawTx struct {
+	FromChainID uint64
+	TxHash      []byte
+	Tx          []byte
+	Amounts     []uint64
+}
+
+type PubKeySigs struct {
+	PubKey []byte
+	Sigs   [][]byte
+}
+
+// MultisignInfo collects the signatures of every input of withdraw tx from the federation members.
+type MultisignInfo struct {
+	Status bool
+	Sigs   []*PubKeySigs
+}
+
+func (m *MultisignInfo) put(pubKey []byte, sigs [][]byte) {
+	for _, v := range m.Sigs {
+		if bytes.Equal(v.PubKey, pubKey) {
+			v.Sigs = sigs
+			return
+		}
+	}
+	m.Sigs = append(m.Sigs, &PubKeySigs{PubKey: pubKey, Sigs: sigs})
+}
+
+func (m *MultisignInfo) get(pubKey []byte) [][]byte {
+	for _, v := range m.Sigs {
+		if bytes.Equal(v.PubKey, pubKey) {
+			return v.Sigs
+		}
+	}
+	return nil
+}
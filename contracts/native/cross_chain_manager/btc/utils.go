@@ -0,0 +1,210 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package btc
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	BTC_HEADER         = "btcHeader"
+	BTC_BEST_CHAIN     = "btcBestChain"
+	BTC_BEST_TIP       = "btcBestTip"
+	BTC_UTXOS          = "btcUtxos"
+	BTC_TX_INFO        = "btcTxInfo"
+	BTC_MULTISIGN_INFO = "btcMultisignInfo"
+)
+
+func GetNetParams(name string) (*chaincfg.Params, error) {
+	switch name {
+	case chaincfg.MainNetParams.Name:
+		return &chaincfg.MainNetParams, nil
+	case chaincfg.TestNet3Params.Name:
+		return &chaincfg.TestNet3Params, nil
+	case chaincfg.RegressionNetParams.Name:
+		return &chaincfg.RegressionNetParams, nil
+	case chaincfg.SimNetParams.Name:
+		return &chaincfg.SimNetParams, nil
+	default:
+		return nil, fmt.Errorf("unknown bitcoin network %s", name)
+	}
+}
+
+// witnessScript returns the P2WSH output script of the federation redeem script.
+func witnessScript(redeemScript []byte) ([]byte, error) {
+	hash := sha256.Sum256(redeemScript)
+	return txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(hash[:]).Script()
+}
+
+func getHeader(native *native.NativeContract, chainID uint64, hash chainhash.Hash) (*HeaderInfo, error) {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_HEADER), utils.GetUint64Bytes(chainID), hash[:])
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("getHeader, get header store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	info := new(HeaderInfo)
+	if err := rlp.DecodeBytes(store, info); err != nil {
+		return nil, fmt.Errorf("getHeader, deserialize header error: %v", err)
+	}
+	return info, nil
+}
+
+func putHeader(native *native.NativeContract, chainID uint64, info *HeaderInfo) error {
+	hash := info.Hash()
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_HEADER), utils.GetUint64Bytes(chainID), hash[:])
+	blob, err := rlp.EncodeToBytes(info)
+	if err != nil {
+		return fmt.Errorf("putHeader, serialize header error: %v", err)
+	}
+	native.GetCacheDB().Put(key, blob)
+	return nil
+}
+
+func bestChainKey(chainID uint64, height uint32) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_BEST_CHAIN), utils.GetUint64Bytes(chainID),
+		utils.GetUint64Bytes(uint64(height)))
+}
+
+// getBestHash returns the hash of header at the height of best chain, or nil if not synced.
+func getBestHash(native *native.NativeContract, chainID uint64, height uint32) (*chainhash.Hash, error) {
+	store, err := native.GetCacheDB().Get(bestChainKey(chainID, height))
+	if err != nil {
+		return nil, fmt.Errorf("getBestHash, get best chain store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	return chainhash.NewHash(store)
+}
+
+func putBestHash(native *native.NativeContract, chainID uint64, height uint32, hash chainhash.Hash) {
+	native.GetCacheDB().Put(bestChainKey(chainID, height), hash[:])
+}
+
+func delBestHash(native *native.NativeContract, chainID uint64, height uint32) {
+	native.GetCacheDB().Delete(bestChainKey(chainID, height))
+}
+
+func GetBestTip(native *native.NativeContract, chainID uint64) (*HeaderInfo, error) {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_BEST_TIP), utils.GetUint64Bytes(chainID))
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetBestTip, get best tip store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	hash, err := chainhash.NewHash(store)
+	if err != nil {
+		return nil, fmt.Errorf("GetBestTip, invalid best tip: %v", err)
+	}
+	return getHeader(native, chainID, *hash)
+}
+
+func putBestTip(native *native.NativeContract, chainID uint64, hash chainhash.Hash) {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_BEST_TIP), utils.GetUint64Bytes(chainID))
+	native.GetCacheDB().Put(key, hash[:])
+}
+
+func GetUtxos(native *native.NativeContract, chainID uint64) (*Utxos, error) {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_UTXOS), utils.GetUint64Bytes(chainID))
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetUtxos, get utxos store error: %v", err)
+	}
+	utxos := new(Utxos)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, utxos); err != nil {
+			return nil, fmt.Errorf("GetUtxos, deserialize utxos error: %v", err)
+		}
+	}
+	return utxos, nil
+}
+
+func putUtxos(native *native.NativeContract, chainID uint64, utxos *Utxos) error {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_UTXOS), utils.GetUint64Bytes(chainID))
+	blob, err := rlp.EncodeToBytes(utxos)
+	if err != nil {
+		return fmt.Errorf("putUtxos, serialize utxos error: %v", err)
+	}
+	native.GetCacheDB().Put(key, blob)
+	return nil
+}
+
+func getWithdrawTx(native *native.NativeContract, chainID uint64, txHash []byte) (*WithdrawTx, error) {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_TX_INFO), utils.GetUint64Bytes(chainID), txHash)
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("getWithdrawTx, get withdraw tx store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	tx := new(WithdrawTx)
+	if err := rlp.DecodeBytes(store, tx); err != nil {
+		return nil, fmt.Errorf("getWithdrawTx, deserialize withdraw tx error: %v", err)
+	}
+	return tx, nil
+}
+
+func putWithdrawTx(native *native.NativeContract, chainID uint64, txHash []byte, tx *WithdrawTx) error {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_TX_INFO), utils.GetUint64Bytes(chainID), txHash)
+	blob, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return fmt.Errorf("putWithdrawTx, serialize withdraw tx error: %v", err)
+	}
+	native.GetCacheDB().Put(key, blob)
+	return nil
+}
+
+func getMultisignInfo(native *native.NativeContract, chainID uint64, txHash []byte) (*MultisignInfo, error) {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_MULTISIGN_INFO), utils.GetUint64Bytes(chainID), txHash)
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("getMultisignInfo, get multisign info store error: %v", err)
+	}
+	info := new(MultisignInfo)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, info); err != nil {
+			return nil, fmt.Errorf("getMultisignInfo, deserialize multisign info error: %v", err)
+		}
+	}
+	return info, nil
+}
+
+func putMultisignInfo(native *native.NativeContract, chainID uint64, txHash []byte, info *MultisignInfo) error {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(BTC_MULTISIGN_INFO), utils.GetUint64Bytes(chainID), txHash)
+	blob, err := rlp.EncodeToBytes(info)
+	if err != nil {
+		return fmt.Errorf("putMultisignInfo, serialize multisign info error: %v", err)
+	}
+	native.GetCacheDB().Put(key, blob)
+	return nil
+}
@@ -20,9 +20,11 @@ package common
 import (
 	"fmt"
 	"io"
+	"math/big"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
@@ -35,10 +37,29 @@ var (
 	MethodImportOuterTransfer = cross_chain_manager_abi.MethodImportOuterTransfer
 	MethodMultiSignRipple     = cross_chain_manager_abi.MethodMultiSignRipple
 	MethodReconstructRippleTx = cross_chain_manager_abi.MethodReconstructRippleTx
+	MethodRotateRippleSigners = cross_chain_manager_abi.MethodRotateRippleSigners
+	MethodBumpRippleFee       = cross_chain_manager_abi.MethodBumpRippleFee
+	MethodGetRippleTxRecord   = cross_chain_manager_abi.MethodGetRippleTxRecord
 	MethodCheckDone           = cross_chain_manager_abi.MethodCheckDone
 	MethodBlackChain          = cross_chain_manager_abi.MethodBlackChain
 	MethodWhiteChain          = cross_chain_manager_abi.MethodWhiteChain
 	MethodReplenish           = cross_chain_manager_abi.MethodReplenish
+	MethodSetFeeConfig        = cross_chain_manager_abi.MethodSetFeeConfig
+	MethodGetFeeConfig        = cross_chain_manager_abi.MethodGetFeeConfig
+	MethodGetFeePool          = cross_chain_manager_abi.MethodGetFeePool
+	MethodDistributeFee       = cross_chain_manager_abi.MethodDistributeFee
+	MethodSetRateLimit        = cross_chain_manager_abi.MethodSetRateLimit
+	MethodGetRateLimit        = cross_chain_manager_abi.MethodGetRateLimit
+	MethodSetTransferDelay    = cross_chain_manager_abi.MethodSetTransferDelay
+	MethodGetTransferDelay    = cross_chain_manager_abi.MethodGetTransferDelay
+	MethodCancelTransfer      = cross_chain_manager_abi.MethodCancelTransfer
+	MethodReleaseTransfer     = cross_chain_manager_abi.MethodReleaseTransfer
+	MethodGetPendingTransfer  = cross_chain_manager_abi.MethodGetPendingTransfer
+	MethodGetPendingTransfers = cross_chain_manager_abi.MethodGetPendingTransfers
+	MethodSyncBtcHeaders      = cross_chain_manager_abi.MethodSyncBtcHeaders
+	MethodMultiSignBtc        = cross_chain_manager_abi.MethodMultiSignBtc
+	MethodGetBtcBestHeader    = cross_chain_manager_abi.MethodGetBtcBestHeader
+	MethodGetBtcUtxos         = cross_chain_manager_abi.MethodGetBtcUtxos
 )
 
 var ABI *abi.ABI
@@ -186,6 +207,31 @@ func (m *ReconstructTxParam) Encode() ([]byte, error) {
 	return utils.PackMethodWithStruct(ABI, MethodReconstructRippleTx, m)
 }
 
+type RotateRippleSignersParam struct {
+	ChainID uint64
+	Pks     [][]byte
+	Quorum  uint64
+}
+
+func (m *RotateRippleSignersParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodRotateRippleSigners, m)
+}
+
+type BumpRippleFeeParam struct {
+	FromChainId uint64
+	TxHash      []byte
+	ToChainId   uint64
+}
+
+func (m *BumpRippleFeeParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodBumpRippleFee, m)
+}
+
+type GetRippleTxRecordParam struct {
+	ChainID  uint64
+	Sequence uint32
+}
+
 func (m *ReconstructTxParam) EncodeRLP(w io.Writer) error {
 	return rlp.Encode(w, []interface{}{m.FromChainId, m.TxHash, m.ToChainId})
 }
@@ -217,3 +263,94 @@ type ReplenishParam struct {
 func (m *ReplenishParam) Encode() ([]byte, error) {
 	return utils.PackMethodWithStruct(ABI, MethodReplenish, m)
 }
+
+type SetFeeConfigParam struct {
+	VoterRate     uint64
+	RelayerRate   uint64
+	CommunityRate uint64
+	Period        uint64
+}
+
+func (m *SetFeeConfigParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodSetFeeConfig, m)
+}
+
+type GetFeePoolParam struct {
+	Token common.Address
+}
+
+func (m *GetFeePoolParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodGetFeePool, m)
+}
+
+type SetRateLimitParam struct {
+	ChainID        uint64
+	Asset          []byte
+	Window         uint64
+	Limit          *big.Int
+	PauseThreshold *big.Int
+}
+
+func (m *SetRateLimitParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodSetRateLimit, m)
+}
+
+type GetRateLimitParam struct {
+	ChainID uint64
+	Asset   []byte
+}
+
+func (m *GetRateLimitParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodGetRateLimit, m)
+}
+
+type SetTransferDelayParam struct {
+	ChainID   uint64
+	Asset     []byte
+	Threshold *big.Int
+	Delay     uint64
+}
+
+func (m *SetTransferDelayParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodSetTransferDelay, m)
+}
+
+type GetTransferDelayParam struct {
+	ChainID uint64
+	Asset   []byte
+}
+
+func (m *GetTransferDelayParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodGetTransferDelay, m)
+}
+
+// PendingTransferParam is used by `cancelTransfer`, `releaseTransfer` and `getPendingTransfer`
+type PendingTransferParam struct {
+	ChainID      uint64
+	CrossChainID []byte
+}
+
+type SyncBtcHeadersParam struct {
+	ChainID uint64
+	Headers [][]byte
+}
+
+func (m *SyncBtcHeadersParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodSyncBtcHeaders, m)
+}
+
+type MultiSignBtcParam struct {
+	ChainID uint64
+	TxHash  []byte
+	PubKey  []byte
+	Sigs    [][]byte
+}
+
+func (m *MultiSignBtcParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodMultiSignBtc, m)
+}
+
+// BtcChainIDParam is used by `getBtcBestHeader` and `getBtcUtxos`
+type BtcChainIDParam struct {
+	ChainID uint64
+}
@@ -18,6 +18,9 @@
 package common
 
 import (
+	"encoding/binary"
+	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -130,43 +133,175 @@ type ToMerkleValue struct {
 	MakeTxParam *MakeTxParam
 }
 
+// RippleTxArgs is the args of transfer to ripple, `DestinationTag` and `Memo` are required by some
+// receivers such as exchanges. they are appended to the abi encoding only when set, so that the args
+// without them are compatible with the lock proxies decoding (bytes, int).
 type RippleTxArgs struct {
-	ToAddress []byte
-	Amount    *big.Int
+	ToAddress         []byte
+	Amount            *big.Int
+	HasDestinationTag bool   `rlp:"optional"`
+	DestinationTag    uint32 `rlp:"optional"`
+	Memo              []byte `rlp:"optional"`
 }
 
-func DecodeRippleTxArgs(data []byte) (param *RippleTxArgs, err error) {
+func rippleTxArgsABI(extended bool) abi.Arguments {
 	BytesTy, _ := abi.NewType("bytes", "", nil)
 	IntTy, _ := abi.NewType("int", "", nil)
-	// StringTy, _ := abi.NewType("string", "", nil)
+	BoolTy, _ := abi.NewType("bool", "", nil)
+	Uint32Ty, _ := abi.NewType("uint32", "", nil)
 
 	Args := abi.Arguments{
 		{Type: BytesTy, Name: "toAddress"},
 		{Type: IntTy, Name: "amount"},
 	}
+	if extended {
+		Args = append(Args,
+			abi.Argument{Type: BoolTy, Name: "hasDestinationTag"},
+			abi.Argument{Type: Uint32Ty, Name: "destinationTag"},
+			abi.Argument{Type: BytesTy, Name: "memo"},
+		)
+	}
+	return Args
+}
 
-	args, err := Args.Unpack(data)
-	if err != nil {
-		return
+func DecodeRippleTxArgs(data []byte) (param *RippleTxArgs, err error) {
+	// the extended args are tried first, since the abi decoding ignores the trailing data
+	for _, extended := range []bool{true, false} {
+		Args := rippleTxArgsABI(extended)
+		args, err := Args.Unpack(data)
+		if err != nil {
+			continue
+		}
+		param = new(RippleTxArgs)
+		if err = Args.Copy(param, args); err != nil {
+			return nil, err
+		}
+		return param, nil
 	}
+	return nil, fmt.Errorf("DecodeRippleTxArgs, invalid ripple tx args")
+}
 
-	param = new(RippleTxArgs)
-	err = Args.Copy(param, args)
+func EncodeRippleTxArgs(args *RippleTxArgs) (data []byte, err error) {
+	if !args.HasDestinationTag && len(args.Memo) == 0 {
+		return rippleTxArgsABI(false).Pack(args.ToAddress, args.Amount)
+	}
+	memo := args.Memo
+	if memo == nil {
+		memo = []byte{}
+	}
+	return rippleTxArgsABI(true).Pack(args.ToAddress, args.Amount, args.HasDestinationTag, args.DestinationTag, memo)
+}
+
+// TxArgs is the args of `unlock` method of lock proxy, serialized in poly zero copy format.
+type TxArgs struct {
+	ToAssetHash []byte
+	ToAddress   []byte
+	Amount      *big.Int
+}
+
+func DecodeTxArgs(data []byte) (*TxArgs, error) {
+	source := &zeroCopySource{data: data}
+	args := new(TxArgs)
+	var err error
+	if args.ToAssetHash, err = source.nextVarBytes(); err != nil {
+		return nil, fmt.Errorf("DecodeTxArgs, read to asset hash error: %v", err)
+	}
+	if args.ToAddress, err = source.nextVarBytes(); err != nil {
+		return nil, fmt.Errorf("DecodeTxArgs, read to address error: %v", err)
+	}
+	// uint256 in little endian
+	amount, err := source.next(32)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("DecodeTxArgs, read amount error: %v", err)
 	}
-	return
+	be := make([]byte, len(amount))
+	for i, b := range amount {
+		be[len(amount)-1-i] = b
+	}
+	args.Amount = new(big.Int).SetBytes(be)
+	return args, nil
 }
 
-func EncodeRippleTxArgs(args *RippleTxArgs) (data []byte, err error) {
-	BytesTy, _ := abi.NewType("bytes", "", nil)
-	IntTy, _ := abi.NewType("int", "", nil)
+func EncodeTxArgs(args *TxArgs) ([]byte, error) {
+	if args.Amount == nil || args.Amount.Sign() < 0 || args.Amount.BitLen() > 256 {
+		return nil, fmt.Errorf("EncodeTxArgs, invalid amount %v", args.Amount)
+	}
+	data := writeVarBytes(nil, args.ToAssetHash)
+	data = writeVarBytes(data, args.ToAddress)
+	be := args.Amount.Bytes()
+	amount := make([]byte, 32)
+	for i, b := range be {
+		amount[len(be)-1-i] = b
+	}
+	return append(data, amount...), nil
+}
 
-	Args := abi.Arguments{
-		{Type: BytesTy, Name: "toAddress"},
-		{Type: IntTy, Name: "amount"},
+func writeVarBytes(data []byte, b []byte) []byte {
+	size := uint64(len(b))
+	var buf [9]byte
+	switch {
+	case size < 0xfd:
+		data = append(data, byte(size))
+	case size <= 0xffff:
+		buf[0] = 0xfd
+		binary.LittleEndian.PutUint16(buf[1:], uint16(size))
+		data = append(data, buf[:3]...)
+	case size <= 0xffffffff:
+		buf[0] = 0xfe
+		binary.LittleEndian.PutUint32(buf[1:], uint32(size))
+		data = append(data, buf[:5]...)
+	default:
+		buf[0] = 0xff
+		binary.LittleEndian.PutUint64(buf[1:], size)
+		data = append(data, buf[:]...)
 	}
+	return append(data, b...)
+}
 
-	data, err = Args.Pack(args.ToAddress, args.Amount)
-	return
+type zeroCopySource struct {
+	data []byte
+	off  int
+}
+
+func (s *zeroCopySource) next(n int) ([]byte, error) {
+	if n < 0 || len(s.data)-s.off < n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	data := s.data[s.off : s.off+n]
+	s.off += n
+	return data, nil
+}
+
+func (s *zeroCopySource) nextVarBytes() ([]byte, error) {
+	prefix, err := s.next(1)
+	if err != nil {
+		return nil, err
+	}
+	var size uint64
+	switch prefix[0] {
+	case 0xfd:
+		b, err := s.next(2)
+		if err != nil {
+			return nil, err
+		}
+		size = uint64(binary.LittleEndian.Uint16(b))
+	case 0xfe:
+		b, err := s.next(4)
+		if err != nil {
+			return nil, err
+		}
+		size = uint64(binary.LittleEndian.Uint32(b))
+	case 0xff:
+		b, err := s.next(8)
+		if err != nil {
+			return nil, err
+		}
+		size = binary.LittleEndian.Uint64(b)
+	default:
+		size = uint64(prefix[0])
+	}
+	if size > uint64(len(s.data)-s.off) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return s.next(int(size))
 }
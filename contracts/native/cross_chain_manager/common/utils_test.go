@@ -57,3 +57,65 @@ func TestUint256ToBytes(t *testing.T) {
 		assert.Equal(t, v.Expect, gotNum)
 	}
 }
+
+func TestDecodeTxArgs(t *testing.T) {
+	asset := common.HexToAddress("0x0000000000000000000000000000000000000a0a")
+	to := common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	amount := make([]byte, 32)
+	amount[0], amount[1] = 0x10, 0x27 // 10000 in little endian
+
+	var data []byte
+	data = append(data, byte(len(asset)))
+	data = append(data, asset.Bytes()...)
+	data = append(data, 0xfd, byte(len(to)), 0)
+	data = append(data, to.Bytes()...)
+	data = append(data, amount...)
+
+	args, err := DecodeTxArgs(data)
+	assert.Nil(t, err)
+	assert.Equal(t, asset.Bytes(), args.ToAssetHash)
+	assert.Equal(t, to.Bytes(), args.ToAddress)
+	assert.Equal(t, big.NewInt(10000), args.Amount)
+
+	_, err = DecodeTxArgs(data[:len(data)-1])
+	assert.NotNil(t, err)
+}
+
+func TestEncodeTxArgs(t *testing.T) {
+	args := &TxArgs{
+		ToAssetHash: common.HexToAddress("0x0a").Bytes(),
+		ToAddress:   make([]byte, 300),
+		Amount:      big.NewInt(123456789),
+	}
+	data, err := EncodeTxArgs(args)
+	assert.Nil(t, err)
+	decoded, err := DecodeTxArgs(data)
+	assert.Nil(t, err)
+	assert.Equal(t, args, decoded)
+
+	_, err = EncodeTxArgs(&TxArgs{Amount: big.NewInt(-1)})
+	assert.NotNil(t, err)
+}
+
+func TestRippleTxArgs(t *testing.T) {
+	args := &RippleTxArgs{ToAddress: common.HexToAddress("0x01").Bytes(), Amount: big.NewInt(1000)}
+	data, err := EncodeRippleTxArgs(args)
+	assert.Nil(t, err)
+	// the args without tag and memo keep the encoding of (bytes, int)
+	assert.Equal(t, 4*32, len(data))
+	decoded, err := DecodeRippleTxArgs(data)
+	assert.Nil(t, err)
+	assert.Equal(t, args.ToAddress, decoded.ToAddress)
+	assert.Equal(t, args.Amount, decoded.Amount)
+	assert.False(t, decoded.HasDestinationTag)
+
+	args.HasDestinationTag, args.DestinationTag, args.Memo = true, 0, []byte("memo")
+	data, err = EncodeRippleTxArgs(args)
+	assert.Nil(t, err)
+	decoded, err = DecodeRippleTxArgs(data)
+	assert.Nil(t, err)
+	assert.Equal(t, args, decoded)
+
+	_, err = DecodeRippleTxArgs(data[:64])
+	assert.NotNil(t, err)
+}
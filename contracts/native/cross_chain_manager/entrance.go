@@ -21,13 +21,16 @@ package cross_chain_manager
 import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/btc"
 	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/eth_common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/eth_receipt"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/no_proof"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/ripple"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 const contractName = "cross chain manager"
@@ -49,6 +52,25 @@ var (
 		scom.MethodReplenish:           100000,
 		scom.MethodMultiSignRipple:     100000,
 		scom.MethodReconstructRippleTx: 300000,
+		scom.MethodRotateRippleSigners: 152250,
+		scom.MethodBumpRippleFee:       300000,
+		scom.MethodGetRippleTxRecord:   57750,
+		scom.MethodSyncBtcHeaders:      500000,
+		scom.MethodMultiSignBtc:        200000,
+		scom.MethodGetBtcBestHeader:    57750,
+		scom.MethodGetBtcUtxos:         57750,
+		scom.MethodSetFeeConfig:        152250,
+		scom.MethodGetFeeConfig:        57750,
+		scom.MethodGetFeePool:          57750,
+		scom.MethodDistributeFee:       300000,
+		scom.MethodSetRateLimit:        152250,
+		scom.MethodGetRateLimit:        57750,
+		scom.MethodSetTransferDelay:    152250,
+		scom.MethodGetTransferDelay:    57750,
+		scom.MethodCancelTransfer:      152250,
+		scom.MethodReleaseTransfer:     300000,
+		scom.MethodGetPendingTransfer:  57750,
+		scom.MethodGetPendingTransfers: 57750,
 	}
 )
 
@@ -66,9 +88,36 @@ func RegisterCrossChainManagerContract(s *native.NativeContract) {
 	s.Register(scom.MethodCheckDone, CheckDone)
 	s.Register(scom.MethodReplenish, Replenish)
 
+	// fee
+	s.Register(scom.MethodSetFeeConfig, SetFeeConfig)
+	s.Register(scom.MethodGetFeeConfig, GetFeeConfig)
+	s.Register(scom.MethodGetFeePool, GetFeePool)
+	s.Register(scom.MethodDistributeFee, DistributeFee)
+
+	// rate limit
+	s.Register(scom.MethodSetRateLimit, SetRateLimit)
+	s.Register(scom.MethodGetRateLimit, GetRateLimit)
+
+	// pending queue
+	s.Register(scom.MethodSetTransferDelay, SetTransferDelay)
+	s.Register(scom.MethodGetTransferDelay, GetTransferDelay)
+	s.Register(scom.MethodCancelTransfer, CancelTransfer)
+	s.Register(scom.MethodReleaseTransfer, ReleaseTransfer)
+	s.Register(scom.MethodGetPendingTransfer, GetPendingTransfer)
+	s.Register(scom.MethodGetPendingTransfers, GetPendingTransfers)
+
 	// ripple
 	s.Register(scom.MethodMultiSignRipple, MultiSignRipple)
 	s.Register(scom.MethodReconstructRippleTx, ReconstructRippleTx)
+	s.Register(scom.MethodRotateRippleSigners, RotateRippleSigners)
+	s.Register(scom.MethodBumpRippleFee, BumpRippleFee)
+	s.Register(scom.MethodGetRippleTxRecord, GetRippleTxRecord)
+
+	// btc
+	s.Register(scom.MethodSyncBtcHeaders, SyncBtcHeaders)
+	s.Register(scom.MethodMultiSignBtc, MultiSignBtc)
+	s.Register(scom.MethodGetBtcBestHeader, GetBtcBestHeader)
+	s.Register(scom.MethodGetBtcUtxos, GetBtcUtxos)
 }
 
 func GetChainHandler(router uint64) (scom.ChainHandler, error) {
@@ -79,6 +128,10 @@ func GetChainHandler(router uint64) (scom.ChainHandler, error) {
 		return eth_common.NewHandler(), nil
 	case utils.RIPPLE_ROUTER:
 		return ripple.NewRippleHandler(), nil
+	case utils.ETH_RECEIPT_ROUTER:
+		return eth_receipt.NewHandler(), nil
+	case utils.BTC_ROUTER:
+		return btc.NewBtcHandler(), nil
 	default:
 		return nil, fmt.Errorf("not a supported router:%d", router)
 	}
@@ -141,6 +194,10 @@ func ImportOuterTransfer(s *native.NativeContract) ([]byte, error) {
 	}
 
 	if txParam == nil {
+		// the fee is charged only when the transfer is made
+		if err := refundFee(s); err != nil {
+			return nil, fmt.Errorf("ImportExTransfer, refundFee error: %v", err)
+		}
 		return utils.PackOutputs(scom.ABI, scom.MethodImportOuterTransfer, true)
 	}
 
@@ -161,6 +218,40 @@ func ImportOuterTransfer(s *native.NativeContract) ([]byte, error) {
 		return nil, fmt.Errorf("ImportExTransfer, side chain %d is not registered", dstChainID)
 	}
 
+	tripped, err := checkRateLimit(s, srcChainID, txParam, dstChain.Router)
+	if err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, checkRateLimit error: %v", err)
+	}
+
+	deducted, err := feeDeducted(s, dstChain)
+	if err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, feeDeducted error: %v", err)
+	}
+	if deducted {
+		if err := refundFee(s); err != nil {
+			return nil, fmt.Errorf("ImportExTransfer, refundFee error: %v", err)
+		}
+	} else if err := chargeFee(s, dstChainID); err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, chargeFee error: %v", err)
+	}
+
+	// the transfer tripping the circuit breaker is held until governance resumes the chain
+	if tripped {
+		if err := parkTransfer(s, srcChainID, txParam, dstChain.Router, s.ContractRef().BlockHeight().Uint64()); err != nil {
+			return nil, fmt.Errorf("ImportExTransfer, parkTransfer error: %v", err)
+		}
+		return utils.PackOutputs(scom.ABI, scom.MethodImportOuterTransfer, true)
+	}
+
+	// large transfer is parked in pending queue and made after delay
+	queued, err := queueTransfer(s, srcChainID, txParam, dstChain.Router)
+	if err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, queueTransfer error: %v", err)
+	}
+	if queued {
+		return utils.PackOutputs(scom.ABI, scom.MethodImportOuterTransfer, true)
+	}
+
 	if dstChain.Router == utils.RIPPLE_ROUTER {
 		err := ripple.NewRippleHandler().MakeTransaction(s, txParam, srcChainID)
 		if err != nil {
@@ -169,6 +260,13 @@ func ImportOuterTransfer(s *native.NativeContract) ([]byte, error) {
 		return utils.BYTE_TRUE, nil
 	}
 
+	if dstChain.Router == utils.BTC_ROUTER {
+		if err := btc.NewBtcHandler().MakeTransaction(s, txParam, srcChainID); err != nil {
+			return nil, err
+		}
+		return utils.PackOutputs(scom.ABI, scom.MethodImportOuterTransfer, true)
+	}
+
 	//NOTE, you need to store the tx in this
 	if err := scom.MakeTransaction(s, txParam, srcChainID); err != nil {
 		return nil, err
@@ -198,6 +296,93 @@ func ReconstructRippleTx(s *native.NativeContract) ([]byte, error) {
 	return utils.BYTE_TRUE, nil
 }
 
+func RotateRippleSigners(s *native.NativeContract) ([]byte, error) {
+	err := ripple.NewRippleHandler().RotateSigners(s)
+	if err != nil {
+		return utils.BYTE_FALSE, err
+	}
+	return utils.BYTE_TRUE, nil
+}
+
+func BumpRippleFee(s *native.NativeContract) ([]byte, error) {
+	err := ripple.NewRippleHandler().BumpFee(s)
+	if err != nil {
+		return utils.BYTE_FALSE, err
+	}
+	return utils.BYTE_TRUE, nil
+}
+
+func GetRippleTxRecord(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.GetRippleTxRecordParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodGetRippleTxRecord, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetRippleTxRecord, unpack params error: %v", err)
+	}
+	record, err := ripple.GetRippleTxRecord(s, params.ChainID, params.Sequence)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleTxRecord, ripple.GetRippleTxRecord error: %v", err)
+	}
+	if record == nil {
+		return nil, fmt.Errorf("GetRippleTxRecord, record of chain %d sequence %d is not found", params.ChainID, params.Sequence)
+	}
+	enc, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleTxRecord, serialize record error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetRippleTxRecord, enc)
+}
+
+func SyncBtcHeaders(s *native.NativeContract) ([]byte, error) {
+	if err := btc.SyncHeaders(s); err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSyncBtcHeaders, true)
+}
+
+func MultiSignBtc(s *native.NativeContract) ([]byte, error) {
+	if err := btc.NewBtcHandler().MultiSign(s); err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodMultiSignBtc, true)
+}
+
+func GetBtcBestHeader(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.BtcChainIDParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodGetBtcBestHeader, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetBtcBestHeader, unpack params error: %v", err)
+	}
+	tip, err := btc.GetBestTip(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetBtcBestHeader, GetBestTip error: %v", err)
+	}
+	if tip == nil {
+		return nil, fmt.Errorf("GetBtcBestHeader, headers of chain %d are not synced", params.ChainID)
+	}
+	enc, err := rlp.EncodeToBytes(tip)
+	if err != nil {
+		return nil, fmt.Errorf("GetBtcBestHeader, serialize header error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetBtcBestHeader, enc)
+}
+
+func GetBtcUtxos(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.BtcChainIDParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodGetBtcUtxos, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetBtcUtxos, unpack params error: %v", err)
+	}
+	utxos, err := btc.GetUtxos(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetBtcUtxos, GetUtxos error: %v", err)
+	}
+	enc, err := rlp.EncodeToBytes(utxos)
+	if err != nil {
+		return nil, fmt.Errorf("GetBtcUtxos, serialize utxos error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetBtcUtxos, enc)
+}
+
 func BlackChain(s *native.NativeContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
 	params := &scom.BlackChainParam{}
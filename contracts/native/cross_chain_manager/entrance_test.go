@@ -19,6 +19,7 @@
 package cross_chain_manager
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"encoding/hex"
 	"encoding/json"
@@ -29,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/ripple"
 	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/go_abi/side_chain_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
@@ -40,6 +42,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/rubblelabs/ripple/data"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -349,3 +352,381 @@ func TestBlackChain(t *testing.T) {
 	}
 	tr.Dump()
 }
+
+func TestFee(t *testing.T) {
+	relayer := common.HexToAddress("0x0000000000000000000000000000000000000fee")
+	fee := big.NewInt(100)
+	paid := big.NewInt(150)
+
+	newContract := func(height int64, value *big.Int) *native.NativeContract {
+		contractRef := native.NewContractRef(sdb, relayer, relayer, big.NewInt(height), common.Hash{}, 10000000, nil)
+		contractRef.SetValue(value)
+		contractRef.PushContext(&native.Context{Caller: relayer, ContractAddress: this})
+		return native.NewNativeContract(sdb, contractRef)
+	}
+
+	contract := newContract(1, nil)
+	assert.Nil(t, side_chain_manager.PutFee(contract, 2, &side_chain_manager.Fee{View: 1, Fee: fee}))
+
+	// insufficient fee
+	contract = newContract(1, big.NewInt(50))
+	assert.NotNil(t, chargeFee(contract, 2))
+
+	// the value is transferred to the contract by evm before the native call
+	sdb.AddBalance(this, paid)
+	contract = newContract(1, paid)
+	assert.Nil(t, chargeFee(contract, 2))
+
+	pool, err := getFeePool(contract, common.EmptyAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, paid, pool.Amount)
+	relayers, err := getRelayerFees(contract, common.EmptyAddress, pool.RelayerCount)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(relayers))
+	assert.Equal(t, relayer, relayers[0].Relayer)
+	assert.Equal(t, paid, relayers[0].Amount)
+
+	// fee token should be paid without value
+	token := common.HexToAddress("0x0000000000000000000000000000000000000a0a")
+	side_chain_manager.PutFeeToken(contract, 2, token)
+	assert.NotNil(t, chargeFee(contract, 2))
+	side_chain_manager.PutFeeToken(contract, 2, common.EmptyAddress)
+
+	input, err := utils.PackMethod(scom.ABI, cross_chain_manager_abi.MethodDistributeFee)
+	assert.Nil(t, err)
+
+	// too early to distribute
+	contractRef := native.NewContractRef(sdb, relayer, relayer, big.NewInt(1), common.Hash{}, 10000000, nil)
+	_, _, err = contractRef.NativeCall(relayer, utils.CrossChainManagerContractAddress, input)
+	assert.NotNil(t, err)
+
+	communityAddr := common.EmptyAddress
+	balances := make(map[common.Address]*big.Int)
+	for _, addr := range append([]common.Address{relayer, communityAddr}, signers...) {
+		balances[addr] = sdb.GetBalance(addr)
+	}
+
+	height := int64(DefaultFeeConfig.Period)
+	contractRef = native.NewContractRef(sdb, relayer, relayer, big.NewInt(height), common.Hash{}, 10000000, nil)
+	_, _, err = contractRef.NativeCall(relayer, utils.CrossChainManagerContractAddress, input)
+	assert.Nil(t, err)
+
+	// 40% to 2 voters, 40% to the relayer and 20% to community
+	for _, signer := range signers {
+		assert.Equal(t, new(big.Int).Add(balances[signer], big.NewInt(30)), sdb.GetBalance(signer))
+	}
+	assert.Equal(t, new(big.Int).Add(balances[relayer], big.NewInt(60)), sdb.GetBalance(relayer))
+	assert.Equal(t, new(big.Int).Add(balances[communityAddr], big.NewInt(30)), sdb.GetBalance(communityAddr))
+
+	// the event carries the credited amounts
+	logs := sdb.Logs()
+	event, err := scom.ABI.Unpack(cross_chain_manager_abi.EventDistributeFee, logs[len(logs)-1].Data)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{common.EmptyAddress, big.NewInt(60), big.NewInt(60), big.NewInt(30)}, event)
+
+	contract = newContract(height, nil)
+	pool, err = getFeePool(contract, common.EmptyAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, pool.Amount.Sign())
+	assert.Equal(t, uint64(0), pool.RelayerCount)
+	paidFee, err := getRelayerFee(contract, common.EmptyAddress, relayer)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, paidFee.Sign())
+	config, err := GetFeeConfigObj(contract)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(height), config.LastDistribution)
+}
+
+func TestRateLimit(t *testing.T) {
+	var chainID uint64 = 2
+	asset := common.HexToAddress("0x0000000000000000000000000000000000000a0a").Bytes()
+	param := &scom.MakeTxParam{
+		ToChainID: 10,
+		Method:    unlockMethod,
+	}
+	setAmount := func(amount int64) {
+		le := make([]byte, 32)
+		for i, b := range common.LeftPadBytes(big.NewInt(amount).Bytes(), 32) {
+			le[31-i] = b
+		}
+		param.Args = append(append([]byte{byte(len(asset))}, asset...), 0)
+		param.Args = append(param.Args, le...)
+	}
+	newContract := func(height int64) *native.NativeContract {
+		contractRef := native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(height), common.Hash{}, 10000000, nil)
+		contractRef.PushContext(&native.Context{ContractAddress: this})
+		return native.NewNativeContract(sdb, contractRef)
+	}
+	setRateLimit := func(p *scom.SetRateLimitParam) {
+		input, err := p.Encode()
+		assert.Nil(t, err)
+		for _, caller := range signers {
+			contractRef := native.NewContractRef(sdb, caller, caller, big.NewInt(1), common.Hash{}, 10000000, nil)
+			_, _, err = contractRef.NativeCall(caller, utils.CrossChainManagerContractAddress, input)
+			assert.Nil(t, err)
+		}
+	}
+
+	// at most 2 transfers from the chain, and pause the chain after 500 asset transferred
+	setRateLimit(&scom.SetRateLimitParam{ChainID: chainID, Window: 100, Limit: big.NewInt(2), PauseThreshold: new(big.Int)})
+	setRateLimit(&scom.SetRateLimitParam{ChainID: chainID, Asset: asset, Window: 100, Limit: big.NewInt(1000), PauseThreshold: big.NewInt(500)})
+
+	// the failed transfer is reverted
+	contract := newContract(101)
+	setAmount(1001)
+	snap := sdb.Snapshot()
+	_, err := checkRateLimit(contract, chainID, param, utils.ETH_COMMON_ROUTER)
+	assert.NotNil(t, err)
+	sdb.RevertToSnapshot(snap)
+
+	setAmount(400)
+	tripped, err := checkRateLimit(contract, chainID, param, utils.ETH_COMMON_ROUTER)
+	assert.Nil(t, err)
+	assert.False(t, tripped)
+	blacked, err := CheckIfChainBlacked(contract, chainID)
+	assert.Nil(t, err)
+	assert.False(t, blacked)
+
+	// the transfer tripping the threshold pauses the chain and should not be made
+	setAmount(100)
+	tripped, err = checkRateLimit(contract, chainID, param, utils.ETH_COMMON_ROUTER)
+	assert.Nil(t, err)
+	assert.True(t, tripped)
+	blacked, err = CheckIfChainBlacked(contract, chainID)
+	assert.Nil(t, err)
+	assert.True(t, blacked)
+
+	// the held transfer can not be released while the chain is paused
+	param.CrossChainID = []byte("tripped")
+	assert.Nil(t, parkTransfer(contract, chainID, param, utils.ETH_COMMON_ROUTER, 101))
+	input, err := utils.PackMethodWithStruct(scom.ABI, scom.MethodReleaseTransfer, &scom.PendingTransferParam{ChainID: chainID, CrossChainID: param.CrossChainID})
+	assert.Nil(t, err)
+	contractRef := native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(101), common.Hash{}, 10000000, nil)
+	_, _, err = contractRef.NativeCall(common.Address{}, utils.CrossChainManagerContractAddress, input)
+	assert.Contains(t, err.Error(), "blacked")
+	assert.Nil(t, delPendingTransfer(contract, chainID, param.CrossChainID))
+
+	// transfer count exceeds the limit of chain
+	setAmount(1)
+	_, err = checkRateLimit(contract, chainID, param, utils.ETH_COMMON_ROUTER)
+	assert.NotNil(t, err)
+
+	// usage is reset in next window
+	contract = newContract(200)
+	_, err = checkRateLimit(contract, chainID, param, utils.ETH_COMMON_ROUTER)
+	assert.Nil(t, err)
+
+	input, err = (&scom.GetRateLimitParam{ChainID: chainID, Asset: asset}).Encode()
+	assert.Nil(t, err)
+	contractRef = native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(200), common.Hash{}, 10000000, nil)
+	ret, _, err := contractRef.NativeCall(common.Address{}, utils.CrossChainManagerContractAddress, input)
+	assert.Nil(t, err)
+	info := new(RateLimitInfo)
+	assert.Nil(t, info.Decode(ret))
+	assert.Equal(t, uint64(200), info.Usage.WindowStart)
+	assert.Equal(t, big.NewInt(1), info.Usage.Used)
+	assert.Equal(t, big.NewInt(500), info.Limit.PauseThreshold)
+
+	// governance removes the limits and resumes the chain
+	setRateLimit(&scom.SetRateLimitParam{ChainID: chainID, Limit: new(big.Int), PauseThreshold: new(big.Int)})
+	setRateLimit(&scom.SetRateLimitParam{ChainID: chainID, Asset: asset, Limit: new(big.Int), PauseThreshold: new(big.Int)})
+	RemoveBlackChain(contract, chainID)
+	_, _, err = contractRef.NativeCall(common.Address{}, utils.CrossChainManagerContractAddress, input)
+	assert.NotNil(t, err)
+}
+
+func TestTransferQueue(t *testing.T) {
+	var chainID uint64 = 11
+	asset := common.HexToAddress("0x0000000000000000000000000000000000000c0c").Bytes()
+	newParam := func(crossChainID byte, amount int64) *scom.MakeTxParam {
+		le := make([]byte, 32)
+		for i, b := range common.LeftPadBytes(big.NewInt(amount).Bytes(), 32) {
+			le[31-i] = b
+		}
+		args := append(append([]byte{byte(len(asset))}, asset...), 0)
+		return &scom.MakeTxParam{
+			CrossChainID: []byte{crossChainID},
+			ToChainID:    10,
+			Method:       unlockMethod,
+			Args:         append(args, le...),
+		}
+	}
+	newContract := func(height int64) *native.NativeContract {
+		contractRef := native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(height), common.Hash{}, 10000000, nil)
+		contractRef.PushContext(&native.Context{ContractAddress: this})
+		return native.NewNativeContract(sdb, contractRef)
+	}
+	call := func(height int64, callers []common.Address, method string, param interface{}) ([]byte, error) {
+		input, err := utils.PackMethodWithStruct(scom.ABI, method, param)
+		assert.Nil(t, err)
+		var ret []byte
+		for _, caller := range callers {
+			contractRef := native.NewContractRef(sdb, caller, caller, big.NewInt(height), common.Hash{}, 10000000, nil)
+			if ret, _, err = contractRef.NativeCall(caller, utils.CrossChainManagerContractAddress, input); err != nil {
+				return nil, err
+			}
+		}
+		return ret, nil
+	}
+
+	_, err := call(1, signers, scom.MethodSetTransferDelay, &scom.SetTransferDelayParam{ChainID: chainID, Asset: asset, Threshold: big.NewInt(1000), Delay: 100})
+	assert.Nil(t, err)
+
+	// small transfer is made immediately
+	head := getPendingTransferHead(newContract(1))
+	queued, err := queueTransfer(newContract(1), chainID, newParam(1, 999), utils.ETH_COMMON_ROUTER)
+	assert.Nil(t, err)
+	assert.False(t, queued)
+
+	for _, id := range []byte{2, 3} {
+		queued, err = queueTransfer(newContract(1), chainID, newParam(id, 1000), utils.ETH_COMMON_ROUTER)
+		assert.Nil(t, err)
+		assert.True(t, queued)
+	}
+	ret, err := call(1, []common.Address{{}}, scom.MethodGetPendingTransfers, &struct{}{})
+	assert.Nil(t, err)
+	list := new(PendingTransfers)
+	assert.Nil(t, list.Decode(ret))
+	assert.Equal(t, 2, len(list.List))
+	assert.Equal(t, uint64(101), list.List[0].ReleaseHeight)
+	assert.Equal(t, head, list.List[0].Index)
+	assert.Equal(t, head+2, getPendingTransferTail(newContract(1)))
+
+	// locked during delay
+	_, err = call(100, []common.Address{{}}, scom.MethodReleaseTransfer, &scom.PendingTransferParam{ChainID: chainID, CrossChainID: []byte{2}})
+	assert.NotNil(t, err)
+
+	// signers cancel the transfer 2
+	_, err = call(100, signers, scom.MethodCancelTransfer, &scom.PendingTransferParam{ChainID: chainID, CrossChainID: []byte{2}})
+	assert.Nil(t, err)
+	_, err = call(100, []common.Address{{}}, scom.MethodGetPendingTransfer, &scom.PendingTransferParam{ChainID: chainID, CrossChainID: []byte{2}})
+	assert.NotNil(t, err)
+	assert.Equal(t, head+1, getPendingTransferHead(newContract(100)))
+
+	// anyone releases the transfer 3 after delay
+	_, err = call(101, []common.Address{{}}, scom.MethodReleaseTransfer, &scom.PendingTransferParam{ChainID: chainID, CrossChainID: []byte{3}})
+	assert.Nil(t, err)
+	ret, err = call(101, []common.Address{{}}, scom.MethodGetPendingTransfers, &struct{}{})
+	assert.Nil(t, err)
+	assert.Nil(t, list.Decode(ret))
+	assert.Equal(t, 0, len(list.List))
+	assert.Equal(t, head+2, getPendingTransferHead(newContract(101)))
+}
+
+func TestRotateRippleSigners(t *testing.T) {
+	var chainID uint64 = 31
+	newContract := func(height int64) *native.NativeContract {
+		contractRef := native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(height), common.Hash{}, 10000000, nil)
+		contractRef.PushContext(&native.Context{ContractAddress: this})
+		return native.NewNativeContract(sdb, contractRef)
+	}
+	call := func(height int64, callers []common.Address, method string, param interface{}) ([]byte, error) {
+		input, err := utils.PackMethodWithStruct(scom.ABI, method, param)
+		assert.Nil(t, err)
+		var ret []byte
+		for _, caller := range callers {
+			contractRef := native.NewContractRef(sdb, caller, caller, big.NewInt(height), common.Hash{}, 10000000, nil)
+			if ret, _, err = contractRef.NativeCall(caller, utils.CrossChainManagerContractAddress, input); err != nil {
+				return nil, err
+			}
+		}
+		return ret, nil
+	}
+
+	pks := make([][]byte, 3)
+	for i := range pks {
+		key, _ := crypto.GenerateKey()
+		pks[i] = crypto.CompressPubkey(&key.PublicKey)
+	}
+	extra, err := rlp.EncodeToBytes(&side_chain_manager.RippleExtraInfo{
+		Sequence:      20,
+		Quorum:        1,
+		SignerNum:     2,
+		Pks:           pks[:2],
+		ReserveAmount: big.NewInt(10),
+	})
+	assert.Nil(t, err)
+	contract := newContract(1)
+	assert.Nil(t, side_chain_manager.PutSideChain(contract, &side_chain_manager.SideChain{
+		ChainID:   chainID,
+		Router:    utils.RIPPLE_ROUTER,
+		ExtraInfo: extra,
+	}))
+	assert.Nil(t, side_chain_manager.PutFee(contract, chainID, &side_chain_manager.Fee{View: 1, Fee: big.NewInt(10)}))
+	multisign := common.HexToAddress("0x0000000000000000000000000000000000000d0d").Bytes()
+	assert.Nil(t, side_chain_manager.PutAssetBind(contract, chainID, &side_chain_manager.AssetBind{
+		AssetMap:     map[uint64][]byte{chainID: multisign},
+		LockProxyMap: map[uint64][]byte{chainID: multisign},
+	}))
+
+	// invalid signer list
+	_, err = call(1, signers, scom.MethodRotateRippleSigners, &scom.RotateRippleSignersParam{ChainID: chainID, Pks: [][]byte{pks[1], pks[1]}, Quorum: 1})
+	assert.NotNil(t, err)
+	_, err = call(1, signers, scom.MethodRotateRippleSigners, &scom.RotateRippleSignersParam{ChainID: chainID, Pks: pks[1:], Quorum: 3})
+	assert.NotNil(t, err)
+
+	_, err = call(1, signers, scom.MethodRotateRippleSigners, &scom.RotateRippleSignersParam{ChainID: chainID, Pks: pks[1:], Quorum: 2})
+	assert.Nil(t, err)
+	info, err := side_chain_manager.GetRippleExtraInfo(contract, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(21), info.Sequence)
+	assert.Equal(t, pks[1:], info.Pks)
+	// the rotation tx and txs before it are signed by the previous signers
+	prev, quorum := info.SignerSet(20)
+	assert.Equal(t, pks[:2], prev)
+	assert.Equal(t, uint64(1), quorum)
+	_, quorum = info.SignerSet(21)
+	assert.Equal(t, uint64(2), quorum)
+
+	txHash := common.Hash{}
+	ret, err := call(1, []common.Address{{}}, scom.MethodGetRippleTxRecord, &scom.GetRippleTxRecordParam{ChainID: chainID, Sequence: 20})
+	assert.Nil(t, err)
+	record := new(ripple.RippleTxRecord)
+	assert.Nil(t, record.Decode(ret))
+	assert.Equal(t, chainID, record.FromChainId)
+	assert.Equal(t, txHash[:], record.TxHash)
+	assert.Equal(t, uint64(0), record.Attempts)
+
+	// the stuck tx is re-issued with doubled fee
+	bump := &scom.BumpRippleFeeParam{FromChainId: chainID, TxHash: txHash[:], ToChainId: chainID}
+	_, err = call(ripple.StuckBlocks, signers, scom.MethodBumpRippleFee, bump)
+	assert.NotNil(t, err)
+	// only voters can bump the fee
+	_, err = call(ripple.StuckBlocks+1, []common.Address{{}}, scom.MethodBumpRippleFee, bump)
+	assert.NotNil(t, err)
+	_, err = call(ripple.StuckBlocks+1, signers, scom.MethodBumpRippleFee, bump)
+	assert.Nil(t, err)
+	raw, err := ripple.GetTxJsonInfo(contract, chainID, txHash[:])
+	assert.Nil(t, err)
+	blob, err := hex.DecodeString(raw)
+	assert.Nil(t, err)
+	tx, err := data.ReadTransaction(bytes.NewReader(blob))
+	assert.Nil(t, err)
+	assert.Equal(t, data.SIGNER_LIST_SET, tx.GetTransactionType())
+	assert.Equal(t, uint32(20), tx.GetBase().Sequence)
+	assert.Equal(t, "0.00004", tx.GetBase().Fee.String())
+
+	ret, err = call(1, []common.Address{{}}, scom.MethodGetRippleTxRecord, &scom.GetRippleTxRecordParam{ChainID: chainID, Sequence: 20})
+	assert.Nil(t, err)
+	assert.Nil(t, record.Decode(ret))
+	assert.Equal(t, uint64(1), record.Attempts)
+	assert.Equal(t, uint64(ripple.StuckBlocks+1), record.Height)
+
+	// the next bump waits for doubled blocks
+	_, err = call(2*ripple.StuckBlocks, signers, scom.MethodBumpRippleFee, bump)
+	assert.NotNil(t, err)
+
+	// the signers of txs before the first rotation are kept after another rotation
+	_, err = call(ripple.StuckBlocks+1, signers, scom.MethodRotateRippleSigners, &scom.RotateRippleSignersParam{ChainID: chainID, Pks: pks[:1], Quorum: 1})
+	assert.Nil(t, err)
+	info, err = side_chain_manager.GetRippleExtraInfo(contract, chainID)
+	assert.Nil(t, err)
+	prev, quorum = info.SignerSet(20)
+	assert.Equal(t, pks[:2], prev)
+	assert.Equal(t, uint64(1), quorum)
+	prev, quorum = info.SignerSet(21)
+	assert.Equal(t, pks[1:], prev)
+	assert.Equal(t, uint64(2), quorum)
+	prev, _ = info.SignerSet(22)
+	assert.Equal(t, pks[:1], prev)
+}
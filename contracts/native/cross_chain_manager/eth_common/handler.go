@@ -233,7 +233,8 @@ func CheckProofResult(result, value []byte) (err error) {
 }
 
 type Header struct {
-	Root common.Hash `json:"stateRoot" gencodec:"required"`
+	Root        common.Hash `json:"stateRoot" gencodec:"required"`
+	ReceiptHash common.Hash `json:"receiptsRoot"`
 }
 
 // Decode header
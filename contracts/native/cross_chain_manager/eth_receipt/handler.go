@@ -0,0 +1,197 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eth_receipt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/eth_common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	icom "github.com/ethereum/go-ethereum/contracts/native/info_sync"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/light"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// event CrossChainEvent(address indexed sender, bytes txId, address proxyOrAssetContract, uint64 toChainId, bytes toContract, bytes rawdata);
+const crossChainEventABI = `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"bytes","name":"txId","type":"bytes"},{"indexed":false,"internalType":"address","name":"proxyOrAssetContract","type":"address"},{"indexed":false,"internalType":"uint64","name":"toChainId","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"toContract","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"rawdata","type":"bytes"}],"name":"CrossChainEvent","type":"event"}]`
+
+var crossChainEvent abi.Event
+
+func init() {
+	ab, err := abi.JSON(strings.NewReader(crossChainEventABI))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	crossChainEvent = ab.Events["CrossChainEvent"]
+}
+
+// Handler verifies the cross chain event log of source chain with the receipt trie proof against the
+// `ReceiptHash` of header synced in info_sync, so that the relayers do not need `eth_getProof`.
+type Handler struct{}
+
+func NewHandler() *Handler {
+	return new(Handler)
+}
+
+func (h *Handler) MakeDepositProposal(service *native.NativeContract) (txParam *scom.MakeTxParam, err error) {
+	ctx := service.ContractRef().CurrentContext()
+	params := &scom.EntranceParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := side_chain_manager.GetSideChainObject(service, params.SourceChainID)
+	if err != nil || sideChain == nil {
+		err = fmt.Errorf("eth receipt handler failed to get side chain instance, chain(%d) err: %v", params.SourceChainID, err)
+		return
+	}
+
+	txParam, err = h.VerifyDepositProposal(service, sideChain, params)
+	if err != nil {
+		err = fmt.Errorf("eth receipt handler verify deposit proposal failure chain(%d):%s, err: %v", params.SourceChainID, sideChain.Name, err)
+		return
+	}
+
+	err = scom.CheckDoneTx(service, txParam.CrossChainID, params.SourceChainID)
+	if err != nil {
+		err = fmt.Errorf("eth receipt handler check done transaction err: %v, chain(%d): %s", err, params.SourceChainID, sideChain.Name)
+		return
+	}
+
+	err = scom.PutDoneTx(service, txParam.CrossChainID, params.SourceChainID)
+	if err != nil {
+		err = fmt.Errorf("eth receipt handler mark tx as done err: %v, chain(%d): %s", err, params.SourceChainID, sideChain.Name)
+		return
+	}
+	return
+}
+
+func (h *Handler) VerifyDepositProposal(service *native.NativeContract,
+	sideChain *side_chain_manager.SideChain, params *scom.EntranceParam) (txParam *scom.MakeTxParam, err error) {
+
+	proof := new(Proof)
+	err = json.Unmarshal(params.Proof, proof)
+	if err != nil {
+		err = fmt.Errorf("decode receipt proof failed, err: %v", err)
+		return
+	}
+
+	info, err := icom.GetRootInfo(service, sideChain.ChainID, params.Height)
+	if err != nil {
+		err = fmt.Errorf("get root info failure, err %v", err)
+		return
+	}
+	if info == nil {
+		err = fmt.Errorf("root info missing for height %d", params.Height)
+		return
+	}
+
+	header, err := eth_common.DecodeHeader(info)
+	if err != nil {
+		err = fmt.Errorf("decode root info failure, %v", err)
+		return
+	}
+
+	return VerifyReceiptProof(proof, header.ReceiptHash, sideChain.CCMCAddress)
+}
+
+// Proof is the receipt trie proof of the transaction emitting the cross chain event, `LogIndex` is the
+// index of the event in the logs of the receipt.
+type Proof struct {
+	TxIndex      hexutil.Uint64 `json:"transactionIndex"`
+	LogIndex     hexutil.Uint64 `json:"logIndex"`
+	ReceiptProof []string       `json:"receiptProof"`
+}
+
+// receiptRLP is the consensus encoding of receipt, fields appended by some chains (e.g. deposit nonce
+// of optimism) are ignored.
+type receiptRLP struct {
+	PostStateOrStatus []byte
+	CumulativeGasUsed uint64
+	Bloom             types.Bloom
+	Logs              []*types.Log
+	Rest              []rlp.RawValue `rlp:"tail"`
+}
+
+// VerifyReceiptProof verifies the receipt proof and decodes the cross chain param from the event log
+// emitted by the cross chain manager contract of source chain.
+func VerifyReceiptProof(proof *Proof, receiptHash common.Hash, address []byte) (*scom.MakeTxParam, error) {
+	if receiptHash == (common.Hash{}) {
+		return nil, fmt.Errorf("empty receipt hash found in header")
+	}
+	nodeList := new(light.NodeList)
+	for _, s := range proof.ReceiptProof {
+		nodeList.Put(nil, common.Hex2Bytes(scom.Replace0x(s)))
+	}
+	key, err := rlp.EncodeToBytes(uint64(proof.TxIndex))
+	if err != nil {
+		return nil, fmt.Errorf("encode receipt key failed, err: %v", err)
+	}
+	value, err := trie.VerifyProof(receiptHash, key, nodeList.NodeSet())
+	if err != nil {
+		return nil, fmt.Errorf("receipt VerifyProof failure, err: %v", err)
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("receipt of tx %d not found", proof.TxIndex)
+	}
+
+	// typed receipt is prefixed with tx type
+	if value[0] <= 0x7f {
+		value = value[1:]
+	}
+	receipt := new(receiptRLP)
+	if err := rlp.DecodeBytes(value, receipt); err != nil {
+		return nil, fmt.Errorf("decode receipt failed, err: %v", err)
+	}
+	if !bytes.Equal(receipt.PostStateOrStatus, []byte{0x01}) {
+		return nil, fmt.Errorf("tx %d is not successful", proof.TxIndex)
+	}
+	if uint64(proof.LogIndex) >= uint64(len(receipt.Logs)) {
+		return nil, fmt.Errorf("log index %d out of range %d", proof.LogIndex, len(receipt.Logs))
+	}
+
+	log := receipt.Logs[proof.LogIndex]
+	if !bytes.Equal(log.Address.Bytes(), address) {
+		return nil, fmt.Errorf("log address(%s) does not match with contract address(%x)", log.Address.Hex(), address)
+	}
+	if len(log.Topics) == 0 || log.Topics[0] != crossChainEvent.ID {
+		return nil, fmt.Errorf("log is not cross chain event")
+	}
+	args, err := crossChainEvent.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unpack cross chain event failed, err: %v", err)
+	}
+	// rawdata is the last non-indexed input
+	raw, ok := args[len(args)-1].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid cross chain event rawdata")
+	}
+	return scom.DecodeTxParam(raw)
+}
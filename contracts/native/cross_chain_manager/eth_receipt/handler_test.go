@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eth_receipt
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/light"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyReceiptProof(t *testing.T) {
+	ccmc := common.HexToAddress("0xdedace1809079e241234d546e44517f31b57ab8f")
+	param := &scom.MakeTxParam{
+		TxHash:              []byte{1},
+		CrossChainID:        []byte{2},
+		FromContractAddress: common.HexToAddress("0x01").Bytes(),
+		ToChainID:           10,
+		ToContractAddress:   common.HexToAddress("0x02").Bytes(),
+		Method:              "unlock",
+		Args:                []byte{3},
+	}
+	raw, err := scom.EncodeTxParam(param)
+	assert.Nil(t, err)
+	data, err := crossChainEvent.Inputs.NonIndexed().Pack(param.CrossChainID, common.Address{}, param.ToChainID, param.ToContractAddress, raw)
+	assert.Nil(t, err)
+
+	event := &types.Log{
+		Address: ccmc,
+		Topics:  []common.Hash{crossChainEvent.ID, common.BytesToHash(common.HexToAddress("0x03").Bytes())},
+		Data:    data,
+	}
+	receipts := []*types.Receipt{
+		{Type: types.LegacyTxType, Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 21000, Logs: []*types.Log{}},
+		{Type: types.DynamicFeeTxType, Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 80000, Logs: []*types.Log{{Address: common.HexToAddress("0x04")}, event}},
+		{Type: types.LegacyTxType, Status: types.ReceiptStatusFailed, CumulativeGasUsed: 90000, Logs: []*types.Log{event}},
+	}
+	tr, err := trie.New(common.Hash{}, trie.NewDatabase(memorydb.New()))
+	assert.Nil(t, err)
+	for i, receipt := range receipts {
+		key, err := rlp.EncodeToBytes(uint64(i))
+		assert.Nil(t, err)
+		value, err := receipt.MarshalBinary()
+		assert.Nil(t, err)
+		tr.Update(key, value)
+	}
+	root := tr.Hash()
+	assert.Equal(t, types.DeriveSha(types.Receipts(receipts), trie.NewStackTrie(nil)), root)
+
+	prove := func(txIndex, logIndex uint64) *Proof {
+		key, err := rlp.EncodeToBytes(txIndex)
+		assert.Nil(t, err)
+		nodes := new(light.NodeList)
+		assert.Nil(t, tr.Prove(key, 0, nodes))
+		proof := &Proof{TxIndex: hexutil.Uint64(txIndex), LogIndex: hexutil.Uint64(logIndex)}
+		for _, node := range *nodes {
+			proof.ReceiptProof = append(proof.ReceiptProof, hexutil.Encode(node))
+		}
+		return proof
+	}
+
+	txParam, err := VerifyReceiptProof(prove(1, 1), root, ccmc.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, param, txParam)
+
+	// not cross chain event
+	_, err = VerifyReceiptProof(prove(1, 0), root, ccmc.Bytes())
+	assert.NotNil(t, err)
+	// failed tx
+	_, err = VerifyReceiptProof(prove(2, 0), root, ccmc.Bytes())
+	assert.NotNil(t, err)
+	// wrong contract
+	_, err = VerifyReceiptProof(prove(1, 1), root, common.HexToAddress("0x05").Bytes())
+	assert.NotNil(t, err)
+	// wrong root
+	_, err = VerifyReceiptProof(prove(1, 1), common.BigToHash(big.NewInt(1)), ccmc.Bytes())
+	assert.NotNil(t, err)
+}
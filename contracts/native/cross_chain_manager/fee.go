@@ -0,0 +1,519 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cross_chain_manager
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	FEE_CONFIG        = "feeConfig"
+	FEE_POOL          = "feePool"
+	FEE_TOKENS        = "feeTokens"
+	FEE_RELAYER       = "feeRelayer"
+	FEE_RELAYER_INDEX = "feeRelayerIndex"
+
+	// gas used to call the erc20 fee token
+	feeTokenCallGas uint64 = 100000
+)
+
+var (
+	// DefaultFeeConfig is used before the fee config set by governance, the rates are in ten thousandths.
+	DefaultFeeConfig = FeeConfig{
+		VoterRate:     4000,
+		RelayerRate:   4000,
+		CommunityRate: 2000,
+		Period:        10000,
+	}
+
+	transferSelector     = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+	transferFromSelector = crypto.Keccak256([]byte("transferFrom(address,address,uint256)"))[:4]
+)
+
+// FeeConfig defines how the accrued cross chain fees are distributed to the voters of current epoch,
+// the relayers and the community pool, and the blocks between two distributions.
+type FeeConfig struct {
+	VoterRate        uint64
+	RelayerRate      uint64
+	CommunityRate    uint64
+	Period           uint64
+	LastDistribution uint64
+}
+
+func (m *FeeConfig) Decode(payload []byte) error {
+	var data struct {
+		FeeConfig []byte
+	}
+	if err := utils.UnpackOutputs(scom.ABI, scom.MethodGetFeeConfig, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.FeeConfig, m)
+}
+
+type RelayerFee struct {
+	Relayer common.Address
+	Amount  *big.Int
+}
+
+// FeePool is the fee accrued in a token since last distribution, and the fee paid by each relayer.
+// the relayer fees are stored per key and only filled in for queries.
+type FeePool struct {
+	Amount       *big.Int
+	RelayerCount uint64
+	Relayers     []*RelayerFee
+}
+
+func (m *FeePool) Decode(payload []byte) error {
+	var data struct {
+		FeePool []byte
+	}
+	if err := utils.UnpackOutputs(scom.ABI, scom.MethodGetFeePool, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.FeePool, m)
+}
+
+type TokenList struct {
+	List []common.Address
+}
+
+func SetFeeConfig(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.SetFeeConfigParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetFeeConfig, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("SetFeeConfig, unpack params error: %v", err)
+	}
+	total := new(big.Int).SetUint64(params.VoterRate + params.RelayerRate + params.CommunityRate)
+	if total.Cmp(community.PercentDecimal) != 0 {
+		return nil, fmt.Errorf("SetFeeConfig, sum of rates should be %s", community.PercentDecimal)
+	}
+	if params.Period == 0 {
+		return nil, fmt.Errorf("SetFeeConfig, distribution period should be positive")
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(s, scom.MethodSetFeeConfig, ctx.Payload, s.ContractRef().MsgSender(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SetFeeConfig, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetFeeConfig, true)
+	}
+
+	config, err := GetFeeConfigObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("SetFeeConfig, GetFeeConfigObj error: %v", err)
+	}
+	config.VoterRate = params.VoterRate
+	config.RelayerRate = params.RelayerRate
+	config.CommunityRate = params.CommunityRate
+	config.Period = params.Period
+	if err := putFeeConfig(s, config); err != nil {
+		return nil, fmt.Errorf("SetFeeConfig, putFeeConfig error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSetFeeConfig, true)
+}
+
+func GetFeeConfig(s *native.NativeContract) ([]byte, error) {
+	config, err := GetFeeConfigObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetFeeConfig, GetFeeConfigObj error: %v", err)
+	}
+	enc, err := rlp.EncodeToBytes(config)
+	if err != nil {
+		return nil, fmt.Errorf("GetFeeConfig, serialize fee config error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetFeeConfig, enc)
+}
+
+func GetFeePool(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.GetFeePoolParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodGetFeePool, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetFeePool, unpack params error: %v", err)
+	}
+	pool, err := getFeePool(s, params.Token)
+	if err != nil {
+		return nil, fmt.Errorf("GetFeePool, getFeePool error: %v", err)
+	}
+	if pool.Relayers, err = getRelayerFees(s, params.Token, pool.RelayerCount); err != nil {
+		return nil, fmt.Errorf("GetFeePool, getRelayerFees error: %v", err)
+	}
+	enc, err := rlp.EncodeToBytes(pool)
+	if err != nil {
+		return nil, fmt.Errorf("GetFeePool, serialize fee pool error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetFeePool, enc)
+}
+
+// DistributeFee distributes the accrued fees once per period, anyone can trigger it. the voter part is
+// shared equally by the voters of current epoch, the relayer part is shared by relayers in proportion
+// to the fees they paid, and the rest including the dust goes to the community pool.
+func DistributeFee(s *native.NativeContract) ([]byte, error) {
+	height := s.ContractRef().BlockHeight().Uint64()
+
+	config, err := GetFeeConfigObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("DistributeFee, GetFeeConfigObj error: %v", err)
+	}
+	if height < config.LastDistribution+config.Period {
+		return nil, fmt.Errorf("DistributeFee, next distribution height is %d", config.LastDistribution+config.Period)
+	}
+	epoch, err := node_manager.GetCurrentEpochInfoImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("DistributeFee, GetCurrentEpochInfoImpl error: %v", err)
+	}
+	communityInfo, err := community.GetCommunityInfoImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("DistributeFee, GetCommunityInfoImpl error: %v", err)
+	}
+	tokens, err := getFeeTokens(s)
+	if err != nil {
+		return nil, fmt.Errorf("DistributeFee, getFeeTokens error: %v", err)
+	}
+
+	for _, token := range tokens {
+		pool, err := getFeePool(s, token)
+		if err != nil {
+			return nil, fmt.Errorf("DistributeFee, getFeePool error: %v", err)
+		}
+		if pool.Amount.Sign() <= 0 {
+			relayers, err := getRelayerFees(s, token, pool.RelayerCount)
+			if err != nil {
+				return nil, fmt.Errorf("DistributeFee, getRelayerFees error: %v", err)
+			}
+			delRelayerFees(s, token, relayers)
+			delFeePool(s, token)
+			continue
+		}
+		voterFee := new(big.Int).Div(new(big.Int).Mul(pool.Amount, new(big.Int).SetUint64(config.VoterRate)), community.PercentDecimal)
+		relayerFee := new(big.Int).Div(new(big.Int).Mul(pool.Amount, new(big.Int).SetUint64(config.RelayerRate)), community.PercentDecimal)
+		relayers, err := getRelayerFees(s, token, pool.RelayerCount)
+		if err != nil {
+			return nil, fmt.Errorf("DistributeFee, getRelayerFees error: %v", err)
+		}
+
+		// the event records the amounts actually credited, which differ from the configured rates if
+		// there is no voter or the shares are rounded down.
+		votersPaid, relayersPaid := new(big.Int), new(big.Int)
+		if len(epoch.Voters) > 0 {
+			share := new(big.Int).Div(voterFee, big.NewInt(int64(len(epoch.Voters))))
+			for _, voter := range epoch.Voters {
+				if err := transferFee(s, token, voter, share); err != nil {
+					return nil, fmt.Errorf("DistributeFee, transfer to voter %s error: %v", voter.Hex(), err)
+				}
+				votersPaid.Add(votersPaid, share)
+			}
+		}
+		for _, v := range relayers {
+			share := new(big.Int).Div(new(big.Int).Mul(relayerFee, v.Amount), pool.Amount)
+			if err := transferFee(s, token, v.Relayer, share); err != nil {
+				return nil, fmt.Errorf("DistributeFee, transfer to relayer %s error: %v", v.Relayer.Hex(), err)
+			}
+			relayersPaid.Add(relayersPaid, share)
+		}
+		rest := new(big.Int).Sub(pool.Amount, new(big.Int).Add(votersPaid, relayersPaid))
+		if err := transferFee(s, token, communityInfo.CommunityAddress, rest); err != nil {
+			return nil, fmt.Errorf("DistributeFee, transfer to community error: %v", err)
+		}
+
+		err = s.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventDistributeFee}, token, votersPaid, relayersPaid, rest)
+		if err != nil {
+			return nil, fmt.Errorf("DistributeFee, AddNotify error: %v", err)
+		}
+		delRelayerFees(s, token, relayers)
+		delFeePool(s, token)
+	}
+	delFeeTokens(s)
+
+	config.LastDistribution = height
+	if err := putFeeConfig(s, config); err != nil {
+		return nil, fmt.Errorf("DistributeFee, putFeeConfig error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodDistributeFee, true)
+}
+
+// chargeFee checks the fee paid with the transfer against the fee of destination chain, and accrues
+// it to the fee pool. the fee is paid with tx value if the fee token of destination chain is native
+// token, otherwise the fee token is transferred from the relayer who should approve it in advance.
+func chargeFee(s *native.NativeContract, dstChainID uint64) error {
+	relayer := s.ContractRef().CurrentContext().Caller
+	value := s.ContractRef().Value()
+
+	fee, err := side_chain_manager.GetFeeObj(s, dstChainID)
+	if err != nil {
+		return fmt.Errorf("chargeFee, side_chain_manager.GetFeeObj error: %v", err)
+	}
+	required := fee.Fee
+	if required == nil {
+		required = new(big.Int)
+	}
+	token, err := side_chain_manager.GetFeeTokenObj(s, dstChainID)
+	if err != nil {
+		return fmt.Errorf("chargeFee, side_chain_manager.GetFeeTokenObj error: %v", err)
+	}
+
+	if token == common.EmptyAddress {
+		if value.Cmp(required) < 0 {
+			return fmt.Errorf("chargeFee, insufficient fee to chain %d, required %s, paid %s", dstChainID, required, value)
+		}
+		return accrueFee(s, token, relayer, value)
+	}
+	if value.Sign() > 0 {
+		return fmt.Errorf("chargeFee, fee to chain %d should be paid in token %s", dstChainID, token.Hex())
+	}
+	if required.Sign() == 0 {
+		return nil
+	}
+	if err := callFeeToken(s, token, transferFromSelector, common.LeftPadBytes(relayer.Bytes(), 32),
+		common.LeftPadBytes(this.Bytes(), 32), common.LeftPadBytes(required.Bytes(), 32)); err != nil {
+		return fmt.Errorf("chargeFee, transfer fee token error: %v", err)
+	}
+	return accrueFee(s, token, relayer, required)
+}
+
+// feeDeducted returns whether the fee to destination chain is deducted from the transferred asset, which
+// is true for the native assets of bitcoin and ripple.
+func feeDeducted(s *native.NativeContract, dstChain *side_chain_manager.SideChain) (bool, error) {
+	switch dstChain.Router {
+	case utils.BTC_ROUTER:
+		return true, nil
+	case utils.RIPPLE_ROUTER:
+		assetBind, err := side_chain_manager.GetAssetBind(s, dstChain.ChainID)
+		if err != nil {
+			return false, err
+		}
+		return !assetBind.IsIssuedCurrency(), nil
+	default:
+		return false, nil
+	}
+}
+
+// refundFee returns the tx value to the caller, e.g. the transfer waiting for more signatures.
+func refundFee(s *native.NativeContract) error {
+	value := s.ContractRef().Value()
+	if value.Sign() == 0 {
+		return nil
+	}
+	return contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, s.ContractRef().CurrentContext().Caller, value)
+}
+
+func accrueFee(s *native.NativeContract, token, relayer common.Address, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+	pool, err := getFeePool(s, token)
+	if err != nil {
+		return err
+	}
+	if pool.Amount.Sign() == 0 {
+		if err := addFeeToken(s, token); err != nil {
+			return err
+		}
+	}
+	pool.Amount = new(big.Int).Add(pool.Amount, amount)
+
+	paid, err := getRelayerFee(s, token, relayer)
+	if err != nil {
+		return err
+	}
+	if paid.Sign() == 0 {
+		s.GetCacheDB().Put(feeRelayerIndexKey(token, pool.RelayerCount), relayer.Bytes())
+		pool.RelayerCount++
+	}
+	s.GetCacheDB().Put(feeRelayerKey(token, relayer), new(big.Int).Add(paid, amount).Bytes())
+	return putFeePool(s, token, pool)
+}
+
+func transferFee(s *native.NativeContract, token, to common.Address, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+	if token == common.EmptyAddress {
+		return contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, to, amount)
+	}
+	return callFeeToken(s, token, transferSelector, common.LeftPadBytes(to.Bytes(), 32), common.LeftPadBytes(amount.Bytes(), 32))
+}
+
+// callFeeToken calls the erc20 fee token contract, tokens returning nothing are accepted.
+func callFeeToken(s *native.NativeContract, token common.Address, selector []byte, args ...[]byte) error {
+	input := common.CopyBytes(selector)
+	for _, arg := range args {
+		input = append(input, arg...)
+	}
+	ret, _, err := s.ContractRef().EVMCall(this, token, feeTokenCallGas, input)
+	if err != nil {
+		return err
+	}
+	if len(ret) > 0 && new(big.Int).SetBytes(ret).Sign() == 0 {
+		return fmt.Errorf("call fee token %s failed", token.Hex())
+	}
+	return nil
+}
+
+func GetFeeConfigObj(s *native.NativeContract) (*FeeConfig, error) {
+	store, err := s.GetCacheDB().Get(feeConfigKey())
+	if err != nil {
+		return nil, fmt.Errorf("GetFeeConfigObj, get fee config store error: %v", err)
+	}
+	config := DefaultFeeConfig
+	if store != nil {
+		if err := rlp.DecodeBytes(store, &config); err != nil {
+			return nil, fmt.Errorf("GetFeeConfigObj, deserialize fee config error: %v", err)
+		}
+	}
+	return &config, nil
+}
+
+func putFeeConfig(s *native.NativeContract, config *FeeConfig) error {
+	blob, err := rlp.EncodeToBytes(config)
+	if err != nil {
+		return fmt.Errorf("putFeeConfig, serialize fee config error: %v", err)
+	}
+	s.GetCacheDB().Put(feeConfigKey(), blob)
+	return nil
+}
+
+func getFeePool(s *native.NativeContract, token common.Address) (*FeePool, error) {
+	store, err := s.GetCacheDB().Get(feePoolKey(token))
+	if err != nil {
+		return nil, fmt.Errorf("getFeePool, get fee pool store error: %v", err)
+	}
+	pool := &FeePool{Amount: new(big.Int)}
+	if store != nil {
+		if err := rlp.DecodeBytes(store, pool); err != nil {
+			return nil, fmt.Errorf("getFeePool, deserialize fee pool error: %v", err)
+		}
+	}
+	return pool, nil
+}
+
+func putFeePool(s *native.NativeContract, token common.Address, pool *FeePool) error {
+	blob, err := rlp.EncodeToBytes(pool)
+	if err != nil {
+		return fmt.Errorf("putFeePool, serialize fee pool error: %v", err)
+	}
+	s.GetCacheDB().Put(feePoolKey(token), blob)
+	return nil
+}
+
+func delFeePool(s *native.NativeContract, token common.Address) {
+	s.GetCacheDB().Delete(feePoolKey(token))
+}
+
+func getRelayerFee(s *native.NativeContract, token, relayer common.Address) (*big.Int, error) {
+	store, err := s.GetCacheDB().Get(feeRelayerKey(token, relayer))
+	if err != nil {
+		return nil, fmt.Errorf("getRelayerFee, get relayer fee store error: %v", err)
+	}
+	return new(big.Int).SetBytes(store), nil
+}
+
+// getRelayerFees returns the fees paid by the relayers in the order they first paid.
+func getRelayerFees(s *native.NativeContract, token common.Address, count uint64) ([]*RelayerFee, error) {
+	list := make([]*RelayerFee, 0, count)
+	for i := uint64(0); i < count; i++ {
+		store, err := s.GetCacheDB().Get(feeRelayerIndexKey(token, i))
+		if err != nil {
+			return nil, fmt.Errorf("getRelayerFees, get relayer index store error: %v", err)
+		}
+		relayer := common.BytesToAddress(store)
+		amount, err := getRelayerFee(s, token, relayer)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, &RelayerFee{Relayer: relayer, Amount: amount})
+	}
+	return list, nil
+}
+
+func delRelayerFees(s *native.NativeContract, token common.Address, relayers []*RelayerFee) {
+	for i, v := range relayers {
+		s.GetCacheDB().Delete(feeRelayerKey(token, v.Relayer))
+		s.GetCacheDB().Delete(feeRelayerIndexKey(token, uint64(i)))
+	}
+}
+
+func getFeeTokens(s *native.NativeContract) ([]common.Address, error) {
+	store, err := s.GetCacheDB().Get(feeTokensKey())
+	if err != nil {
+		return nil, fmt.Errorf("getFeeTokens, get fee tokens store error: %v", err)
+	}
+	list := new(TokenList)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, list); err != nil {
+			return nil, fmt.Errorf("getFeeTokens, deserialize fee tokens error: %v", err)
+		}
+	}
+	return list.List, nil
+}
+
+func addFeeToken(s *native.NativeContract, token common.Address) error {
+	tokens, err := getFeeTokens(s)
+	if err != nil {
+		return err
+	}
+	for _, v := range tokens {
+		if v == token {
+			return nil
+		}
+	}
+	blob, err := rlp.EncodeToBytes(&TokenList{List: append(tokens, token)})
+	if err != nil {
+		return fmt.Errorf("addFeeToken, serialize fee tokens error: %v", err)
+	}
+	s.GetCacheDB().Put(feeTokensKey(), blob)
+	return nil
+}
+
+func delFeeTokens(s *native.NativeContract) {
+	s.GetCacheDB().Delete(feeTokensKey())
+}
+
+func feeConfigKey() []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(FEE_CONFIG))
+}
+
+func feePoolKey(token common.Address) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(FEE_POOL), token.Bytes())
+}
+
+func feeTokensKey() []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(FEE_TOKENS))
+}
+
+func feeRelayerKey(token, relayer common.Address) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(FEE_RELAYER), token.Bytes(), relayer.Bytes())
+}
+
+func feeRelayerIndexKey(token common.Address, index uint64) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(FEE_RELAYER_INDEX), token.Bytes(), utils.Uint64Bytes(index))
+}
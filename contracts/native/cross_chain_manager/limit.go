@@ -0,0 +1,270 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cross_chain_manager
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	RATE_LIMIT = "rateLimit"
+	RATE_USAGE = "rateUsage"
+
+	// method name of lock proxy to release the asset on target chain
+	unlockMethod = "unlock"
+)
+
+// RateLimit limits the inflow from a side chain in a fixed window of blocks. the limit with empty asset
+// applies to the side chain and counts the transfers, otherwise it sums the amount of the asset. the
+// transfer exceeding `Limit` is rejected, and the side chain is paused once the usage reaches
+// `PauseThreshold`. zero means no limit.
+type RateLimit struct {
+	Window         uint64
+	Limit          *big.Int
+	PauseThreshold *big.Int
+}
+
+type RateUsage struct {
+	WindowStart uint64
+	Used        *big.Int
+}
+
+type RateLimitInfo struct {
+	Limit *RateLimit
+	Usage *RateUsage
+}
+
+func (m *RateLimitInfo) Decode(payload []byte) error {
+	var data struct {
+		RateLimit []byte
+	}
+	if err := utils.UnpackOutputs(scom.ABI, scom.MethodGetRateLimit, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.RateLimit, m)
+}
+
+// SetRateLimit sets or removes the rate limit of a side chain or asset, it also resets the usage of
+// current window, so that governance can resume the paused chain by `WhiteChain` with a fresh window.
+func SetRateLimit(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.SetRateLimitParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetRateLimit, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("SetRateLimit, unpack params error: %v", err)
+	}
+	remove := params.Limit.Sign() == 0 && params.PauseThreshold.Sign() == 0
+	if !remove && params.Window == 0 {
+		return nil, fmt.Errorf("SetRateLimit, window should be positive")
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(s, scom.MethodSetRateLimit, ctx.Payload, s.ContractRef().MsgSender(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SetRateLimit, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetRateLimit, true)
+	}
+
+	s.GetCacheDB().Delete(rateUsageKey(params.ChainID, params.Asset))
+	if remove {
+		s.GetCacheDB().Delete(rateLimitKey(params.ChainID, params.Asset))
+		return utils.PackOutputs(scom.ABI, scom.MethodSetRateLimit, true)
+	}
+	limit := &RateLimit{
+		Window:         params.Window,
+		Limit:          params.Limit,
+		PauseThreshold: params.PauseThreshold,
+	}
+	if err := putRateLimit(s, params.ChainID, params.Asset, limit); err != nil {
+		return nil, fmt.Errorf("SetRateLimit, putRateLimit error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodSetRateLimit, true)
+}
+
+func GetRateLimit(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.GetRateLimitParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodGetRateLimit, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetRateLimit, unpack params error: %v", err)
+	}
+	limit, err := getRateLimit(s, params.ChainID, params.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("GetRateLimit, getRateLimit error: %v", err)
+	}
+	if limit == nil {
+		return nil, fmt.Errorf("GetRateLimit, rate limit of chain %d asset %x is not set", params.ChainID, params.Asset)
+	}
+	usage, err := getRateUsage(s, params.ChainID, params.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("GetRateLimit, getRateUsage error: %v", err)
+	}
+	enc, err := rlp.EncodeToBytes(&RateLimitInfo{Limit: limit, Usage: usage})
+	if err != nil {
+		return nil, fmt.Errorf("GetRateLimit, serialize rate limit error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetRateLimit, enc)
+}
+
+// checkRateLimit accounts the transfer from source chain against the limits of the chain and the
+// transferred asset, and returns true if the transfer trips the pause threshold.
+func checkRateLimit(s *native.NativeContract, srcChainID uint64, param *scom.MakeTxParam, dstRouter uint64) (bool, error) {
+	tripped, err := consumeRateLimit(s, srcChainID, nil, common.Big1)
+	if err != nil {
+		return false, err
+	}
+	asset, amount := transferAsset(param, dstRouter)
+	if len(asset) == 0 {
+		return tripped, nil
+	}
+	assetTripped, err := consumeRateLimit(s, srcChainID, asset, amount)
+	if err != nil {
+		return false, err
+	}
+	return tripped || assetTripped, nil
+}
+
+// transferAsset returns the asset and amount of the transfer, which are known only for lock proxy and
+// ripple transfers. the args which can not be decoded are left to the target chain, which would
+// reject them as well.
+func transferAsset(param *scom.MakeTxParam, dstRouter uint64) ([]byte, *big.Int) {
+	switch {
+	case dstRouter == utils.RIPPLE_ROUTER:
+		args, err := scom.DecodeRippleTxArgs(param.Args)
+		if err != nil {
+			return nil, nil
+		}
+		return param.ToContractAddress, args.Amount
+	case param.Method == unlockMethod:
+		args, err := scom.DecodeTxArgs(param.Args)
+		if err != nil {
+			return nil, nil
+		}
+		return args.ToAssetHash, args.Amount
+	default:
+		return nil, nil
+	}
+}
+
+// consumeRateLimit returns true if the usage reaches the pause threshold, the side chain is paused
+// and the transfer should not be made. an error would revert the pause as well.
+func consumeRateLimit(s *native.NativeContract, chainID uint64, asset []byte, amount *big.Int) (bool, error) {
+	limit, err := getRateLimit(s, chainID, asset)
+	if err != nil {
+		return false, fmt.Errorf("consumeRateLimit, getRateLimit error: %v", err)
+	}
+	if limit == nil {
+		return false, nil
+	}
+	usage, err := getRateUsage(s, chainID, asset)
+	if err != nil {
+		return false, fmt.Errorf("consumeRateLimit, getRateUsage error: %v", err)
+	}
+	height := s.ContractRef().BlockHeight().Uint64()
+	if start := height - height%limit.Window; usage.WindowStart != start {
+		usage = &RateUsage{WindowStart: start, Used: new(big.Int)}
+	}
+	usage.Used = new(big.Int).Add(usage.Used, amount)
+	if limit.Limit.Sign() > 0 && usage.Used.Cmp(limit.Limit) > 0 {
+		return false, fmt.Errorf("consumeRateLimit, chain %d asset %x exceeds rate limit %s in window from %d",
+			chainID, asset, limit.Limit, usage.WindowStart)
+	}
+	if err := putRateUsage(s, chainID, asset, usage); err != nil {
+		return false, fmt.Errorf("consumeRateLimit, putRateUsage error: %v", err)
+	}
+
+	if limit.PauseThreshold.Sign() == 0 || usage.Used.Cmp(limit.PauseThreshold) < 0 {
+		return false, nil
+	}
+	blacked, err := CheckIfChainBlacked(s, chainID)
+	if err != nil {
+		return false, fmt.Errorf("consumeRateLimit, CheckIfChainBlacked error: %v", err)
+	}
+	if !blacked {
+		PutBlackChain(s, chainID)
+		err = s.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventCircuitBreak}, chainID, asset, usage.Used, limit.PauseThreshold)
+		if err != nil {
+			return false, fmt.Errorf("consumeRateLimit, AddNotify error: %v", err)
+		}
+	}
+	return true, nil
+}
+
+func getRateLimit(s *native.NativeContract, chainID uint64, asset []byte) (*RateLimit, error) {
+	store, err := s.GetCacheDB().Get(rateLimitKey(chainID, asset))
+	if err != nil {
+		return nil, fmt.Errorf("getRateLimit, get rate limit store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	limit := new(RateLimit)
+	if err := rlp.DecodeBytes(store, limit); err != nil {
+		return nil, fmt.Errorf("getRateLimit, deserialize rate limit error: %v", err)
+	}
+	return limit, nil
+}
+
+func putRateLimit(s *native.NativeContract, chainID uint64, asset []byte, limit *RateLimit) error {
+	blob, err := rlp.EncodeToBytes(limit)
+	if err != nil {
+		return fmt.Errorf("putRateLimit, serialize rate limit error: %v", err)
+	}
+	s.GetCacheDB().Put(rateLimitKey(chainID, asset), blob)
+	return nil
+}
+
+func getRateUsage(s *native.NativeContract, chainID uint64, asset []byte) (*RateUsage, error) {
+	store, err := s.GetCacheDB().Get(rateUsageKey(chainID, asset))
+	if err != nil {
+		return nil, fmt.Errorf("getRateUsage, get rate usage store error: %v", err)
+	}
+	usage := &RateUsage{Used: new(big.Int)}
+	if store != nil {
+		if err := rlp.DecodeBytes(store, usage); err != nil {
+			return nil, fmt.Errorf("getRateUsage, deserialize rate usage error: %v", err)
+		}
+	}
+	return usage, nil
+}
+
+func putRateUsage(s *native.NativeContract, chainID uint64, asset []byte, usage *RateUsage) error {
+	blob, err := rlp.EncodeToBytes(usage)
+	if err != nil {
+		return fmt.Errorf("putRateUsage, serialize rate usage error: %v", err)
+	}
+	s.GetCacheDB().Put(rateUsageKey(chainID, asset), blob)
+	return nil
+}
+
+func rateLimitKey(chainID uint64, asset []byte) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(RATE_LIMIT), utils.GetUint64Bytes(chainID), asset)
+}
+
+func rateUsageKey(chainID uint64, asset []byte) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(RATE_USAGE), utils.GetUint64Bytes(chainID), asset)
+}
@@ -0,0 +1,430 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cross_chain_manager
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/btc"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/ripple"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	TRANSFER_DELAY        = "transferDelay"
+	PENDING_TRANSFER      = "pendingTransfer"
+	PENDING_TRANSFER_ID   = "pendingTransferID"
+	PENDING_TRANSFER_HEAD = "pendingTransferHead"
+	PENDING_TRANSFER_TAIL = "pendingTransferTail"
+)
+
+// TransferDelay parks the transfer of the asset from a side chain in pending queue for `Delay` blocks,
+// if the amount is not less than `Threshold`.
+type TransferDelay struct {
+	Threshold *big.Int
+	Delay     uint64
+}
+
+func (m *TransferDelay) Decode(payload []byte) error {
+	var data struct {
+		TransferDelay []byte
+	}
+	if err := utils.UnpackOutputs(scom.ABI, scom.MethodGetTransferDelay, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.TransferDelay, m)
+}
+
+// PendingTransfer is parked in the queue at `Index`, the ids between head and tail of the queue are
+// stored per index, and the slots of the released or cancelled transfers are left empty.
+type PendingTransfer struct {
+	SrcChainID    uint64
+	DstRouter     uint64
+	MakeTxParam   *scom.MakeTxParam
+	ReleaseHeight uint64
+	Index         uint64
+}
+
+func (m *PendingTransfer) Decode(payload []byte) error {
+	var data struct {
+		PendingTransfer []byte
+	}
+	if err := utils.UnpackOutputs(scom.ABI, scom.MethodGetPendingTransfer, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.PendingTransfer, m)
+}
+
+type PendingTransfers struct {
+	List []*PendingTransfer
+}
+
+func (m *PendingTransfers) Decode(payload []byte) error {
+	var data struct {
+		PendingTransfers []byte
+	}
+	if err := utils.UnpackOutputs(scom.ABI, scom.MethodGetPendingTransfers, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.PendingTransfers, m)
+}
+
+type PendingTransferID struct {
+	ChainID      uint64
+	CrossChainID []byte
+}
+
+// SetTransferDelay sets the threshold and delay of an asset from a side chain, zero threshold removes it.
+// the transfers already in queue are not affected.
+func SetTransferDelay(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.SetTransferDelayParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodSetTransferDelay, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("SetTransferDelay, unpack params error: %v", err)
+	}
+	if len(params.Asset) == 0 {
+		return nil, fmt.Errorf("SetTransferDelay, asset should not be empty")
+	}
+	remove := params.Threshold.Sign() == 0
+	if !remove && params.Delay == 0 {
+		return nil, fmt.Errorf("SetTransferDelay, delay should be positive")
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(s, scom.MethodSetTransferDelay, ctx.Payload, s.ContractRef().MsgSender(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SetTransferDelay, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodSetTransferDelay, true)
+	}
+
+	if remove {
+		s.GetCacheDB().Delete(transferDelayKey(params.ChainID, params.Asset))
+		return utils.PackOutputs(scom.ABI, scom.MethodSetTransferDelay, true)
+	}
+	blob, err := rlp.EncodeToBytes(&TransferDelay{Threshold: params.Threshold, Delay: params.Delay})
+	if err != nil {
+		return nil, fmt.Errorf("SetTransferDelay, serialize transfer delay error: %v", err)
+	}
+	s.GetCacheDB().Put(transferDelayKey(params.ChainID, params.Asset), blob)
+	return utils.PackOutputs(scom.ABI, scom.MethodSetTransferDelay, true)
+}
+
+func GetTransferDelay(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.GetTransferDelayParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodGetTransferDelay, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetTransferDelay, unpack params error: %v", err)
+	}
+	delay, err := getTransferDelay(s, params.ChainID, params.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("GetTransferDelay, getTransferDelay error: %v", err)
+	}
+	if delay == nil {
+		return nil, fmt.Errorf("GetTransferDelay, transfer delay of chain %d asset %x is not set", params.ChainID, params.Asset)
+	}
+	enc, err := rlp.EncodeToBytes(delay)
+	if err != nil {
+		return nil, fmt.Errorf("GetTransferDelay, serialize transfer delay error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetTransferDelay, enc)
+}
+
+// CancelTransfer drops the pending transfer with consensus signs, the transfer is still marked as done
+// and can not be imported again.
+func CancelTransfer(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.PendingTransferParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodCancelTransfer, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("CancelTransfer, unpack params error: %v", err)
+	}
+	pending, err := getPendingTransfer(s, params.ChainID, params.CrossChainID)
+	if err != nil {
+		return nil, fmt.Errorf("CancelTransfer, getPendingTransfer error: %v", err)
+	}
+	if pending == nil {
+		return nil, fmt.Errorf("CancelTransfer, transfer %x from chain %d is not pending", params.CrossChainID, params.ChainID)
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(s, scom.MethodCancelTransfer, ctx.Payload, s.ContractRef().MsgSender(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("CancelTransfer, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(scom.ABI, scom.MethodCancelTransfer, true)
+	}
+
+	if err := delPendingTransfer(s, params.ChainID, params.CrossChainID); err != nil {
+		return nil, fmt.Errorf("CancelTransfer, delPendingTransfer error: %v", err)
+	}
+	err = s.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventTransferCancelled}, params.ChainID, params.CrossChainID)
+	if err != nil {
+		return nil, fmt.Errorf("CancelTransfer, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodCancelTransfer, true)
+}
+
+// ReleaseTransfer makes the pending transfer after delay, anyone can release it unless the source or
+// target chain is blacked.
+func ReleaseTransfer(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.PendingTransferParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodReleaseTransfer, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("ReleaseTransfer, unpack params error: %v", err)
+	}
+	pending, err := getPendingTransfer(s, params.ChainID, params.CrossChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ReleaseTransfer, getPendingTransfer error: %v", err)
+	}
+	if pending == nil {
+		return nil, fmt.Errorf("ReleaseTransfer, transfer %x from chain %d is not pending", params.CrossChainID, params.ChainID)
+	}
+	if height := s.ContractRef().BlockHeight().Uint64(); height < pending.ReleaseHeight {
+		return nil, fmt.Errorf("ReleaseTransfer, transfer is locked until %d, current height %d", pending.ReleaseHeight, height)
+	}
+	for _, chainID := range []uint64{pending.SrcChainID, pending.MakeTxParam.ToChainID} {
+		blacked, err := CheckIfChainBlacked(s, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("ReleaseTransfer, CheckIfChainBlacked error: %v", err)
+		}
+		if blacked {
+			return nil, fmt.Errorf("ReleaseTransfer, chain %d is blacked", chainID)
+		}
+	}
+
+	if err := delPendingTransfer(s, params.ChainID, params.CrossChainID); err != nil {
+		return nil, fmt.Errorf("ReleaseTransfer, delPendingTransfer error: %v", err)
+	}
+	switch pending.DstRouter {
+	case utils.RIPPLE_ROUTER:
+		err = ripple.NewRippleHandler().MakeTransaction(s, pending.MakeTxParam, pending.SrcChainID)
+	case utils.BTC_ROUTER:
+		err = btc.NewBtcHandler().MakeTransaction(s, pending.MakeTxParam, pending.SrcChainID)
+	default:
+		err = scom.MakeTransaction(s, pending.MakeTxParam, pending.SrcChainID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ReleaseTransfer, make transaction error: %v", err)
+	}
+	err = s.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventTransferReleased}, params.ChainID, params.CrossChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ReleaseTransfer, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodReleaseTransfer, true)
+}
+
+func GetPendingTransfer(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &scom.PendingTransferParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodGetPendingTransfer, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetPendingTransfer, unpack params error: %v", err)
+	}
+	pending, err := getPendingTransfer(s, params.ChainID, params.CrossChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingTransfer, getPendingTransfer error: %v", err)
+	}
+	if pending == nil {
+		return nil, fmt.Errorf("GetPendingTransfer, transfer %x from chain %d is not pending", params.CrossChainID, params.ChainID)
+	}
+	enc, err := rlp.EncodeToBytes(pending)
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingTransfer, serialize pending transfer error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetPendingTransfer, enc)
+}
+
+func GetPendingTransfers(s *native.NativeContract) ([]byte, error) {
+	head, tail := getPendingTransferHead(s), getPendingTransferTail(s)
+	list := &PendingTransfers{List: make([]*PendingTransfer, 0)}
+	for i := head; i < tail; i++ {
+		id, err := getPendingTransferID(s, i)
+		if err != nil {
+			return nil, fmt.Errorf("GetPendingTransfers, getPendingTransferID error: %v", err)
+		}
+		if id == nil {
+			continue
+		}
+		pending, err := getPendingTransfer(s, id.ChainID, id.CrossChainID)
+		if err != nil {
+			return nil, fmt.Errorf("GetPendingTransfers, getPendingTransfer error: %v", err)
+		}
+		if pending != nil {
+			list.List = append(list.List, pending)
+		}
+	}
+	enc, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingTransfers, serialize pending transfers error: %v", err)
+	}
+	return utils.PackOutputs(scom.ABI, scom.MethodGetPendingTransfers, enc)
+}
+
+// queueTransfer parks the transfer in pending queue if the amount reaches the threshold of the asset.
+func queueTransfer(s *native.NativeContract, srcChainID uint64, param *scom.MakeTxParam, dstRouter uint64) (bool, error) {
+	asset, amount := transferAsset(param, dstRouter)
+	if len(asset) == 0 {
+		return false, nil
+	}
+	delay, err := getTransferDelay(s, srcChainID, asset)
+	if err != nil {
+		return false, err
+	}
+	if delay == nil || amount.Cmp(delay.Threshold) < 0 {
+		return false, nil
+	}
+	return true, parkTransfer(s, srcChainID, param, dstRouter, s.ContractRef().BlockHeight().Uint64()+delay.Delay)
+}
+
+// parkTransfer puts the transfer in pending queue, it can be released after the release height once
+// neither the source nor the target chain is blacked.
+func parkTransfer(s *native.NativeContract, srcChainID uint64, param *scom.MakeTxParam, dstRouter, releaseHeight uint64) error {
+	pending := &PendingTransfer{
+		SrcChainID:    srcChainID,
+		DstRouter:     dstRouter,
+		MakeTxParam:   param,
+		ReleaseHeight: releaseHeight,
+		Index:         getPendingTransferTail(s),
+	}
+	blob, err := rlp.EncodeToBytes(pending)
+	if err != nil {
+		return fmt.Errorf("parkTransfer, serialize pending transfer error: %v", err)
+	}
+	s.GetCacheDB().Put(pendingTransferKey(srcChainID, param.CrossChainID), blob)
+
+	id, err := rlp.EncodeToBytes(&PendingTransferID{ChainID: srcChainID, CrossChainID: param.CrossChainID})
+	if err != nil {
+		return fmt.Errorf("parkTransfer, serialize pending transfer id error: %v", err)
+	}
+	s.GetCacheDB().Put(pendingTransferIDKey(pending.Index), id)
+	s.GetCacheDB().Put(pendingTransferTailKey(), utils.GetUint64Bytes(pending.Index+1))
+
+	err = s.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventTransferQueued}, srcChainID, param.CrossChainID, pending.ReleaseHeight)
+	if err != nil {
+		return fmt.Errorf("parkTransfer, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+func getTransferDelay(s *native.NativeContract, chainID uint64, asset []byte) (*TransferDelay, error) {
+	store, err := s.GetCacheDB().Get(transferDelayKey(chainID, asset))
+	if err != nil {
+		return nil, fmt.Errorf("getTransferDelay, get transfer delay store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	delay := new(TransferDelay)
+	if err := rlp.DecodeBytes(store, delay); err != nil {
+		return nil, fmt.Errorf("getTransferDelay, deserialize transfer delay error: %v", err)
+	}
+	return delay, nil
+}
+
+func getPendingTransfer(s *native.NativeContract, chainID uint64, crossChainID []byte) (*PendingTransfer, error) {
+	store, err := s.GetCacheDB().Get(pendingTransferKey(chainID, crossChainID))
+	if err != nil {
+		return nil, fmt.Errorf("getPendingTransfer, get pending transfer store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	pending := new(PendingTransfer)
+	if err := rlp.DecodeBytes(store, pending); err != nil {
+		return nil, fmt.Errorf("getPendingTransfer, deserialize pending transfer error: %v", err)
+	}
+	return pending, nil
+}
+
+// delPendingTransfer removes the transfer and its slot in queue, the head skips the empty slots so that
+// the queue is not scanned from the first transfer ever parked.
+func delPendingTransfer(s *native.NativeContract, chainID uint64, crossChainID []byte) error {
+	pending, err := getPendingTransfer(s, chainID, crossChainID)
+	if err != nil {
+		return err
+	}
+	if pending == nil {
+		return nil
+	}
+	s.GetCacheDB().Delete(pendingTransferKey(chainID, crossChainID))
+	s.GetCacheDB().Delete(pendingTransferIDKey(pending.Index))
+
+	head, tail := getPendingTransferHead(s), getPendingTransferTail(s)
+	for ; head < tail; head++ {
+		id, err := getPendingTransferID(s, head)
+		if err != nil {
+			return err
+		}
+		if id != nil {
+			break
+		}
+	}
+	s.GetCacheDB().Put(pendingTransferHeadKey(), utils.GetUint64Bytes(head))
+	return nil
+}
+
+func getPendingTransferID(s *native.NativeContract, index uint64) (*PendingTransferID, error) {
+	store, err := s.GetCacheDB().Get(pendingTransferIDKey(index))
+	if err != nil {
+		return nil, fmt.Errorf("getPendingTransferID, get pending transfer id store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	id := new(PendingTransferID)
+	if err := rlp.DecodeBytes(store, id); err != nil {
+		return nil, fmt.Errorf("getPendingTransferID, deserialize pending transfer id error: %v", err)
+	}
+	return id, nil
+}
+
+func getPendingTransferHead(s *native.NativeContract) uint64 {
+	store, _ := s.GetCacheDB().Get(pendingTransferHeadKey())
+	return utils.GetBytesUint64(store)
+}
+
+func getPendingTransferTail(s *native.NativeContract) uint64 {
+	store, _ := s.GetCacheDB().Get(pendingTransferTailKey())
+	return utils.GetBytesUint64(store)
+}
+
+func transferDelayKey(chainID uint64, asset []byte) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(TRANSFER_DELAY), utils.GetUint64Bytes(chainID), asset)
+}
+
+func pendingTransferKey(chainID uint64, crossChainID []byte) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(PENDING_TRANSFER), utils.GetUint64Bytes(chainID), crossChainID)
+}
+
+func pendingTransferIDKey(index uint64) []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(PENDING_TRANSFER_ID), utils.GetUint64Bytes(index))
+}
+
+func pendingTransferHeadKey() []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(PENDING_TRANSFER_HEAD))
+}
+
+func pendingTransferTailKey() []byte {
+	return utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(PENDING_TRANSFER_TAIL))
+}
@@ -93,7 +93,11 @@ func (this *RippleHandler) MakeDepositProposal(service *native.NativeContract) (
 		if err != nil {
 			return nil, fmt.Errorf("ripple MakeDepositProposal, rlp.DecodeBytes error: %s", err)
 		}
+		// the destination tag and memo of deposit are passed through to target chain
 		b, err := scom.EncodeRippleTxArgs(args)
+		if err != nil {
+			return nil, fmt.Errorf("ripple MakeDepositProposal, EncodeRippleTxArgs error: %s", err)
+		}
 		txParam.Args = b
 
 		return txParam, nil
@@ -129,6 +133,12 @@ func (this *RippleHandler) MultiSign(service *native.NativeContract) error {
 		return nil
 	}
 
+	tx, err := deserializeRawTx(raw)
+	if err != nil {
+		return fmt.Errorf("MultiSign, deserializeRawTx error: %v", err)
+	}
+	pks, quorum := rippleExtraInfo.SignerSet(uint64(tx.GetBase().Sequence))
+
 	// check if signature is valid
 	txJson := new(types.MultisignPayment)
 	err = json.Unmarshal([]byte(params.TxJson), txJson)
@@ -151,7 +161,7 @@ func (this *RippleHandler) MultiSign(service *native.NativeContract) error {
 
 		// check if valid signer
 		flag := false
-		for _, v := range rippleExtraInfo.Pks {
+		for _, v := range pks {
 			if fmt.Sprintf("%X", v) == s.Signer.SigningPubKey {
 				flag = true
 				break
@@ -162,9 +172,9 @@ func (this *RippleHandler) MultiSign(service *native.NativeContract) error {
 		}
 
 		//check if valid signature
-		err = types.CheckMultiSign(raw, *signerAccount, signerPk, signature)
+		err = checkMultiSign(tx, *signerAccount, signerPk, signature)
 		if err != nil {
-			return fmt.Errorf("MultiSign, types.CheckMultiSign error: %s", err)
+			return fmt.Errorf("MultiSign, checkMultiSign error: %s", err)
 		}
 		signer := &Signer{
 			Account:       signerAccount.Bytes(),
@@ -178,11 +188,7 @@ func (this *RippleHandler) MultiSign(service *native.NativeContract) error {
 		multisignInfo.SigMap[hex.EncodeToString(blob)] = true
 	}
 
-	if uint64(len(multisignInfo.SigMap)) >= rippleExtraInfo.Quorum {
-		payment, err := types.DeserializeRawMultiSignTx(raw)
-		if err != nil {
-			return fmt.Errorf("MultiSign, types.DeserializeRawMultiSignTx error")
-		}
+	if uint64(len(multisignInfo.SigMap)) >= quorum {
 		for s := range multisignInfo.SigMap {
 			signerBytes, err := hex.DecodeString(s)
 			if err != nil {
@@ -201,15 +207,25 @@ func (this *RippleHandler) MultiSign(service *native.NativeContract) error {
 			acc := data.Account{}
 			copy(acc[:], signer.Account)
 			sig.Signer.Account = acc
-			payment.Signers = append(payment.Signers, sig)
+			tx.GetBase().Signers = append(tx.GetBase().Signers, sig)
 		}
 
-		finalPayment, err := json.Marshal(payment)
-		if err != nil {
-			return fmt.Errorf("MultiSign, json.Marshal final payment error: %s", err)
+		var finalPayment []byte
+		if tx.GetTransactionType() == data.SIGNER_LIST_SET {
+			// the json of signer entries is not in the format of rippled, so the signed tx blob is submitted
+			signed, err := serializeTx(tx)
+			if err != nil {
+				return fmt.Errorf("MultiSign, serializeTx error: %s", err)
+			}
+			finalPayment = []byte(hex.EncodeToString(signed))
+		} else {
+			finalPayment, err = json.Marshal(tx)
+			if err != nil {
+				return fmt.Errorf("MultiSign, json.Marshal final payment error: %s", err)
+			}
 		}
 		err = service.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventMultiSign}, params.FromChainId, params.ToChainId,
-			hex.EncodeToString(params.TxHash), string(finalPayment), payment.Sequence)
+			hex.EncodeToString(params.TxHash), string(finalPayment), tx.GetBase().Sequence)
 		if err != nil {
 			return fmt.Errorf("MultiSign, AddNotify error: %v", err)
 		}
@@ -225,14 +241,9 @@ func (this *RippleHandler) MakeTransaction(service *native.NativeContract, param
 	fromChainID uint64) error {
 	args, err := scom.DecodeRippleTxArgs(param.Args)
 	if err != nil {
-		fmt.Errorf("ripple MakeTransaction, deserialize asset hash error")
+		return fmt.Errorf("ripple MakeTransaction, deserialize asset hash error")
 	}
 	toAddrBytes := args.ToAddress
-	amount_temp := args.Amount.Uint64()
-	amount, err := data.NewAmount(new(big.Int).SetUint64(amount_temp).String())
-	if err != nil {
-		return fmt.Errorf("ripple MakeTransaction, data.NewAmount error: %s", err)
-	}
 
 	//get asset map
 	assetBind, err := side_chain_manager.GetAssetBind(service, param.ToChainID)
@@ -274,20 +285,34 @@ func (this *RippleHandler) MakeTransaction(service *native.NativeContract, param
 	if err != nil {
 		return fmt.Errorf("ripple MakeTransaction, data.NewValue fee error: %s", err)
 	}
-	feeAmount, err := data.NewAmount(fee_temp.String())
-	if err != nil {
-		return fmt.Errorf("ripple MakeTransaction, data.NewAmount fee error: %s", err)
-	}
-	amountD, err := amount.Subtract(feeAmount)
-	if err != nil {
-		return fmt.Errorf("ripple MakeTransaction, amount.Subtract fee error: %s", err)
-	}
-	reserveAmount, err := data.NewValue(rippleExtraInfo.ReserveAmount.String(), false)
-	if err != nil {
-		return fmt.Errorf("ripple MakeTransaction, side_chain_manager.GetFee error: %v", err)
-	}
-	if amountD.Compare(*reserveAmount) < 0 {
-		return fmt.Errorf("ripple MakeTransaction, amount is less than reserveAmount")
+
+	var amountD *data.Amount
+	if assetBind.IsIssuedCurrency() {
+		// the fee in XRP is paid by the multisign account, which is charged on zion instead
+		amountD, err = issuedAmount(assetBind, args.Amount)
+		if err != nil {
+			return fmt.Errorf("ripple MakeTransaction, issuedAmount error: %s", err)
+		}
+	} else {
+		amount, err := data.NewAmount(new(big.Int).SetUint64(args.Amount.Uint64()).String())
+		if err != nil {
+			return fmt.Errorf("ripple MakeTransaction, data.NewAmount error: %s", err)
+		}
+		feeAmount, err := data.NewAmount(fee_temp.String())
+		if err != nil {
+			return fmt.Errorf("ripple MakeTransaction, data.NewAmount fee error: %s", err)
+		}
+		amountD, err = amount.Subtract(feeAmount)
+		if err != nil {
+			return fmt.Errorf("ripple MakeTransaction, amount.Subtract fee error: %s", err)
+		}
+		reserveAmount, err := data.NewValue(rippleExtraInfo.ReserveAmount.String(), false)
+		if err != nil {
+			return fmt.Errorf("ripple MakeTransaction, side_chain_manager.GetFee error: %v", err)
+		}
+		if amountD.Compare(*reserveAmount) < 0 {
+			return fmt.Errorf("ripple MakeTransaction, amount is less than reserveAmount")
+		}
 	}
 
 	from := new(data.Account)
@@ -296,6 +321,15 @@ func (this *RippleHandler) MakeTransaction(service *native.NativeContract, param
 	copy(to[:], toAddrBytes)
 
 	payment := types.GeneratePayment(*from, *to, *amountD, *fee, uint32(rippleExtraInfo.Sequence))
+	if args.HasDestinationTag {
+		tag := args.DestinationTag
+		payment.DestinationTag = &tag
+	}
+	if len(args.Memo) > 0 {
+		memo := data.Memo{}
+		memo.Memo.MemoData = args.Memo
+		payment.Memos = data.Memos{memo}
+	}
 	_, raw, err := data.Raw(payment)
 	if err != nil {
 		return fmt.Errorf("ripple MakeTransaction, data.Raw error: %s", err)
@@ -306,6 +340,15 @@ func (this *RippleHandler) MakeTransaction(service *native.NativeContract, param
 		return fmt.Errorf("ripple MakeTransaction, AddNotify error: %v", err)
 	}
 
+	err = putRippleTxRecord(service, param.ToChainID, payment.Sequence, &RippleTxRecord{
+		FromChainId: fromChainID,
+		TxHash:      param.TxHash,
+		Height:      service.ContractRef().BlockHeight().Uint64(),
+	})
+	if err != nil {
+		return fmt.Errorf("ripple MakeTransaction, putRippleTxRecord error: %s", err)
+	}
+
 	//sequence + 1
 	rippleExtraInfo.Sequence = rippleExtraInfo.Sequence + 1
 	err = side_chain_manager.PutRippleExtraInfo(service, param.ToChainID, rippleExtraInfo)
@@ -346,9 +389,9 @@ func (this *RippleHandler) ReconstructTx(service *native.NativeContract) error {
 		return fmt.Errorf("ReconstructTx, side_chain_manager.GetRippleExtraInfo error: %v", err)
 	}
 
-	payment, err := types.DeserializeRawMultiSignTx(raw)
+	tx, err := deserializeRawTx(raw)
 	if err != nil {
-		return fmt.Errorf("ReconstructTx, types.DeserializeRawMultiSignTx error")
+		return fmt.Errorf("ReconstructTx, deserializeRawTx error: %v", err)
 	}
 
 	//fee = baseFee * signerNum
@@ -358,19 +401,38 @@ func (this *RippleHandler) ReconstructTx(service *native.NativeContract) error {
 		return fmt.Errorf("ReconstructTx, data.NewValue fee error: %s", err)
 	}
 
-	payment.Fee = *fee
-	_, newRaw, err := data.Raw(payment)
+	tx.GetBase().Fee = *fee
+	newRaw, err := serializeTx(tx)
 	if err != nil {
-		return fmt.Errorf("ReconstructTx, data.Raw error: %s", err)
+		return fmt.Errorf("ReconstructTx, serializeTx error: %s", err)
 	}
 
 	//store txJson info
 	PutTxJsonInfo(service, params.FromChainId, params.TxHash, hex.EncodeToString(newRaw))
 
 	err = service.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventRippleTx}, params.FromChainId, params.ToChainId,
-		hex.EncodeToString(params.TxHash), hex.EncodeToString(newRaw), payment.Sequence)
+		hex.EncodeToString(params.TxHash), hex.EncodeToString(newRaw), tx.GetBase().Sequence)
 	if err != nil {
 		return fmt.Errorf("ReconstructTx, AddNotify error: %v", err)
 	}
 	return nil
 }
+
+// issuedAmount converts the amount to the value of issued currency, the value is the amount scaled down
+// by the precision of asset bind.
+func issuedAmount(assetBind *side_chain_manager.AssetBind, amount *big.Int) (*data.Amount, error) {
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount should be positive")
+	}
+	value, err := data.NewValue(ToStringByPrecise(amount, assetBind.Precision), false)
+	if err != nil {
+		return nil, fmt.Errorf("data.NewValue error: %s", err)
+	}
+	currency, err := data.NewCurrency(assetBind.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("data.NewCurrency error: %s", err)
+	}
+	issuer := data.Account{}
+	copy(issuer[:], assetBind.Issuer)
+	return &data.Amount{Value: value, Currency: currency, Issuer: issuer}, nil
+}
@@ -1,12 +1,23 @@
 package ripple
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"github.com/polynetwork/ripple-sdk/types"
-	"github.com/stretchr/testify/assert"
 	"math/big"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/ripple-sdk/types"
+	"github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestJsonMarshall(t *testing.T) {
@@ -23,4 +34,129 @@ func TestStringPrecise(t *testing.T) {
 	fee_temp := new(big.Int).SetUint64(150)
 	fee := ToStringByPrecise(fee_temp, 6)
 	assert.Equal(t, fee, "0.00015")
-}
\ No newline at end of file
+}
+func TestMakeTransaction(t *testing.T) {
+	sdb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	contractRef := native.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 10000000, nil)
+	contractRef.PushContext(&native.Context{ContractAddress: utils.CrossChainManagerContractAddress})
+	contract := native.NewNativeContract(sdb, contractRef)
+
+	chainID := uint64(6)
+	multisign, err := data.NewAccountFromAddress("rsHYGX2AoQ4tXqFywzEeeTDgXFTUfL1Fw9")
+	assert.Nil(t, err)
+	issuer, err := data.NewAccountFromAddress("rLi6oSF38EdP7mzhdccyxhfd8vp8FWbsWF")
+	assert.Nil(t, err)
+	to, err := data.NewAccountFromAddress("rT4vRkeJsgaq7t6TVJJPsbrQp5oKMGRfN")
+	assert.Nil(t, err)
+
+	extra, err := rlp.EncodeToBytes(&side_chain_manager.RippleExtraInfo{
+		Sequence:      10,
+		Quorum:        2,
+		SignerNum:     3,
+		ReserveAmount: big.NewInt(10),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, side_chain_manager.PutSideChain(contract, &side_chain_manager.SideChain{
+		ChainID:   chainID,
+		Router:    utils.RIPPLE_ROUTER,
+		ExtraInfo: extra,
+	}))
+	assert.Nil(t, side_chain_manager.PutFee(contract, chainID, &side_chain_manager.Fee{View: 1, Fee: big.NewInt(10)}))
+	assetBind := &side_chain_manager.AssetBind{
+		AssetMap:     map[uint64][]byte{chainID: multisign.Bytes()},
+		LockProxyMap: map[uint64][]byte{chainID: multisign.Bytes()},
+	}
+	assert.Nil(t, side_chain_manager.PutAssetBind(contract, chainID, assetBind))
+
+	makeTransaction := func(txHash []byte, args *scom.RippleTxArgs) *data.Payment {
+		encoded, err := scom.EncodeRippleTxArgs(args)
+		assert.Nil(t, err)
+		param := &scom.MakeTxParam{TxHash: txHash, ToChainID: chainID, ToContractAddress: multisign.Bytes(), Args: encoded}
+		assert.Nil(t, NewRippleHandler().MakeTransaction(contract, param, 2))
+		raw, err := GetTxJsonInfo(contract, 2, txHash)
+		assert.Nil(t, err)
+		payment, err := types.DeserializeRawMultiSignTx(raw)
+		assert.Nil(t, err)
+		return payment
+	}
+
+	// XRP with destination tag, the fee is deducted
+	payment := makeTransaction([]byte{1}, &scom.RippleTxArgs{ToAddress: to.Bytes(), Amount: big.NewInt(1000), HasDestinationTag: true, DestinationTag: 0})
+	assert.True(t, payment.Amount.IsNative())
+	assert.Equal(t, "0.00097", payment.Amount.Value.String())
+	assert.NotNil(t, payment.DestinationTag)
+	assert.Equal(t, uint32(0), *payment.DestinationTag)
+	assert.Equal(t, 0, len(payment.Memos))
+
+	// issued currency with memo
+	assetBind.Currency, assetBind.Issuer, assetBind.Precision = "USD", issuer.Bytes(), 6
+	assert.Nil(t, side_chain_manager.PutAssetBind(contract, chainID, assetBind))
+	payment = makeTransaction([]byte{2}, &scom.RippleTxArgs{ToAddress: to.Bytes(), Amount: big.NewInt(1500000), Memo: []byte("memo")})
+	assert.False(t, payment.Amount.IsNative())
+	assert.Equal(t, "USD", payment.Amount.Currency.String())
+	assert.Equal(t, *issuer, payment.Amount.Issuer)
+	assert.Equal(t, "1.5", payment.Amount.Value.String())
+	assert.Nil(t, payment.DestinationTag)
+	assert.Equal(t, 1, len(payment.Memos))
+	assert.Equal(t, []byte("memo"), payment.Memos[0].Memo.MemoData.Bytes())
+	assert.Equal(t, uint32(11), payment.Sequence)
+}
+
+func TestSignerListSetCodec(t *testing.T) {
+	account, err := data.NewAccountFromAddress("rsHYGX2AoQ4tXqFywzEeeTDgXFTUfL1Fw9")
+	assert.Nil(t, err)
+	signer, err := data.NewAccountFromAddress("rLi6oSF38EdP7mzhdccyxhfd8vp8FWbsWF")
+	assert.Nil(t, err)
+	fee, err := data.NewValue("0.00003", true)
+	assert.Nil(t, err)
+	weight := uint16(1)
+	tx := &data.SignerListSet{
+		TxBase: data.TxBase{
+			TransactionType: data.SIGNER_LIST_SET,
+			Account:         *account,
+			Sequence:        7,
+			Fee:             *fee,
+		},
+		SignerQuorum:  1,
+		SignerEntries: []data.SignerEntry{{Account: signer, SignerWeight: &weight}, {Account: account, SignerWeight: &weight}},
+	}
+	raw, err := serializeTx(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, encodeSignerEntries(tx.SignerEntries), raw[len(raw)-2*signerEntryLen-2:])
+
+	decoded, err := deserializeRawTx(hex.EncodeToString(raw))
+	assert.Nil(t, err)
+	signerListSet, ok := decoded.(*data.SignerListSet)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(7), signerListSet.Sequence)
+	assert.Equal(t, uint32(1), signerListSet.SignerQuorum)
+	assert.Equal(t, tx.SignerEntries, signerListSet.SignerEntries)
+
+	// the signers are placed before the signer entries
+	signerListSet.Signers = append(signerListSet.Signers, data.Signer{})
+	signerListSet.Signers[0].Signer.Account = *signer
+	signerListSet.Signers[0].Signer.SigningPubKey = new(data.PublicKey)
+	signerListSet.Signers[0].Signer.TxnSignature = &data.VariableLength{1}
+	signed, err := serializeTx(signerListSet)
+	assert.Nil(t, err)
+	body, entries, ok := splitSignerEntries(signed)
+	assert.True(t, ok)
+	assert.Equal(t, tx.SignerEntries, entries)
+	assert.Equal(t, byte(endOfArray), body[len(body)-1])
+
+	// the payment is not mistaken for signer list
+	amount, err := data.NewAmount("1")
+	assert.Nil(t, err)
+	payment := &data.Payment{
+		TxBase:      data.TxBase{TransactionType: data.PAYMENT, Account: *account, Sequence: 8, Fee: *fee},
+		Destination: *signer,
+		Amount:      *amount,
+	}
+	payment.Memos = append(payment.Memos, data.Memo{})
+	payment.Memos[0].Memo.MemoData = data.VariableLength("memo")
+	_, raw, err = data.Raw(payment)
+	assert.Nil(t, err)
+	decoded, err = deserializeRawTx(hex.EncodeToString(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, data.PAYMENT, decoded.GetTransactionType())
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright (C) 2021 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ripple
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	scom "github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/go_abi/cross_chain_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/rubblelabs/ripple/crypto"
+	"github.com/rubblelabs/ripple/data"
+)
+
+const (
+	// max signer entries of SignerListSet
+	MaxSignerEntries = 32
+	// the tx is regarded as stuck if not executed after blocks, and can be re-issued with escalated fee,
+	// the blocks to wait are doubled for each bump
+	StuckBlocks = 600
+	// the fee is doubled for each bump, up to 2^MaxFeeBumps times of base fee
+	MaxFeeBumps = 5
+)
+
+// RotateSigners generates the SignerListSet tx which replaces the signers of multisign account, the tx
+// is signed by current signers with `MultiSign`. the txs issued after it are signed by the new signers.
+func (this *RippleHandler) RotateSigners(service *native.NativeContract) error {
+	ctx := service.ContractRef().CurrentContext()
+	params := &scom.RotateRippleSignersParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodRotateRippleSigners, params, ctx.Payload); err != nil {
+		return fmt.Errorf("RotateSigners, contract params deserialize error: %v", err)
+	}
+	if len(params.Pks) == 0 || len(params.Pks) > MaxSignerEntries {
+		return fmt.Errorf("RotateSigners, signers count should be in [1, %d]", MaxSignerEntries)
+	}
+	if params.Quorum == 0 || params.Quorum > uint64(len(params.Pks)) {
+		return fmt.Errorf("RotateSigners, invalid quorum %d of %d signers", params.Quorum, len(params.Pks))
+	}
+	entries := make([]data.SignerEntry, 0, len(params.Pks))
+	accounts := make(map[data.Account]bool, len(params.Pks))
+	for _, pk := range params.Pks {
+		if !validPublicKey(pk) {
+			return fmt.Errorf("RotateSigners, invalid public key %X", pk)
+		}
+		account := new(data.Account)
+		copy(account[:], crypto.Sha256RipeMD160(pk))
+		if accounts[*account] {
+			return fmt.Errorf("RotateSigners, duplicated public key %X", pk)
+		}
+		accounts[*account] = true
+		weight := uint16(1)
+		entries = append(entries, data.SignerEntry{Account: account, SignerWeight: &weight})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].Account[:], entries[j].Account[:]) < 0
+	})
+
+	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(service, params.ChainID)
+	if err != nil {
+		return fmt.Errorf("RotateSigners, side_chain_manager.GetRippleExtraInfo error: %v", err)
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(service, scom.MethodRotateRippleSigners, ctx.Payload, service.ContractRef().MsgSender(), node_manager.Signer)
+	if err != nil {
+		return fmt.Errorf("RotateSigners, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	assetBind, err := side_chain_manager.GetAssetBind(service, params.ChainID)
+	if err != nil {
+		return fmt.Errorf("RotateSigners, get asset map error: %v", err)
+	}
+	assetAddress, ok := assetBind.AssetMap[params.ChainID]
+	if !ok {
+		return fmt.Errorf("RotateSigners, asset map of chain %d is not registered", params.ChainID)
+	}
+	fee, err := escalatedFee(service, params.ChainID, rippleExtraInfo.SignerNum, 0)
+	if err != nil {
+		return fmt.Errorf("RotateSigners, escalatedFee error: %v", err)
+	}
+
+	from := data.Account{}
+	copy(from[:], assetAddress)
+	tx := &data.SignerListSet{
+		TxBase: data.TxBase{
+			TransactionType: data.SIGNER_LIST_SET,
+			Account:         from,
+			Sequence:        uint32(rippleExtraInfo.Sequence),
+			Fee:             *fee,
+		},
+		SignerQuorum:  uint32(params.Quorum),
+		SignerEntries: entries,
+	}
+	raw, err := serializeTx(tx)
+	if err != nil {
+		return fmt.Errorf("RotateSigners, serializeTx error: %v", err)
+	}
+
+	// the rotation is identified by the zion tx and the chain itself
+	txHash := service.ContractRef().TxHash()
+	PutTxJsonInfo(service, params.ChainID, txHash[:], hex.EncodeToString(raw))
+	err = putRippleTxRecord(service, params.ChainID, tx.Sequence, &RippleTxRecord{
+		FromChainId: params.ChainID,
+		TxHash:      txHash[:],
+		Height:      service.ContractRef().BlockHeight().Uint64(),
+	})
+	if err != nil {
+		return fmt.Errorf("RotateSigners, putRippleTxRecord error: %v", err)
+	}
+
+	rippleExtraInfo.Rotate(rippleExtraInfo.Sequence, params.Pks, params.Quorum)
+	rippleExtraInfo.Sequence = rippleExtraInfo.Sequence + 1
+	if err := side_chain_manager.PutRippleExtraInfo(service, params.ChainID, rippleExtraInfo); err != nil {
+		return fmt.Errorf("RotateSigners, side_chain_manager.PutRippleExtraInfo error: %v", err)
+	}
+
+	err = service.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventRippleSignerListSet}, params.ChainID,
+		hex.EncodeToString(txHash[:]), hex.EncodeToString(raw), tx.Sequence)
+	if err != nil {
+		return fmt.Errorf("RotateSigners, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+// BumpFee re-issues the stuck tx with the same sequence and doubled fee, the signatures of the previous
+// tx are discarded since the raw tx changes. zion can not see whether the tx is executed on ripple, so
+// the bump is made only if the quorum of voters, who relay the ripple txs, agree that it is stuck.
+func (this *RippleHandler) BumpFee(service *native.NativeContract) error {
+	ctx := service.ContractRef().CurrentContext()
+	params := &scom.BumpRippleFeeParam{}
+	if err := utils.UnpackMethod(scom.ABI, scom.MethodBumpRippleFee, params, ctx.Payload); err != nil {
+		return fmt.Errorf("BumpFee, contract params deserialize error: %v", err)
+	}
+
+	raw, err := GetTxJsonInfo(service, params.FromChainId, params.TxHash)
+	if err != nil {
+		return fmt.Errorf("BumpFee, GetTxJsonInfo error: %v", err)
+	}
+	tx, err := deserializeRawTx(raw)
+	if err != nil {
+		return fmt.Errorf("BumpFee, deserializeRawTx error: %v", err)
+	}
+	sequence := tx.GetBase().Sequence
+	record, err := GetRippleTxRecord(service, params.ToChainId, sequence)
+	if err != nil {
+		return fmt.Errorf("BumpFee, GetRippleTxRecord error: %v", err)
+	}
+	if record == nil || record.FromChainId != params.FromChainId || !bytes.Equal(record.TxHash, params.TxHash) {
+		return fmt.Errorf("BumpFee, tx is not tracked with sequence %d", sequence)
+	}
+	if record.Attempts >= MaxFeeBumps {
+		return fmt.Errorf("BumpFee, tx with sequence %d has been bumped %d times", sequence, record.Attempts)
+	}
+	height := service.ContractRef().BlockHeight().Uint64()
+	if next := record.Height + StuckBlocks<<record.Attempts; height < next {
+		return fmt.Errorf("BumpFee, tx with sequence %d can be bumped after height %d", sequence, next)
+	}
+
+	// each bump of the sequence is voted separately
+	id := append(utils.GetUint64Bytes(params.ToChainId), utils.GetUint64Bytes(uint64(sequence))...)
+	id = append(id, utils.GetUint64Bytes(record.Attempts)...)
+	ok, err := node_manager.CheckConsensusSigns(service, scom.MethodBumpRippleFee, id, service.ContractRef().MsgSender(), node_manager.Voter)
+	if err != nil {
+		return fmt.Errorf("BumpFee, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(service, params.ToChainId)
+	if err != nil {
+		return fmt.Errorf("BumpFee, side_chain_manager.GetRippleExtraInfo error: %v", err)
+	}
+	pks, _ := rippleExtraInfo.SignerSet(uint64(sequence))
+	fee, err := escalatedFee(service, params.ToChainId, uint64(len(pks)), record.Attempts+1)
+	if err != nil {
+		return fmt.Errorf("BumpFee, escalatedFee error: %v", err)
+	}
+	tx.GetBase().Fee = *fee
+	newRaw, err := serializeTx(tx)
+	if err != nil {
+		return fmt.Errorf("BumpFee, serializeTx error: %v", err)
+	}
+	PutTxJsonInfo(service, params.FromChainId, params.TxHash, hex.EncodeToString(newRaw))
+
+	record.Height = height
+	record.Attempts = record.Attempts + 1
+	if err := putRippleTxRecord(service, params.ToChainId, sequence, record); err != nil {
+		return fmt.Errorf("BumpFee, putRippleTxRecord error: %v", err)
+	}
+
+	if tx.GetTransactionType() == data.SIGNER_LIST_SET {
+		err = service.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventRippleSignerListSet}, params.ToChainId,
+			hex.EncodeToString(params.TxHash), hex.EncodeToString(newRaw), sequence)
+	} else {
+		err = service.AddNotify(scom.ABI, []string{cross_chain_manager_abi.EventRippleTx}, params.FromChainId, params.ToChainId,
+			hex.EncodeToString(params.TxHash), hex.EncodeToString(newRaw), sequence)
+	}
+	if err != nil {
+		return fmt.Errorf("BumpFee, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+// escalatedFee returns baseFee * signerNum * 2^attempts in XRP
+func escalatedFee(service *native.NativeContract, chainID, signerNum, attempts uint64) (*data.Value, error) {
+	baseFee, err := side_chain_manager.GetFeeObj(service, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("side_chain_manager.GetFee error: %v", err)
+	}
+	if baseFee.View == 0 {
+		return nil, fmt.Errorf("base fee is not initialized")
+	}
+	fee := new(big.Int).Mul(baseFee.Fee, new(big.Int).SetUint64(signerNum))
+	fee.Lsh(fee, uint(attempts))
+	return data.NewValue(ToStringByPrecise(fee, 6), true)
+}
+
+// validPublicKey checks the secp256k1 compressed public key or ed25519 public key prefixed with 0xED
+func validPublicKey(pk []byte) bool {
+	if len(pk) != 33 {
+		return false
+	}
+	return pk[0] == 0x02 || pk[0] == 0x03 || pk[0] == 0xED
+}
@@ -18,9 +18,12 @@
 package ripple
 
 import (
-	"github.com/ethereum/go-ethereum/rlp"
 	"io"
 	"sort"
+
+	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 type MultisignInfo struct {
@@ -64,3 +67,21 @@ type Signer struct {
 	TxnSignature  []byte
 	SigningPubKey []byte
 }
+
+// RippleTxRecord tracks the tx issued with a sequence of multisign account, `Attempts` counts the fee bumps.
+type RippleTxRecord struct {
+	FromChainId uint64
+	TxHash      []byte
+	Height      uint64
+	Attempts    uint64
+}
+
+func (this *RippleTxRecord) Decode(payload []byte) error {
+	var data struct {
+		Record []byte
+	}
+	if err := utils.UnpackOutputs(common.ABI, common.MethodGetRippleTxRecord, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Record, this)
+}
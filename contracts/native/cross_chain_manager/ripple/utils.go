@@ -18,11 +18,16 @@
 package ripple
 
 import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
 	"fmt"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/cross_chain_manager/common"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/rubblelabs/ripple/crypto"
+	"github.com/rubblelabs/ripple/data"
 	"math/big"
 	"strings"
 )
@@ -73,6 +78,149 @@ func GetTxJsonInfo(native *native.NativeContract, fromChainId uint64, txHash []b
 	return string(store), nil
 }
 
+const RIPPLE_TX_RECORD = "rippleTxRecord"
+
+func putRippleTxRecord(native *native.NativeContract, chainId uint64, sequence uint32, record *RippleTxRecord) error {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(RIPPLE_TX_RECORD), utils.GetUint64Bytes(chainId),
+		utils.GetUint64Bytes(uint64(sequence)))
+	blob, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return fmt.Errorf("putRippleTxRecord, rlp.EncodeToBytes record error: %v", err)
+	}
+	native.GetCacheDB().Put(key, blob)
+	return nil
+}
+
+func GetRippleTxRecord(native *native.NativeContract, chainId uint64, sequence uint32) (*RippleTxRecord, error) {
+	key := utils.ConcatKey(utils.CrossChainManagerContractAddress, []byte(RIPPLE_TX_RECORD), utils.GetUint64Bytes(chainId),
+		utils.GetUint64Bytes(uint64(sequence)))
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleTxRecord, get record store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	record := new(RippleTxRecord)
+	if err := rlp.DecodeBytes(store, record); err != nil {
+		return nil, fmt.Errorf("GetRippleTxRecord, deserialize record error: %v", err)
+	}
+	return record, nil
+}
+
+// the SignerEntries of SignerListSet are not wrapped into SignerEntry objects by the codec of
+// rubblelabs/ripple, so the array is encoded here and kept at the tail of raw tx, which is its
+// canonical position as there is no other array field sorted after it.
+const (
+	signerEntriesField = 0xF4 // STArray SignerEntries
+	signerEntryField   = 0xEB // STObject SignerEntry
+	signerWeightField  = 0x13 // UInt16 SignerWeight
+	accountField       = 0x81 // AccountID Account
+	endOfObject        = 0xE1
+	endOfArray         = 0xF1
+	signerEntryLen     = 27
+)
+
+func encodeSignerEntries(entries []data.SignerEntry) []byte {
+	buf := make([]byte, 0, len(entries)*signerEntryLen+2)
+	buf = append(buf, signerEntriesField)
+	for _, entry := range entries {
+		buf = append(buf, signerEntryField, signerWeightField, byte(*entry.SignerWeight>>8), byte(*entry.SignerWeight), accountField, 20)
+		buf = append(buf, entry.Account[:]...)
+		buf = append(buf, endOfObject)
+	}
+	return append(buf, endOfArray)
+}
+
+// splitSignerEntries splits the SignerEntries array from the tail of raw tx
+func splitSignerEntries(raw []byte) ([]byte, []data.SignerEntry, bool) {
+	var entries []data.SignerEntry
+	if len(raw) == 0 || raw[len(raw)-1] != endOfArray {
+		return raw, nil, false
+	}
+	for end := len(raw) - 1; end >= signerEntryLen+1; end -= signerEntryLen {
+		entry := raw[end-signerEntryLen : end]
+		if entry[0] != signerEntryField || entry[1] != signerWeightField || entry[4] != accountField ||
+			entry[5] != 20 || entry[signerEntryLen-1] != endOfObject {
+			break
+		}
+		weight := uint16(entry[2])<<8 | uint16(entry[3])
+		account := new(data.Account)
+		copy(account[:], entry[6:26])
+		entries = append([]data.SignerEntry{{Account: account, SignerWeight: &weight}}, entries...)
+		if start := end - signerEntryLen - 1; raw[start] == signerEntriesField {
+			return raw[:start], entries, true
+		}
+	}
+	return raw, nil, false
+}
+
+// serializeTx serializes the tx of any type for multisign, e.g. Payment and SignerListSet
+func serializeTx(tx data.Transaction) ([]byte, error) {
+	signerListSet, ok := tx.(*data.SignerListSet)
+	if !ok {
+		_, raw, err := data.Raw(tx)
+		return raw, err
+	}
+	body := *signerListSet
+	body.SignerEntries = nil
+	_, raw, err := data.Raw(&body)
+	if err != nil {
+		return nil, err
+	}
+	return append(raw, encodeSignerEntries(signerListSet.SignerEntries)...), nil
+}
+
+// deserializeRawTx deserializes the raw tx of any type for multisign, e.g. Payment and SignerListSet
+func deserializeRawTx(raw string) (data.Transaction, error) {
+	txData, err := hex.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decode raw tx error: %v", err)
+	}
+	var tx data.Transaction
+	if body, entries, ok := splitSignerEntries(txData); ok {
+		tx, err = data.ReadTransaction(bytes.NewReader(body))
+		if signerListSet, ok := tx.(*data.SignerListSet); err == nil && ok {
+			signerListSet.SignerEntries = entries
+		} else {
+			tx = nil
+		}
+	}
+	if tx == nil {
+		tx, err = data.ReadTransaction(bytes.NewReader(txData))
+		if err != nil {
+			return nil, fmt.Errorf("parse raw tx error: %v", err)
+		}
+	}
+	tx.GetBase().InitialiseForMultiSigning()
+	return tx, nil
+}
+
+func checkMultiSign(tx data.Transaction, signer data.Account, pk, signature []byte) error {
+	var ok bool
+	var err error
+	if signerListSet, isSignerListSet := tx.(*data.SignerListSet); isSignerListSet {
+		body := *signerListSet
+		body.SignerEntries = nil
+		var msg []byte
+		if _, msg, err = data.MultiSignHash(&body, signer); err == nil {
+			// insert the signer entries before the signer account
+			msg = append(append(msg[:len(msg)-len(signer):len(msg)-len(signer)], encodeSignerEntries(signerListSet.SignerEntries)...), signer[:]...)
+			hash := sha512.Sum512(append(data.HP_MULTI_SIGN.Bytes(), msg...))
+			ok, err = crypto.Verify(pk, hash[:32], msg, signature)
+		}
+	} else {
+		ok, err = data.CheckMultiSignature(tx, signer, pk, signature)
+	}
+	if err != nil {
+		return fmt.Errorf("data.CheckMultiSignature error: %v", err)
+	}
+	if !ok {
+		return fmt.Errorf("data.CheckMultiSignature failed")
+	}
+	return nil
+}
+
 func ToStringByPrecise(bigNum *big.Int, precise uint64) string {
 	if bigNum.Sign() != -1 {
 		return toStringByPrecise(bigNum, precise)
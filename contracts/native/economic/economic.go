@@ -27,7 +27,6 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/params"
 )
 
 var (
@@ -57,11 +56,9 @@ func Name(s *native.NativeContract) ([]byte, error) {
 
 func TotalSupply(s *native.NativeContract) ([]byte, error) {
 	height := s.ContractRef().BlockHeight()
-
-	supply := params.GenesisSupply
-	if height.Uint64() > 0 {
-		reward := new(big.Int).Mul(height, params.RewardPerBlock)
-		supply = new(big.Int).Add(supply, reward)
+	supply, err := totalSupplyAt(s.GetCacheDB(), height)
+	if err != nil {
+		return nil, fmt.Errorf("TotalSupply, totalSupplyAt error: %v", err)
 	}
 	return utils.PackOutputs(ABI, MethodTotalSupply, supply)
 }
@@ -72,9 +69,14 @@ func getBlockRewardList(s *native.NativeContract) ([]*RewardAmount, error) {
 		return nil, fmt.Errorf("GetCommunityInfo failed, err: %v", err)
 	}
 
+	inflation, err := GetInflationImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetInflation failed, err: %v", err)
+	}
+
 	// allow empty address as reward pool
 	poolAddr := community.CommunityAddress
-	rewardPerBlock := utils.NewDecFromBigInt(params.RewardPerBlock)
+	rewardPerBlock := utils.NewDecFromBigInt(inflation.RewardPerBlock)
 	rewardFactor := utils.NewDecFromBigInt(community.CommunityRate)
 	poolRwdAmt, err := rewardPerBlock.MulWithPercentDecimal(rewardFactor)
 	if err != nil {
@@ -128,5 +130,71 @@ func GenerateBlockReward(s *native.NativeContract) error {
 	}
 	log.Debug("reward", "num", height, "list", sRwd)
 
+	// decay the block reward at the end of each interval
+	config, err := GetEconomicConfigImpl(s)
+	if err != nil {
+		return fmt.Errorf("GenerateBlockReward, GetEconomicConfigImpl error: %v", err)
+	}
+	if config.DecayInterval.Sign() > 0 && new(big.Int).Mod(height, config.DecayInterval).Sign() == 0 {
+		inflation, err := GetInflationImpl(s)
+		if err != nil {
+			return fmt.Errorf("GenerateBlockReward, GetInflationImpl error: %v", err)
+		}
+		reward := new(big.Int).Sub(utils.PercentDecimal, config.DecayRate)
+		reward.Mul(reward, inflation.RewardPerBlock).Div(reward, utils.PercentDecimal)
+		if err := rollInflation(s.GetCacheDB(), height, reward); err != nil {
+			return fmt.Errorf("GenerateBlockReward, rollInflation error: %v", err)
+		}
+		log.Debug("decay block reward", "num", height, "reward", reward)
+	}
+	return nil
+}
+
+// AdjustInflation moves the block reward one step towards the target staking rate, it
+// is called at the end of each epoch with the total stake of node manager.
+func AdjustInflation(s *native.NativeContract, totalStake *big.Int) error {
+	config, err := GetEconomicConfigImpl(s)
+	if err != nil {
+		return fmt.Errorf("AdjustInflation, GetEconomicConfigImpl error: %v", err)
+	}
+	if config.TargetStakingRate.Sign() == 0 {
+		return nil
+	}
+	inflation, err := GetInflationImpl(s)
+	if err != nil {
+		return fmt.Errorf("AdjustInflation, GetInflationImpl error: %v", err)
+	}
+
+	height := s.ContractRef().BlockHeight()
+	supply, err := totalSupplyAt(s.GetCacheDB(), height)
+	if err != nil {
+		return fmt.Errorf("AdjustInflation, totalSupplyAt error: %v", err)
+	}
+	stakingRate := new(big.Int).Mul(totalStake, utils.PercentDecimal)
+	stakingRate.Div(stakingRate, supply)
+
+	// inflation rises if too few tokens are staked and falls if too many
+	reward := new(big.Int).Set(inflation.RewardPerBlock)
+	delta := new(big.Int).Mul(reward, config.AdjustRate)
+	delta.Div(delta, utils.PercentDecimal)
+	switch stakingRate.Cmp(config.TargetStakingRate) {
+	case -1:
+		reward.Add(reward, delta)
+	case 1:
+		reward.Sub(reward, delta)
+	}
+	if config.MaxRewardPerBlock.Sign() > 0 && reward.Cmp(config.MaxRewardPerBlock) > 0 {
+		reward.Set(config.MaxRewardPerBlock)
+	}
+	if reward.Cmp(config.MinRewardPerBlock) < 0 {
+		reward.Set(config.MinRewardPerBlock)
+	}
+	if reward.Cmp(inflation.RewardPerBlock) == 0 {
+		return nil
+	}
+	if err := rollInflation(s.GetCacheDB(), height, reward); err != nil {
+		return fmt.Errorf("AdjustInflation, rollInflation error: %v", err)
+	}
+	log.Debug("adjust block reward", "num", height, "staking rate", stakingRate, "reward", reward)
 	return nil
 }
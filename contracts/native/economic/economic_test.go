@@ -94,6 +94,130 @@ func TestTotalSupply(t *testing.T) {
 	}
 }
 
+func TestBurnedSupply(t *testing.T) {
+	burned := new(big.Int).Mul(big.NewInt(15), params.ZNT1)
+
+	payload, _ := new(MethodTotalSupplyInput).Encode()
+	raw, err := native.TestNativeCall(t, this, MethodTotalSupply, payload, common.Big0, 40, func(db *state.StateDB) {
+		assert.NoError(t, AddBurnedSupply((*state.CacheDB)(db), new(big.Int).Sub(burned, params.ZNT1)))
+		assert.NoError(t, AddBurnedSupply((*state.CacheDB)(db), params.ZNT1))
+	}, gasTable[MethodTotalSupply])
+	assert.NoError(t, err)
+
+	var supply *big.Int
+	assert.NoError(t, utils.UnpackOutputs(ABI, MethodTotalSupply, &supply, raw))
+	assert.Equal(t, big.NewInt(100000025), new(big.Int).Div(supply, params.ZNT1))
+}
+
+func TestConfiguredTotalSupply(t *testing.T) {
+	genesisSupply := new(big.Int).Mul(big.NewInt(1000), params.ZNT1)
+	rewardPerBlock := new(big.Int).Mul(big.NewInt(2), params.ZNT1)
+
+	payload, _ := new(MethodTotalSupplyInput).Encode()
+	raw, err := native.TestNativeCall(t, this, MethodTotalSupply, payload, common.Big0, 10, func(state *state.StateDB) {
+		_, err := StoreGenesisEconomicConfig(state, &EconomicConfig{GenesisSupply: genesisSupply, RewardPerBlock: rewardPerBlock})
+		assert.NoError(t, err)
+	}, gasTable[MethodTotalSupply])
+	assert.NoError(t, err)
+
+	var supply *big.Int
+	assert.NoError(t, utils.UnpackOutputs(ABI, MethodTotalSupply, &supply, raw))
+	assert.Equal(t, big.NewInt(1020), new(big.Int).Div(supply, params.ZNT1))
+
+	// only the default parameter is taken for nil one
+	db := native.NewTestStateDB()
+	config, err := StoreGenesisEconomicConfig(db, &EconomicConfig{RewardPerBlock: rewardPerBlock})
+	assert.NoError(t, err)
+	assert.Equal(t, params.GenesisSupply, config.GenesisSupply)
+	assert.Equal(t, common.Big0, config.DecayInterval)
+	_, err = StoreGenesisEconomicConfig(db, &EconomicConfig{RewardPerBlock: big.NewInt(-1)})
+	assert.Error(t, err)
+	_, err = StoreGenesisEconomicConfig(db, &EconomicConfig{DecayRate: big.NewInt(10001)})
+	assert.Error(t, err)
+	_, err = StoreGenesisEconomicConfig(db, &EconomicConfig{MinRewardPerBlock: big.NewInt(2), MaxRewardPerBlock: big.NewInt(1)})
+	assert.Error(t, err)
+}
+
+func TestInflationSchedule(t *testing.T) {
+	db := native.NewTestStateDB()
+	pool := common.HexToAddress("0x123")
+	community.StoreCommunityInfo(db, big.NewInt(2000), pool)
+	_, err := StoreGenesisEconomicConfig(db, &EconomicConfig{
+		GenesisSupply:     big.NewInt(1000000),
+		RewardPerBlock:    big.NewInt(1000),
+		DecayInterval:     big.NewInt(10),
+		DecayRate:         big.NewInt(5000),
+		TargetStakingRate: big.NewInt(5000),
+		AdjustRate:        big.NewInt(1000),
+		MinRewardPerBlock: big.NewInt(100),
+		MaxRewardPerBlock: big.NewInt(2000),
+	})
+	assert.NoError(t, err)
+
+	supplyAt := func(height int) *big.Int {
+		payload, _ := new(MethodTotalSupplyInput).Encode()
+		raw, err := native.TestNativeCall(t, this, MethodTotalSupply, payload, common.Big0, height, db, gasTable[MethodTotalSupply])
+		assert.NoError(t, err)
+		var supply *big.Int
+		assert.NoError(t, utils.UnpackOutputs(ABI, MethodTotalSupply, &supply, raw))
+		return supply
+	}
+	rewardAt := func(height int) *big.Int {
+		_, s := native.GenerateTestContext(t, common.Big0, this, height, db)
+		inflation, err := GetInflationImpl(s)
+		assert.NoError(t, err)
+		return inflation.RewardPerBlock
+	}
+
+	// total supply always equals to the genesis supply plus minted rewards
+	minted := new(big.Int)
+	history := map[int]*big.Int{0: big.NewInt(1000000)}
+	for height := 1; height <= 30; height++ {
+		before := new(big.Int).Add(db.GetBalance(pool), db.GetBalance(utils.NodeManagerContractAddress))
+		_, s := native.GenerateTestContext(t, common.Big0, this, height, db)
+		assert.NoError(t, GenerateBlockReward(s))
+		after := new(big.Int).Add(db.GetBalance(pool), db.GetBalance(utils.NodeManagerContractAddress))
+		minted.Add(minted, new(big.Int).Sub(after, before))
+
+		switch height {
+		case 15:
+			// staking rate is lower than target, the reward rises by 10 percent
+			assert.NoError(t, AdjustInflation(s, big.NewInt(1000)))
+			assert.Equal(t, big.NewInt(550), rewardAt(height))
+		case 25:
+			// staking rate is higher than target, the reward falls by 10 percent
+			assert.NoError(t, AdjustInflation(s, big.NewInt(1000000)))
+			assert.Equal(t, big.NewInt(248), rewardAt(height))
+		}
+		assert.Equal(t, new(big.Int).Add(big.NewInt(1000000), minted), supplyAt(height))
+		history[height] = supplyAt(height)
+	}
+	// the decay at height 30 halves the reward again
+	assert.Equal(t, big.NewInt(124), rewardAt(30))
+
+	// governance changes the reward from the current block
+	_, s := native.GenerateTestContext(t, common.Big0, this, 31, db)
+	config, err := GetEconomicConfigImpl(s)
+	assert.NoError(t, err)
+	config.RewardPerBlock = big.NewInt(800)
+	config.DecayInterval = common.Big0
+	assert.NoError(t, SetEconomicConfig(s, config))
+	assert.NoError(t, GenerateBlockReward(s))
+	assert.Equal(t, new(big.Int).Add(big.NewInt(1000800), minted), supplyAt(31))
+	assert.Equal(t, new(big.Int).Add(big.NewInt(1008800), minted), supplyAt(41))
+
+	// the supply of former heights is evaluated by the segments in history
+	for height, supply := range history {
+		got, err := totalSupplyAt((*state.CacheDB)(db), big.NewInt(int64(height)))
+		assert.NoError(t, err)
+		assert.Equal(t, supply, got, "height %d", height)
+	}
+
+	config.GenesisSupply = big.NewInt(1)
+	config.AdjustRate = big.NewInt(-1)
+	assert.Error(t, SetEconomicConfig(s, config))
+}
+
 func TestReward(t *testing.T) {
 	xe17 := func(n int) *big.Int {
 		return new(big.Int).SetUint64(uint64(1e17) * uint64(n))
@@ -0,0 +1,408 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package economic
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_ECONOMIC_CONFIG = "st_economic_config"
+	SKP_INFLATION       = "st_inflation"
+	SKP_INFLATION_LIST  = "st_inflation_list"
+	SKP_INFLATION_SIZE  = "st_inflation_size"
+	SKP_BURNED          = "st_burned"
+)
+
+// EconomicConfig is the token economic parameters configured in genesis and updated
+// by governance. The rates of inflation schedule are in percent decimal, and the zero
+// values disable the related adjustment.
+type EconomicConfig struct {
+	GenesisSupply  *big.Int
+	RewardPerBlock *big.Int
+
+	DecayInterval     *big.Int `rlp:"optional"` // blocks between two reward decays
+	DecayRate         *big.Int `rlp:"optional"` // reward reduction of each decay, 5000 halves it
+	TargetStakingRate *big.Int `rlp:"optional"` // target ratio of total stake to total supply
+	AdjustRate        *big.Int `rlp:"optional"` // reward change of each epoch to approach the target
+	MinRewardPerBlock *big.Int `rlp:"optional"`
+	MaxRewardPerBlock *big.Int `rlp:"optional"` // zero means no upper bound
+}
+
+// Inflation is the segment of supply curve with a constant block reward, the supply
+// at StartHeight includes the reward of that block.
+type Inflation struct {
+	StartHeight    *big.Int
+	StartSupply    *big.Int
+	RewardPerBlock *big.Int
+}
+
+// SupplyAt returns the total supply after the block of height is rewarded, the height
+// should be inside of the segment, see supplyAt for the heights of former segments.
+func (m *Inflation) SupplyAt(height *big.Int) *big.Int {
+	if height.Cmp(m.StartHeight) <= 0 {
+		return new(big.Int).Set(m.StartSupply)
+	}
+	blocks := new(big.Int).Sub(height, m.StartHeight)
+	return new(big.Int).Add(m.StartSupply, new(big.Int).Mul(blocks, m.RewardPerBlock))
+}
+
+// DefaultEconomicConfig returns the parameters used if the chain is not configured
+// in genesis.
+func DefaultEconomicConfig() *EconomicConfig {
+	config := &EconomicConfig{
+		GenesisSupply:  new(big.Int).Set(params.GenesisSupply),
+		RewardPerBlock: new(big.Int).Set(params.RewardPerBlock),
+	}
+	config.fillSchedule()
+	return config
+}
+
+// fillSchedule sets the nil schedule parameters to zero, which is the case of config
+// stored before the inflation schedule introduced.
+func (m *EconomicConfig) fillSchedule() {
+	for _, v := range []**big.Int{&m.DecayInterval, &m.DecayRate, &m.TargetStakingRate, &m.AdjustRate,
+		&m.MinRewardPerBlock, &m.MaxRewardPerBlock} {
+		if *v == nil {
+			*v = new(big.Int)
+		}
+	}
+}
+
+func (m *EconomicConfig) validate() error {
+	if m.GenesisSupply.Sign() <= 0 {
+		return fmt.Errorf("genesis supply must be positive")
+	}
+	if m.RewardPerBlock.Sign() < 0 {
+		return fmt.Errorf("reward per block can not be negative")
+	}
+	if m.DecayInterval.Sign() < 0 {
+		return fmt.Errorf("decay interval can not be negative")
+	}
+	if !validRate(m.DecayRate) || !validRate(m.TargetStakingRate) || !validRate(m.AdjustRate) {
+		return fmt.Errorf("decay rate, target staking rate and adjust rate must be between 0 and 100 percent")
+	}
+	if m.MinRewardPerBlock.Sign() < 0 || m.MaxRewardPerBlock.Sign() < 0 {
+		return fmt.Errorf("reward bound can not be negative")
+	}
+	if m.MaxRewardPerBlock.Sign() > 0 && m.MinRewardPerBlock.Cmp(m.MaxRewardPerBlock) > 0 {
+		return fmt.Errorf("min reward per block is more than max reward per block")
+	}
+	return nil
+}
+
+func validRate(rate *big.Int) bool {
+	return rate.Sign() >= 0 && rate.Cmp(utils.PercentDecimal) <= 0
+}
+
+// StoreGenesisEconomicConfig stores the economic parameters in genesis block, the
+// nil parameters take the default values.
+func StoreGenesisEconomicConfig(s *state.StateDB, genesis *EconomicConfig) (*EconomicConfig, error) {
+	config := DefaultEconomicConfig()
+	if genesis != nil {
+		for dst, src := range map[**big.Int]*big.Int{
+			&config.GenesisSupply:     genesis.GenesisSupply,
+			&config.RewardPerBlock:    genesis.RewardPerBlock,
+			&config.DecayInterval:     genesis.DecayInterval,
+			&config.DecayRate:         genesis.DecayRate,
+			&config.TargetStakingRate: genesis.TargetStakingRate,
+			&config.AdjustRate:        genesis.AdjustRate,
+			&config.MinRewardPerBlock: genesis.MinRewardPerBlock,
+			&config.MaxRewardPerBlock: genesis.MaxRewardPerBlock,
+		} {
+			if src != nil {
+				*dst = src
+			}
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("StoreGenesisEconomicConfig, %v", err)
+	}
+	if err := setEconomicConfig((*state.CacheDB)(s), config); err != nil {
+		return nil, fmt.Errorf("StoreGenesisEconomicConfig, %v", err)
+	}
+	return config, nil
+}
+
+// CheckEconomicConfig validates the economic parameters proposed to governance, the
+// genesis supply is not checked since it can not be changed.
+func CheckEconomicConfig(config *EconomicConfig) error {
+	if config.RewardPerBlock == nil {
+		return fmt.Errorf("reward per block is nil")
+	}
+	config.fillSchedule()
+	check := *config
+	check.GenesisSupply = common.Big1
+	return check.validate()
+}
+
+// SetEconomicConfig updates the economic parameters except the genesis supply. The block
+// reward restarts from the new RewardPerBlock only if it differs from the current config,
+// so that the schedule can be tuned without resetting the decayed reward.
+func SetEconomicConfig(s *native.NativeContract, config *EconomicConfig) error {
+	db := s.GetCacheDB()
+	current, err := getEconomicConfig(db)
+	if err != nil {
+		return fmt.Errorf("SetEconomicConfig, getEconomicConfig error: %v", err)
+	}
+	config.GenesisSupply = current.GenesisSupply
+	if err := CheckEconomicConfig(config); err != nil {
+		return fmt.Errorf("SetEconomicConfig, %v", err)
+	}
+
+	if config.RewardPerBlock.Cmp(current.RewardPerBlock) != 0 {
+		// the new reward takes effect from the current block which is not rewarded yet
+		height := new(big.Int).Sub(s.ContractRef().BlockHeight(), common.Big1)
+		if err := rollInflation(db, height, config.RewardPerBlock); err != nil {
+			return fmt.Errorf("SetEconomicConfig, rollInflation error: %v", err)
+		}
+	}
+	if err := setEconomicConfig(db, config); err != nil {
+		return fmt.Errorf("SetEconomicConfig, %v", err)
+	}
+	return nil
+}
+
+// GetEconomicConfigImpl returns the economic parameters, the default one is returned
+// for the chain initialised without them.
+func GetEconomicConfigImpl(s *native.NativeContract) (*EconomicConfig, error) {
+	return getEconomicConfig(s.GetCacheDB())
+}
+
+func GetEconomicConfigFromDB(s *state.StateDB) (*EconomicConfig, error) {
+	return getEconomicConfig((*state.CacheDB)(s))
+}
+
+func setEconomicConfig(db *state.CacheDB, config *EconomicConfig) error {
+	store, err := rlp.EncodeToBytes(config)
+	if err != nil {
+		return fmt.Errorf("serialize economic config error: %v", err)
+	}
+	db.Put(economicConfigKey(), store)
+	return nil
+}
+
+func getEconomicConfig(db *state.CacheDB) (*EconomicConfig, error) {
+	store, err := db.Get(economicConfigKey())
+	if err != nil {
+		return nil, fmt.Errorf("getEconomicConfig, get store error: %v", err)
+	}
+	if len(store) == 0 {
+		return DefaultEconomicConfig(), nil
+	}
+	config := new(EconomicConfig)
+	if err := rlp.DecodeBytes(store, config); err != nil {
+		return nil, fmt.Errorf("getEconomicConfig, deserialize economic config error: %v", err)
+	}
+	config.fillSchedule()
+	return config, nil
+}
+
+// GetInflationImpl returns the current segment of supply curve, which starts from genesis
+// block with configured RewardPerBlock until the reward changed.
+func GetInflationImpl(s *native.NativeContract) (*Inflation, error) {
+	return getInflation(s.GetCacheDB())
+}
+
+func getInflation(db *state.CacheDB) (*Inflation, error) {
+	store, err := db.Get(inflationKey())
+	if err != nil {
+		return nil, fmt.Errorf("getInflation, get store error: %v", err)
+	}
+	if len(store) == 0 {
+		config, err := getEconomicConfig(db)
+		if err != nil {
+			return nil, fmt.Errorf("getInflation, getEconomicConfig error: %v", err)
+		}
+		return &Inflation{
+			StartHeight:    new(big.Int),
+			StartSupply:    config.GenesisSupply,
+			RewardPerBlock: config.RewardPerBlock,
+		}, nil
+	}
+	inflation := new(Inflation)
+	if err := rlp.DecodeBytes(store, inflation); err != nil {
+		return nil, fmt.Errorf("getInflation, deserialize inflation error: %v", err)
+	}
+	return inflation, nil
+}
+
+func setInflation(db *state.CacheDB, inflation *Inflation) error {
+	store, err := rlp.EncodeToBytes(inflation)
+	if err != nil {
+		return fmt.Errorf("setInflation, serialize inflation error: %v", err)
+	}
+	db.Put(inflationKey(), store)
+	return nil
+}
+
+// rollInflation starts a new segment of supply curve after the block of height, the
+// current segment is kept in the history unless it's replaced at its start height.
+func rollInflation(db *state.CacheDB, height, reward *big.Int) error {
+	inflation, err := getInflation(db)
+	if err != nil {
+		return err
+	}
+	if height.Cmp(inflation.StartHeight) <= 0 {
+		height = inflation.StartHeight
+	} else if err := appendInflationHistory(db, inflation); err != nil {
+		return err
+	}
+	return setInflation(db, &Inflation{
+		StartHeight:    new(big.Int).Set(height),
+		StartSupply:    inflation.SupplyAt(height),
+		RewardPerBlock: reward,
+	})
+}
+
+func appendInflationHistory(db *state.CacheDB, inflation *Inflation) error {
+	size, err := getInflationHistorySize(db)
+	if err != nil {
+		return err
+	}
+	store, err := rlp.EncodeToBytes(inflation)
+	if err != nil {
+		return fmt.Errorf("appendInflationHistory, serialize inflation error: %v", err)
+	}
+	db.Put(inflationListKey(size), store)
+	db.Put(inflationSizeKey(), utils.GetUint64Bytes(size+1))
+	return nil
+}
+
+func getInflationHistorySize(db *state.CacheDB) (uint64, error) {
+	store, err := db.Get(inflationSizeKey())
+	if err != nil {
+		return 0, fmt.Errorf("getInflationHistorySize, get store error: %v", err)
+	}
+	return utils.GetBytesUint64(store), nil
+}
+
+func getInflationHistory(db *state.CacheDB, index uint64) (*Inflation, error) {
+	store, err := db.Get(inflationListKey(index))
+	if err != nil {
+		return nil, fmt.Errorf("getInflationHistory, get store error: %v", err)
+	}
+	inflation := new(Inflation)
+	if err := rlp.DecodeBytes(store, inflation); err != nil {
+		return nil, fmt.Errorf("getInflationHistory, deserialize inflation error: %v", err)
+	}
+	return inflation, nil
+}
+
+// supplyAt returns the minted supply after the block of height is rewarded, which is
+// evaluated by the segment of supply curve containing the height.
+func supplyAt(db *state.CacheDB, height *big.Int) (*big.Int, error) {
+	inflation, err := getInflation(db)
+	if err != nil {
+		return nil, err
+	}
+	if height.Cmp(inflation.StartHeight) >= 0 {
+		return inflation.SupplyAt(height), nil
+	}
+
+	// the segments in history are sorted by start height, find the last one started
+	// before the height.
+	size, err := getInflationHistorySize(db)
+	if err != nil {
+		return nil, err
+	}
+	lo, hi := uint64(0), size
+	for lo < hi {
+		mid := (lo + hi) / 2
+		segment, err := getInflationHistory(db, mid)
+		if err != nil {
+			return nil, err
+		}
+		if segment.StartHeight.Cmp(height) <= 0 {
+			inflation, lo = segment, mid+1
+		} else {
+			hi = mid
+		}
+	}
+	return inflation.SupplyAt(height), nil
+}
+
+// AddBurnedSupply accumulates the tokens burned from transaction fees, which are
+// deducted from the total supply.
+func AddBurnedSupply(db *state.CacheDB, amount *big.Int) error {
+	if amount.Sign() <= 0 {
+		return nil
+	}
+	burned, err := getBurnedSupply(db)
+	if err != nil {
+		return fmt.Errorf("AddBurnedSupply, %v", err)
+	}
+	db.Put(burnedKey(), new(big.Int).Add(burned, amount).Bytes())
+	return nil
+}
+
+func GetBurnedSupplyFromDB(s *state.StateDB) (*big.Int, error) {
+	return getBurnedSupply((*state.CacheDB)(s))
+}
+
+func getBurnedSupply(db *state.CacheDB) (*big.Int, error) {
+	store, err := db.Get(burnedKey())
+	if err != nil {
+		return nil, fmt.Errorf("getBurnedSupply, get store error: %v", err)
+	}
+	return new(big.Int).SetBytes(store), nil
+}
+
+// totalSupplyAt returns the tokens minted until the block of height without the
+// burned ones.
+func totalSupplyAt(db *state.CacheDB, height *big.Int) (*big.Int, error) {
+	supply, err := supplyAt(db, height)
+	if err != nil {
+		return nil, err
+	}
+	burned, err := getBurnedSupply(db)
+	if err != nil {
+		return nil, err
+	}
+	return supply.Sub(supply, burned), nil
+}
+
+func economicConfigKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_ECONOMIC_CONFIG))
+}
+
+func inflationKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_INFLATION))
+}
+
+func burnedKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_BURNED))
+}
+
+func inflationListKey(index uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_INFLATION_LIST), utils.GetUint64Bytes(index))
+}
+
+func inflationSizeKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_INFLATION_SIZE))
+}
@@ -31,40 +31,113 @@ var (
 
 	MethodWhiteChain = "WhiteChain"
 
+	MethodBumpRippleFee = "bumpRippleFee"
+
+	MethodCancelTransfer = "cancelTransfer"
+
+	MethodDistributeFee = "distributeFee"
+
 	MethodImportOuterTransfer = "importOuterTransfer"
 
+	MethodMultiSignBtc = "multiSignBtc"
+
 	MethodMultiSignRipple = "multiSignRipple"
 
 	MethodReconstructRippleTx = "reconstructRippleTx"
 
+	MethodReleaseTransfer = "releaseTransfer"
+
 	MethodReplenish = "replenish"
 
+	MethodRotateRippleSigners = "rotateRippleSigners"
+
+	MethodSetFeeConfig = "setFeeConfig"
+
+	MethodSetRateLimit = "setRateLimit"
+
+	MethodSetTransferDelay = "setTransferDelay"
+
+	MethodSyncBtcHeaders = "syncBtcHeaders"
+
 	MethodCheckDone = "checkDone"
 
+	MethodGetBtcBestHeader = "getBtcBestHeader"
+
+	MethodGetBtcUtxos = "getBtcUtxos"
+
+	MethodGetFeeConfig = "getFeeConfig"
+
+	MethodGetFeePool = "getFeePool"
+
+	MethodGetPendingTransfer = "getPendingTransfer"
+
+	MethodGetPendingTransfers = "getPendingTransfers"
+
+	MethodGetRateLimit = "getRateLimit"
+
+	MethodGetRippleTxRecord = "getRippleTxRecord"
+
+	MethodGetTransferDelay = "getTransferDelay"
+
 	MethodName = "name"
 
+	EventBtcMultiSign = "BtcMultiSign"
+
+	EventBtcTx = "BtcTx"
+
+	EventCircuitBreak = "CircuitBreak"
+
+	EventDistributeFee = "DistributeFee"
+
 	EventMultiSign = "MultiSign"
 
 	EventReplenishEvent = "ReplenishEvent"
 
+	EventRippleSignerListSet = "RippleSignerListSet"
+
 	EventRippleTx = "RippleTx"
 
+	EventTransferCancelled = "TransferCancelled"
+
+	EventTransferQueued = "TransferQueued"
+
+	EventTransferReleased = "TransferReleased"
+
 	EventMakeProof = "makeProof"
 )
 
 // ICrossChainManagerABI is the input ABI used to generate the binding from.
-const ICrossChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"payment\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"MultiSign\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"txHashes\",\"type\":\"string[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"ReplenishEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txJson\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"RippleTx\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"merkleValueHex\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlockHeight\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"}],\"name\":\"makeProof\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"BlackChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"WhiteChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"checkDone\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"Height\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Extra\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"}],\"name\":\"importOuterTransfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"AssetAddress\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"TxJson\",\"type\":\"string\"}],\"name\":\"multiSignRipple\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"}],\"name\":\"reconstructRippleTx\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"string[]\",\"name\":\"txHashes\",\"type\":\"string[]\"}],\"name\":\"replenish\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const ICrossChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"signedTx\",\"type\":\"string\"}],\"name\":\"BtcMultiSign\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rawTx\",\"type\":\"string\"}],\"name\":\"BtcTx\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"usage\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"}],\"name\":\"CircuitBreak\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"voterFee\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"relayerFee\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"communityFee\",\"type\":\"uint256\"}],\"name\":\"DistributeFee\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"payment\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"MultiSign\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"txHashes\",\"type\":\"string[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"ReplenishEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txJson\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"RippleSignerListSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txJson\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"RippleTx\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"TransferCancelled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"releaseHeight\",\"type\":\"uint64\"}],\"name\":\"TransferQueued\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"TransferReleased\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"merkleValueHex\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlockHeight\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"}],\"name\":\"makeProof\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"BlackChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"WhiteChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"}],\"name\":\"bumpRippleFee\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"cancelTransfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"checkDone\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"distributeFee\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getBtcBestHeader\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getBtcUtxos\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getFeeConfig\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"getFeePool\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"getPendingTransfer\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPendingTransfers\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"}],\"name\":\"getRateLimit\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"getRippleTxRecord\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"}],\"name\":\"getTransferDelay\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"Height\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Extra\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"}],\"name\":\"importOuterTransfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"txHash\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"pubKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes[]\",\"name\":\"sigs\",\"type\":\"bytes[]\"}],\"name\":\"multiSignBtc\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"AssetAddress\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"TxJson\",\"type\":\"string\"}],\"name\":\"multiSignRipple\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"}],\"name\":\"reconstructRippleTx\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"releaseTransfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"string[]\",\"name\":\"txHashes\",\"type\":\"string[]\"}],\"name\":\"replenish\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes[]\",\"name\":\"pks\",\"type\":\"bytes[]\"},{\"internalType\":\"uint64\",\"name\":\"quorum\",\"type\":\"uint64\"}],\"name\":\"rotateRippleSigners\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"voterRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"relayerRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"communityRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"period\",\"type\":\"uint64\"}],\"name\":\"setFeeConfig\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"window\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"pauseThreshold\",\"type\":\"uint256\"}],\"name\":\"setRateLimit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"delay\",\"type\":\"uint64\"}],\"name\":\"setTransferDelay\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes[]\",\"name\":\"headers\",\"type\":\"bytes[]\"}],\"name\":\"syncBtcHeaders\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // ICrossChainManagerFuncSigs maps the 4-byte function signature to its string representation.
 var ICrossChainManagerFuncSigs = map[string]string{
 	"8a449f03": "BlackChain(uint64)",
 	"99d0e87a": "WhiteChain(uint64)",
+	"70f56797": "bumpRippleFee(uint64,bytes,uint64)",
+	"75438846": "cancelTransfer(uint64,bytes)",
 	"1245f8d5": "checkDone(uint64,bytes)",
+	"26c4e60d": "distributeFee()",
+	"5db569df": "getBtcBestHeader(uint64)",
+	"27525534": "getBtcUtxos(uint64)",
+	"5fbbc0d2": "getFeeConfig()",
+	"8d66a9a3": "getFeePool(address)",
+	"d0a3572b": "getPendingTransfer(uint64,bytes)",
+	"448878fc": "getPendingTransfers()",
+	"7b64ec01": "getRateLimit(uint64,bytes)",
+	"0173b7fb": "getRippleTxRecord(uint64,uint32)",
+	"2273508a": "getTransferDelay(uint64,bytes)",
 	"bbc2a76a": "importOuterTransfer(uint64,uint32,bytes,bytes,bytes)",
+	"3cf4bdd0": "multiSignBtc(uint64,bytes,bytes,bytes[])",
 	"b7ef3989": "multiSignRipple(uint64,bytes,uint64,bytes,string)",
 	"06fdde03": "name()",
 	"3b178819": "reconstructRippleTx(uint64,bytes,uint64)",
+	"2c69ec3e": "releaseTransfer(uint64,bytes)",
 	"f8bac498": "replenish(uint64,string[])",
+	"34227dc7": "rotateRippleSigners(uint64,bytes[],uint64)",
+	"4391b81b": "setFeeConfig(uint64,uint64,uint64,uint64)",
+	"14d91d17": "setRateLimit(uint64,bytes,uint64,uint256,uint256)",
+	"1c0ece4e": "setTransferDelay(uint64,bytes,uint256,uint64)",
+	"86cdf410": "syncBtcHeaders(uint64,bytes[])",
 }
 
 // ICrossChainManager is an auto generated Go binding around an Ethereum contract.
@@ -240,6 +313,285 @@ func (_ICrossChainManager *ICrossChainManagerCallerSession) CheckDone(chainID ui
 	return _ICrossChainManager.Contract.CheckDone(&_ICrossChainManager.CallOpts, chainID, crossChainID)
 }
 
+// GetBtcBestHeader is a free data retrieval call binding the contract method 0x5db569df.
+//
+// Solidity: function getBtcBestHeader(uint64 chainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetBtcBestHeader(opts *bind.CallOpts, chainID uint64) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getBtcBestHeader", chainID)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetBtcBestHeader is a free data retrieval call binding the contract method 0x5db569df.
+//
+// Solidity: function getBtcBestHeader(uint64 chainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetBtcBestHeader(chainID uint64) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetBtcBestHeader(&_ICrossChainManager.CallOpts, chainID)
+}
+
+// GetBtcBestHeader is a free data retrieval call binding the contract method 0x5db569df.
+//
+// Solidity: function getBtcBestHeader(uint64 chainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetBtcBestHeader(chainID uint64) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetBtcBestHeader(&_ICrossChainManager.CallOpts, chainID)
+}
+
+// GetBtcUtxos is a free data retrieval call binding the contract method 0x27525534.
+//
+// Solidity: function getBtcUtxos(uint64 chainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetBtcUtxos(opts *bind.CallOpts, chainID uint64) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getBtcUtxos", chainID)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetBtcUtxos is a free data retrieval call binding the contract method 0x27525534.
+//
+// Solidity: function getBtcUtxos(uint64 chainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetBtcUtxos(chainID uint64) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetBtcUtxos(&_ICrossChainManager.CallOpts, chainID)
+}
+
+// GetBtcUtxos is a free data retrieval call binding the contract method 0x27525534.
+//
+// Solidity: function getBtcUtxos(uint64 chainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetBtcUtxos(chainID uint64) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetBtcUtxos(&_ICrossChainManager.CallOpts, chainID)
+}
+
+// GetFeeConfig is a free data retrieval call binding the contract method 0x5fbbc0d2.
+//
+// Solidity: function getFeeConfig() view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetFeeConfig(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getFeeConfig")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetFeeConfig is a free data retrieval call binding the contract method 0x5fbbc0d2.
+//
+// Solidity: function getFeeConfig() view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetFeeConfig() ([]byte, error) {
+	return _ICrossChainManager.Contract.GetFeeConfig(&_ICrossChainManager.CallOpts)
+}
+
+// GetFeeConfig is a free data retrieval call binding the contract method 0x5fbbc0d2.
+//
+// Solidity: function getFeeConfig() view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetFeeConfig() ([]byte, error) {
+	return _ICrossChainManager.Contract.GetFeeConfig(&_ICrossChainManager.CallOpts)
+}
+
+// GetFeePool is a free data retrieval call binding the contract method 0x8d66a9a3.
+//
+// Solidity: function getFeePool(address token) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetFeePool(opts *bind.CallOpts, token common.Address) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getFeePool", token)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetFeePool is a free data retrieval call binding the contract method 0x8d66a9a3.
+//
+// Solidity: function getFeePool(address token) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetFeePool(token common.Address) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetFeePool(&_ICrossChainManager.CallOpts, token)
+}
+
+// GetFeePool is a free data retrieval call binding the contract method 0x8d66a9a3.
+//
+// Solidity: function getFeePool(address token) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetFeePool(token common.Address) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetFeePool(&_ICrossChainManager.CallOpts, token)
+}
+
+// GetPendingTransfer is a free data retrieval call binding the contract method 0xd0a3572b.
+//
+// Solidity: function getPendingTransfer(uint64 chainID, bytes crossChainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetPendingTransfer(opts *bind.CallOpts, chainID uint64, crossChainID []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getPendingTransfer", chainID, crossChainID)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetPendingTransfer is a free data retrieval call binding the contract method 0xd0a3572b.
+//
+// Solidity: function getPendingTransfer(uint64 chainID, bytes crossChainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetPendingTransfer(chainID uint64, crossChainID []byte) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetPendingTransfer(&_ICrossChainManager.CallOpts, chainID, crossChainID)
+}
+
+// GetPendingTransfer is a free data retrieval call binding the contract method 0xd0a3572b.
+//
+// Solidity: function getPendingTransfer(uint64 chainID, bytes crossChainID) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetPendingTransfer(chainID uint64, crossChainID []byte) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetPendingTransfer(&_ICrossChainManager.CallOpts, chainID, crossChainID)
+}
+
+// GetPendingTransfers is a free data retrieval call binding the contract method 0x448878fc.
+//
+// Solidity: function getPendingTransfers() view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetPendingTransfers(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getPendingTransfers")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetPendingTransfers is a free data retrieval call binding the contract method 0x448878fc.
+//
+// Solidity: function getPendingTransfers() view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetPendingTransfers() ([]byte, error) {
+	return _ICrossChainManager.Contract.GetPendingTransfers(&_ICrossChainManager.CallOpts)
+}
+
+// GetPendingTransfers is a free data retrieval call binding the contract method 0x448878fc.
+//
+// Solidity: function getPendingTransfers() view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetPendingTransfers() ([]byte, error) {
+	return _ICrossChainManager.Contract.GetPendingTransfers(&_ICrossChainManager.CallOpts)
+}
+
+// GetRateLimit is a free data retrieval call binding the contract method 0x7b64ec01.
+//
+// Solidity: function getRateLimit(uint64 chainID, bytes asset) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetRateLimit(opts *bind.CallOpts, chainID uint64, asset []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getRateLimit", chainID, asset)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetRateLimit is a free data retrieval call binding the contract method 0x7b64ec01.
+//
+// Solidity: function getRateLimit(uint64 chainID, bytes asset) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetRateLimit(chainID uint64, asset []byte) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetRateLimit(&_ICrossChainManager.CallOpts, chainID, asset)
+}
+
+// GetRateLimit is a free data retrieval call binding the contract method 0x7b64ec01.
+//
+// Solidity: function getRateLimit(uint64 chainID, bytes asset) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetRateLimit(chainID uint64, asset []byte) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetRateLimit(&_ICrossChainManager.CallOpts, chainID, asset)
+}
+
+// GetRippleTxRecord is a free data retrieval call binding the contract method 0x0173b7fb.
+//
+// Solidity: function getRippleTxRecord(uint64 chainID, uint32 sequence) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetRippleTxRecord(opts *bind.CallOpts, chainID uint64, sequence uint32) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getRippleTxRecord", chainID, sequence)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetRippleTxRecord is a free data retrieval call binding the contract method 0x0173b7fb.
+//
+// Solidity: function getRippleTxRecord(uint64 chainID, uint32 sequence) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetRippleTxRecord(chainID uint64, sequence uint32) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetRippleTxRecord(&_ICrossChainManager.CallOpts, chainID, sequence)
+}
+
+// GetRippleTxRecord is a free data retrieval call binding the contract method 0x0173b7fb.
+//
+// Solidity: function getRippleTxRecord(uint64 chainID, uint32 sequence) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetRippleTxRecord(chainID uint64, sequence uint32) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetRippleTxRecord(&_ICrossChainManager.CallOpts, chainID, sequence)
+}
+
+// GetTransferDelay is a free data retrieval call binding the contract method 0x2273508a.
+//
+// Solidity: function getTransferDelay(uint64 chainID, bytes asset) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetTransferDelay(opts *bind.CallOpts, chainID uint64, asset []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getTransferDelay", chainID, asset)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetTransferDelay is a free data retrieval call binding the contract method 0x2273508a.
+//
+// Solidity: function getTransferDelay(uint64 chainID, bytes asset) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerSession) GetTransferDelay(chainID uint64, asset []byte) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetTransferDelay(&_ICrossChainManager.CallOpts, chainID, asset)
+}
+
+// GetTransferDelay is a free data retrieval call binding the contract method 0x2273508a.
+//
+// Solidity: function getTransferDelay(uint64 chainID, bytes asset) view returns(bytes)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetTransferDelay(chainID uint64, asset []byte) ([]byte, error) {
+	return _ICrossChainManager.Contract.GetTransferDelay(&_ICrossChainManager.CallOpts, chainID, asset)
+}
+
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string Name)
@@ -313,27 +665,111 @@ func (_ICrossChainManager *ICrossChainManagerTransactorSession) WhiteChain(Chain
 	return _ICrossChainManager.Contract.WhiteChain(&_ICrossChainManager.TransactOpts, ChainID)
 }
 
+// BumpRippleFee is a paid mutator transaction binding the contract method 0x70f56797.
+//
+// Solidity: function bumpRippleFee(uint64 FromChainId, bytes TxHash, uint64 ToChainId) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) BumpRippleFee(opts *bind.TransactOpts, FromChainId uint64, TxHash []byte, ToChainId uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "bumpRippleFee", FromChainId, TxHash, ToChainId)
+}
+
+// BumpRippleFee is a paid mutator transaction binding the contract method 0x70f56797.
+//
+// Solidity: function bumpRippleFee(uint64 FromChainId, bytes TxHash, uint64 ToChainId) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) BumpRippleFee(FromChainId uint64, TxHash []byte, ToChainId uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.BumpRippleFee(&_ICrossChainManager.TransactOpts, FromChainId, TxHash, ToChainId)
+}
+
+// BumpRippleFee is a paid mutator transaction binding the contract method 0x70f56797.
+//
+// Solidity: function bumpRippleFee(uint64 FromChainId, bytes TxHash, uint64 ToChainId) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) BumpRippleFee(FromChainId uint64, TxHash []byte, ToChainId uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.BumpRippleFee(&_ICrossChainManager.TransactOpts, FromChainId, TxHash, ToChainId)
+}
+
+// CancelTransfer is a paid mutator transaction binding the contract method 0x75438846.
+//
+// Solidity: function cancelTransfer(uint64 chainID, bytes crossChainID) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) CancelTransfer(opts *bind.TransactOpts, chainID uint64, crossChainID []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "cancelTransfer", chainID, crossChainID)
+}
+
+// CancelTransfer is a paid mutator transaction binding the contract method 0x75438846.
+//
+// Solidity: function cancelTransfer(uint64 chainID, bytes crossChainID) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) CancelTransfer(chainID uint64, crossChainID []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.CancelTransfer(&_ICrossChainManager.TransactOpts, chainID, crossChainID)
+}
+
+// CancelTransfer is a paid mutator transaction binding the contract method 0x75438846.
+//
+// Solidity: function cancelTransfer(uint64 chainID, bytes crossChainID) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) CancelTransfer(chainID uint64, crossChainID []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.CancelTransfer(&_ICrossChainManager.TransactOpts, chainID, crossChainID)
+}
+
+// DistributeFee is a paid mutator transaction binding the contract method 0x26c4e60d.
+//
+// Solidity: function distributeFee() returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) DistributeFee(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "distributeFee")
+}
+
+// DistributeFee is a paid mutator transaction binding the contract method 0x26c4e60d.
+//
+// Solidity: function distributeFee() returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) DistributeFee() (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.DistributeFee(&_ICrossChainManager.TransactOpts)
+}
+
+// DistributeFee is a paid mutator transaction binding the contract method 0x26c4e60d.
+//
+// Solidity: function distributeFee() returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) DistributeFee() (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.DistributeFee(&_ICrossChainManager.TransactOpts)
+}
+
 // ImportOuterTransfer is a paid mutator transaction binding the contract method 0xbbc2a76a.
 //
-// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes Extra, bytes Signature) returns(bool success)
+// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes Extra, bytes Signature) payable returns(bool success)
 func (_ICrossChainManager *ICrossChainManagerTransactor) ImportOuterTransfer(opts *bind.TransactOpts, SourceChainID uint64, Height uint32, Proof []byte, Extra []byte, Signature []byte) (*types.Transaction, error) {
 	return _ICrossChainManager.contract.Transact(opts, "importOuterTransfer", SourceChainID, Height, Proof, Extra, Signature)
 }
 
 // ImportOuterTransfer is a paid mutator transaction binding the contract method 0xbbc2a76a.
 //
-// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes Extra, bytes Signature) returns(bool success)
+// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes Extra, bytes Signature) payable returns(bool success)
 func (_ICrossChainManager *ICrossChainManagerSession) ImportOuterTransfer(SourceChainID uint64, Height uint32, Proof []byte, Extra []byte, Signature []byte) (*types.Transaction, error) {
 	return _ICrossChainManager.Contract.ImportOuterTransfer(&_ICrossChainManager.TransactOpts, SourceChainID, Height, Proof, Extra, Signature)
 }
 
 // ImportOuterTransfer is a paid mutator transaction binding the contract method 0xbbc2a76a.
 //
-// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes Extra, bytes Signature) returns(bool success)
+// Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes Extra, bytes Signature) payable returns(bool success)
 func (_ICrossChainManager *ICrossChainManagerTransactorSession) ImportOuterTransfer(SourceChainID uint64, Height uint32, Proof []byte, Extra []byte, Signature []byte) (*types.Transaction, error) {
 	return _ICrossChainManager.Contract.ImportOuterTransfer(&_ICrossChainManager.TransactOpts, SourceChainID, Height, Proof, Extra, Signature)
 }
 
+// MultiSignBtc is a paid mutator transaction binding the contract method 0x3cf4bdd0.
+//
+// Solidity: function multiSignBtc(uint64 chainID, bytes txHash, bytes pubKey, bytes[] sigs) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) MultiSignBtc(opts *bind.TransactOpts, chainID uint64, txHash []byte, pubKey []byte, sigs [][]byte) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "multiSignBtc", chainID, txHash, pubKey, sigs)
+}
+
+// MultiSignBtc is a paid mutator transaction binding the contract method 0x3cf4bdd0.
+//
+// Solidity: function multiSignBtc(uint64 chainID, bytes txHash, bytes pubKey, bytes[] sigs) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) MultiSignBtc(chainID uint64, txHash []byte, pubKey []byte, sigs [][]byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.MultiSignBtc(&_ICrossChainManager.TransactOpts, chainID, txHash, pubKey, sigs)
+}
+
+// MultiSignBtc is a paid mutator transaction binding the contract method 0x3cf4bdd0.
+//
+// Solidity: function multiSignBtc(uint64 chainID, bytes txHash, bytes pubKey, bytes[] sigs) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) MultiSignBtc(chainID uint64, txHash []byte, pubKey []byte, sigs [][]byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.MultiSignBtc(&_ICrossChainManager.TransactOpts, chainID, txHash, pubKey, sigs)
+}
+
 // MultiSignRipple is a paid mutator transaction binding the contract method 0xb7ef3989.
 //
 // Solidity: function multiSignRipple(uint64 ToChainId, bytes AssetAddress, uint64 FromChainId, bytes TxHash, string TxJson) returns(bool success)
@@ -376,6 +812,27 @@ func (_ICrossChainManager *ICrossChainManagerTransactorSession) ReconstructRippl
 	return _ICrossChainManager.Contract.ReconstructRippleTx(&_ICrossChainManager.TransactOpts, FromChainId, TxHash, ToChainId)
 }
 
+// ReleaseTransfer is a paid mutator transaction binding the contract method 0x2c69ec3e.
+//
+// Solidity: function releaseTransfer(uint64 chainID, bytes crossChainID) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) ReleaseTransfer(opts *bind.TransactOpts, chainID uint64, crossChainID []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "releaseTransfer", chainID, crossChainID)
+}
+
+// ReleaseTransfer is a paid mutator transaction binding the contract method 0x2c69ec3e.
+//
+// Solidity: function releaseTransfer(uint64 chainID, bytes crossChainID) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) ReleaseTransfer(chainID uint64, crossChainID []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.ReleaseTransfer(&_ICrossChainManager.TransactOpts, chainID, crossChainID)
+}
+
+// ReleaseTransfer is a paid mutator transaction binding the contract method 0x2c69ec3e.
+//
+// Solidity: function releaseTransfer(uint64 chainID, bytes crossChainID) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) ReleaseTransfer(chainID uint64, crossChainID []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.ReleaseTransfer(&_ICrossChainManager.TransactOpts, chainID, crossChainID)
+}
+
 // Replenish is a paid mutator transaction binding the contract method 0xf8bac498.
 //
 // Solidity: function replenish(uint64 chainID, string[] txHashes) returns(bool success)
@@ -397,36 +854,1098 @@ func (_ICrossChainManager *ICrossChainManagerTransactorSession) Replenish(chainI
 	return _ICrossChainManager.Contract.Replenish(&_ICrossChainManager.TransactOpts, chainID, txHashes)
 }
 
-// ICrossChainManagerMultiSignIterator is returned from FilterMultiSign and is used to iterate over the raw logs and unpacked data for MultiSign events raised by the ICrossChainManager contract.
-type ICrossChainManagerMultiSignIterator struct {
-	Event *ICrossChainManagerMultiSign // Event containing the contract specifics and raw log
+// RotateRippleSigners is a paid mutator transaction binding the contract method 0x34227dc7.
+//
+// Solidity: function rotateRippleSigners(uint64 chainID, bytes[] pks, uint64 quorum) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) RotateRippleSigners(opts *bind.TransactOpts, chainID uint64, pks [][]byte, quorum uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "rotateRippleSigners", chainID, pks, quorum)
+}
 
-	contract *bind.BoundContract // Generic contract to use for unpacking event data
-	event    string              // Event name to use for unpacking event data
+// RotateRippleSigners is a paid mutator transaction binding the contract method 0x34227dc7.
+//
+// Solidity: function rotateRippleSigners(uint64 chainID, bytes[] pks, uint64 quorum) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) RotateRippleSigners(chainID uint64, pks [][]byte, quorum uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.RotateRippleSigners(&_ICrossChainManager.TransactOpts, chainID, pks, quorum)
+}
 
-	logs chan types.Log        // Log channel receiving the found contract events
-	sub  ethereum.Subscription // Subscription for errors, completion and termination
-	done bool                  // Whether the subscription completed delivering logs
-	fail error                 // Occurred error to stop iteration
+// RotateRippleSigners is a paid mutator transaction binding the contract method 0x34227dc7.
+//
+// Solidity: function rotateRippleSigners(uint64 chainID, bytes[] pks, uint64 quorum) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) RotateRippleSigners(chainID uint64, pks [][]byte, quorum uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.RotateRippleSigners(&_ICrossChainManager.TransactOpts, chainID, pks, quorum)
 }
 
-// Next advances the iterator to the subsequent event, returning whether there
-// are any more events found. In case of a retrieval or parsing error, false is
-// returned and Error() can be queried for the exact failure.
-func (it *ICrossChainManagerMultiSignIterator) Next() bool {
-	// If the iterator failed, stop iterating
-	if it.fail != nil {
-		return false
-	}
-	// If the iterator completed, deliver directly whatever's available
-	if it.done {
-		select {
-		case log := <-it.logs:
-			it.Event = new(ICrossChainManagerMultiSign)
-			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
-				it.fail = err
-				return false
-			}
+// SetFeeConfig is a paid mutator transaction binding the contract method 0x4391b81b.
+//
+// Solidity: function setFeeConfig(uint64 voterRate, uint64 relayerRate, uint64 communityRate, uint64 period) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) SetFeeConfig(opts *bind.TransactOpts, voterRate uint64, relayerRate uint64, communityRate uint64, period uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "setFeeConfig", voterRate, relayerRate, communityRate, period)
+}
+
+// SetFeeConfig is a paid mutator transaction binding the contract method 0x4391b81b.
+//
+// Solidity: function setFeeConfig(uint64 voterRate, uint64 relayerRate, uint64 communityRate, uint64 period) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) SetFeeConfig(voterRate uint64, relayerRate uint64, communityRate uint64, period uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.SetFeeConfig(&_ICrossChainManager.TransactOpts, voterRate, relayerRate, communityRate, period)
+}
+
+// SetFeeConfig is a paid mutator transaction binding the contract method 0x4391b81b.
+//
+// Solidity: function setFeeConfig(uint64 voterRate, uint64 relayerRate, uint64 communityRate, uint64 period) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) SetFeeConfig(voterRate uint64, relayerRate uint64, communityRate uint64, period uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.SetFeeConfig(&_ICrossChainManager.TransactOpts, voterRate, relayerRate, communityRate, period)
+}
+
+// SetRateLimit is a paid mutator transaction binding the contract method 0x14d91d17.
+//
+// Solidity: function setRateLimit(uint64 chainID, bytes asset, uint64 window, uint256 limit, uint256 pauseThreshold) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) SetRateLimit(opts *bind.TransactOpts, chainID uint64, asset []byte, window uint64, limit *big.Int, pauseThreshold *big.Int) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "setRateLimit", chainID, asset, window, limit, pauseThreshold)
+}
+
+// SetRateLimit is a paid mutator transaction binding the contract method 0x14d91d17.
+//
+// Solidity: function setRateLimit(uint64 chainID, bytes asset, uint64 window, uint256 limit, uint256 pauseThreshold) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) SetRateLimit(chainID uint64, asset []byte, window uint64, limit *big.Int, pauseThreshold *big.Int) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.SetRateLimit(&_ICrossChainManager.TransactOpts, chainID, asset, window, limit, pauseThreshold)
+}
+
+// SetRateLimit is a paid mutator transaction binding the contract method 0x14d91d17.
+//
+// Solidity: function setRateLimit(uint64 chainID, bytes asset, uint64 window, uint256 limit, uint256 pauseThreshold) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) SetRateLimit(chainID uint64, asset []byte, window uint64, limit *big.Int, pauseThreshold *big.Int) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.SetRateLimit(&_ICrossChainManager.TransactOpts, chainID, asset, window, limit, pauseThreshold)
+}
+
+// SetTransferDelay is a paid mutator transaction binding the contract method 0x1c0ece4e.
+//
+// Solidity: function setTransferDelay(uint64 chainID, bytes asset, uint256 threshold, uint64 delay) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) SetTransferDelay(opts *bind.TransactOpts, chainID uint64, asset []byte, threshold *big.Int, delay uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "setTransferDelay", chainID, asset, threshold, delay)
+}
+
+// SetTransferDelay is a paid mutator transaction binding the contract method 0x1c0ece4e.
+//
+// Solidity: function setTransferDelay(uint64 chainID, bytes asset, uint256 threshold, uint64 delay) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) SetTransferDelay(chainID uint64, asset []byte, threshold *big.Int, delay uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.SetTransferDelay(&_ICrossChainManager.TransactOpts, chainID, asset, threshold, delay)
+}
+
+// SetTransferDelay is a paid mutator transaction binding the contract method 0x1c0ece4e.
+//
+// Solidity: function setTransferDelay(uint64 chainID, bytes asset, uint256 threshold, uint64 delay) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) SetTransferDelay(chainID uint64, asset []byte, threshold *big.Int, delay uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.SetTransferDelay(&_ICrossChainManager.TransactOpts, chainID, asset, threshold, delay)
+}
+
+// SyncBtcHeaders is a paid mutator transaction binding the contract method 0x86cdf410.
+//
+// Solidity: function syncBtcHeaders(uint64 chainID, bytes[] headers) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) SyncBtcHeaders(opts *bind.TransactOpts, chainID uint64, headers [][]byte) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "syncBtcHeaders", chainID, headers)
+}
+
+// SyncBtcHeaders is a paid mutator transaction binding the contract method 0x86cdf410.
+//
+// Solidity: function syncBtcHeaders(uint64 chainID, bytes[] headers) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) SyncBtcHeaders(chainID uint64, headers [][]byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.SyncBtcHeaders(&_ICrossChainManager.TransactOpts, chainID, headers)
+}
+
+// SyncBtcHeaders is a paid mutator transaction binding the contract method 0x86cdf410.
+//
+// Solidity: function syncBtcHeaders(uint64 chainID, bytes[] headers) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) SyncBtcHeaders(chainID uint64, headers [][]byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.SyncBtcHeaders(&_ICrossChainManager.TransactOpts, chainID, headers)
+}
+
+// ICrossChainManagerBtcMultiSignIterator is returned from FilterBtcMultiSign and is used to iterate over the raw logs and unpacked data for BtcMultiSign events raised by the ICrossChainManager contract.
+type ICrossChainManagerBtcMultiSignIterator struct {
+	Event *ICrossChainManagerBtcMultiSign // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerBtcMultiSignIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerBtcMultiSign)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerBtcMultiSign)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerBtcMultiSignIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerBtcMultiSignIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerBtcMultiSign represents a BtcMultiSign event raised by the ICrossChainManager contract.
+type ICrossChainManagerBtcMultiSign struct {
+	ChainID  uint64
+	TxHash   string
+	SignedTx string
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterBtcMultiSign is a free log retrieval operation binding the contract event 0x4cd5b6e14d6af9028d9c0881723e4ad617adbf18db66204bedfd8dae9d44fe28.
+//
+// Solidity: event BtcMultiSign(uint64 chainID, string txHash, string signedTx)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterBtcMultiSign(opts *bind.FilterOpts) (*ICrossChainManagerBtcMultiSignIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "BtcMultiSign")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerBtcMultiSignIterator{contract: _ICrossChainManager.contract, event: "BtcMultiSign", logs: logs, sub: sub}, nil
+}
+
+// WatchBtcMultiSign is a free log subscription operation binding the contract event 0x4cd5b6e14d6af9028d9c0881723e4ad617adbf18db66204bedfd8dae9d44fe28.
+//
+// Solidity: event BtcMultiSign(uint64 chainID, string txHash, string signedTx)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchBtcMultiSign(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerBtcMultiSign) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "BtcMultiSign")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerBtcMultiSign)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "BtcMultiSign", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBtcMultiSign is a log parse operation binding the contract event 0x4cd5b6e14d6af9028d9c0881723e4ad617adbf18db66204bedfd8dae9d44fe28.
+//
+// Solidity: event BtcMultiSign(uint64 chainID, string txHash, string signedTx)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseBtcMultiSign(log types.Log) (*ICrossChainManagerBtcMultiSign, error) {
+	event := new(ICrossChainManagerBtcMultiSign)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "BtcMultiSign", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerBtcTxIterator is returned from FilterBtcTx and is used to iterate over the raw logs and unpacked data for BtcTx events raised by the ICrossChainManager contract.
+type ICrossChainManagerBtcTxIterator struct {
+	Event *ICrossChainManagerBtcTx // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerBtcTxIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerBtcTx)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerBtcTx)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerBtcTxIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerBtcTxIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerBtcTx represents a BtcTx event raised by the ICrossChainManager contract.
+type ICrossChainManagerBtcTx struct {
+	FromChainId uint64
+	ToChainId   uint64
+	TxHash      string
+	RawTx       string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBtcTx is a free log retrieval operation binding the contract event 0x1bf2f888e70b3e73c25eb10ecd6a65d2c770737d7c4d7300013f9999e65adbd4.
+//
+// Solidity: event BtcTx(uint64 fromChainId, uint64 toChainId, string txHash, string rawTx)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterBtcTx(opts *bind.FilterOpts) (*ICrossChainManagerBtcTxIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "BtcTx")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerBtcTxIterator{contract: _ICrossChainManager.contract, event: "BtcTx", logs: logs, sub: sub}, nil
+}
+
+// WatchBtcTx is a free log subscription operation binding the contract event 0x1bf2f888e70b3e73c25eb10ecd6a65d2c770737d7c4d7300013f9999e65adbd4.
+//
+// Solidity: event BtcTx(uint64 fromChainId, uint64 toChainId, string txHash, string rawTx)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchBtcTx(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerBtcTx) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "BtcTx")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerBtcTx)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "BtcTx", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBtcTx is a log parse operation binding the contract event 0x1bf2f888e70b3e73c25eb10ecd6a65d2c770737d7c4d7300013f9999e65adbd4.
+//
+// Solidity: event BtcTx(uint64 fromChainId, uint64 toChainId, string txHash, string rawTx)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseBtcTx(log types.Log) (*ICrossChainManagerBtcTx, error) {
+	event := new(ICrossChainManagerBtcTx)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "BtcTx", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerCircuitBreakIterator is returned from FilterCircuitBreak and is used to iterate over the raw logs and unpacked data for CircuitBreak events raised by the ICrossChainManager contract.
+type ICrossChainManagerCircuitBreakIterator struct {
+	Event *ICrossChainManagerCircuitBreak // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerCircuitBreakIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerCircuitBreak)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerCircuitBreak)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerCircuitBreakIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerCircuitBreakIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerCircuitBreak represents a CircuitBreak event raised by the ICrossChainManager contract.
+type ICrossChainManagerCircuitBreak struct {
+	ChainID   uint64
+	Asset     []byte
+	Usage     *big.Int
+	Threshold *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterCircuitBreak is a free log retrieval operation binding the contract event 0x12b8b8921c64e1db14c43243bfba7a04ff7c63a61e7e60cc57b630e9c054a6c0.
+//
+// Solidity: event CircuitBreak(uint64 chainID, bytes asset, uint256 usage, uint256 threshold)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterCircuitBreak(opts *bind.FilterOpts) (*ICrossChainManagerCircuitBreakIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "CircuitBreak")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerCircuitBreakIterator{contract: _ICrossChainManager.contract, event: "CircuitBreak", logs: logs, sub: sub}, nil
+}
+
+// WatchCircuitBreak is a free log subscription operation binding the contract event 0x12b8b8921c64e1db14c43243bfba7a04ff7c63a61e7e60cc57b630e9c054a6c0.
+//
+// Solidity: event CircuitBreak(uint64 chainID, bytes asset, uint256 usage, uint256 threshold)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchCircuitBreak(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerCircuitBreak) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "CircuitBreak")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerCircuitBreak)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "CircuitBreak", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCircuitBreak is a log parse operation binding the contract event 0x12b8b8921c64e1db14c43243bfba7a04ff7c63a61e7e60cc57b630e9c054a6c0.
+//
+// Solidity: event CircuitBreak(uint64 chainID, bytes asset, uint256 usage, uint256 threshold)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseCircuitBreak(log types.Log) (*ICrossChainManagerCircuitBreak, error) {
+	event := new(ICrossChainManagerCircuitBreak)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "CircuitBreak", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerDistributeFeeIterator is returned from FilterDistributeFee and is used to iterate over the raw logs and unpacked data for DistributeFee events raised by the ICrossChainManager contract.
+type ICrossChainManagerDistributeFeeIterator struct {
+	Event *ICrossChainManagerDistributeFee // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerDistributeFeeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerDistributeFee)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerDistributeFee)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerDistributeFeeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerDistributeFeeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerDistributeFee represents a DistributeFee event raised by the ICrossChainManager contract.
+type ICrossChainManagerDistributeFee struct {
+	Token        common.Address
+	VoterFee     *big.Int
+	RelayerFee   *big.Int
+	CommunityFee *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterDistributeFee is a free log retrieval operation binding the contract event 0xbc791336b2e8817b7869c00ff2ef0e5104bfc2d351cc327758ac384c4a1c39d1.
+//
+// Solidity: event DistributeFee(address token, uint256 voterFee, uint256 relayerFee, uint256 communityFee)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterDistributeFee(opts *bind.FilterOpts) (*ICrossChainManagerDistributeFeeIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "DistributeFee")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerDistributeFeeIterator{contract: _ICrossChainManager.contract, event: "DistributeFee", logs: logs, sub: sub}, nil
+}
+
+// WatchDistributeFee is a free log subscription operation binding the contract event 0xbc791336b2e8817b7869c00ff2ef0e5104bfc2d351cc327758ac384c4a1c39d1.
+//
+// Solidity: event DistributeFee(address token, uint256 voterFee, uint256 relayerFee, uint256 communityFee)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchDistributeFee(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerDistributeFee) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "DistributeFee")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerDistributeFee)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "DistributeFee", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDistributeFee is a log parse operation binding the contract event 0xbc791336b2e8817b7869c00ff2ef0e5104bfc2d351cc327758ac384c4a1c39d1.
+//
+// Solidity: event DistributeFee(address token, uint256 voterFee, uint256 relayerFee, uint256 communityFee)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseDistributeFee(log types.Log) (*ICrossChainManagerDistributeFee, error) {
+	event := new(ICrossChainManagerDistributeFee)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "DistributeFee", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerMultiSignIterator is returned from FilterMultiSign and is used to iterate over the raw logs and unpacked data for MultiSign events raised by the ICrossChainManager contract.
+type ICrossChainManagerMultiSignIterator struct {
+	Event *ICrossChainManagerMultiSign // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerMultiSignIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerMultiSign)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerMultiSign)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerMultiSignIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerMultiSignIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerMultiSign represents a MultiSign event raised by the ICrossChainManager contract.
+type ICrossChainManagerMultiSign struct {
+	FromChainId uint64
+	ToChainId   uint64
+	TxHash      string
+	Payment     string
+	Sequence    uint32
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterMultiSign is a free log retrieval operation binding the contract event 0x162a93de0c236723115fd6139780a23fb76844208fcc6a7a51d803138cbe11a0.
+//
+// Solidity: event MultiSign(uint64 fromChainId, uint64 toChainId, string txHash, string payment, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterMultiSign(opts *bind.FilterOpts) (*ICrossChainManagerMultiSignIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "MultiSign")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerMultiSignIterator{contract: _ICrossChainManager.contract, event: "MultiSign", logs: logs, sub: sub}, nil
+}
+
+// WatchMultiSign is a free log subscription operation binding the contract event 0x162a93de0c236723115fd6139780a23fb76844208fcc6a7a51d803138cbe11a0.
+//
+// Solidity: event MultiSign(uint64 fromChainId, uint64 toChainId, string txHash, string payment, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchMultiSign(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerMultiSign) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "MultiSign")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerMultiSign)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "MultiSign", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMultiSign is a log parse operation binding the contract event 0x162a93de0c236723115fd6139780a23fb76844208fcc6a7a51d803138cbe11a0.
+//
+// Solidity: event MultiSign(uint64 fromChainId, uint64 toChainId, string txHash, string payment, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseMultiSign(log types.Log) (*ICrossChainManagerMultiSign, error) {
+	event := new(ICrossChainManagerMultiSign)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "MultiSign", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerReplenishEventIterator is returned from FilterReplenishEvent and is used to iterate over the raw logs and unpacked data for ReplenishEvent events raised by the ICrossChainManager contract.
+type ICrossChainManagerReplenishEventIterator struct {
+	Event *ICrossChainManagerReplenishEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerReplenishEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerReplenishEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerReplenishEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerReplenishEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerReplenishEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerReplenishEvent represents a ReplenishEvent event raised by the ICrossChainManager contract.
+type ICrossChainManagerReplenishEvent struct {
+	TxHashes []string
+	ChainID  uint64
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterReplenishEvent is a free log retrieval operation binding the contract event 0xac3e52c0a7de47fbd0f9a52b8f205485cd725235d94d678f638e16d02404fb38.
+//
+// Solidity: event ReplenishEvent(string[] txHashes, uint64 chainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterReplenishEvent(opts *bind.FilterOpts) (*ICrossChainManagerReplenishEventIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "ReplenishEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerReplenishEventIterator{contract: _ICrossChainManager.contract, event: "ReplenishEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchReplenishEvent is a free log subscription operation binding the contract event 0xac3e52c0a7de47fbd0f9a52b8f205485cd725235d94d678f638e16d02404fb38.
+//
+// Solidity: event ReplenishEvent(string[] txHashes, uint64 chainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchReplenishEvent(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerReplenishEvent) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "ReplenishEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerReplenishEvent)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "ReplenishEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReplenishEvent is a log parse operation binding the contract event 0xac3e52c0a7de47fbd0f9a52b8f205485cd725235d94d678f638e16d02404fb38.
+//
+// Solidity: event ReplenishEvent(string[] txHashes, uint64 chainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseReplenishEvent(log types.Log) (*ICrossChainManagerReplenishEvent, error) {
+	event := new(ICrossChainManagerReplenishEvent)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "ReplenishEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerRippleSignerListSetIterator is returned from FilterRippleSignerListSet and is used to iterate over the raw logs and unpacked data for RippleSignerListSet events raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleSignerListSetIterator struct {
+	Event *ICrossChainManagerRippleSignerListSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerRippleSignerListSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerRippleSignerListSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerRippleSignerListSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerRippleSignerListSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerRippleSignerListSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerRippleSignerListSet represents a RippleSignerListSet event raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleSignerListSet struct {
+	ChainID  uint64
+	TxHash   string
+	TxJson   string
+	Sequence uint32
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRippleSignerListSet is a free log retrieval operation binding the contract event 0x6ee7c5509e9ad5a6e6eeb7d133e2c61783fe9a434737575263a993c0bfaeda8a.
+//
+// Solidity: event RippleSignerListSet(uint64 chainID, string txHash, string txJson, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterRippleSignerListSet(opts *bind.FilterOpts) (*ICrossChainManagerRippleSignerListSetIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "RippleSignerListSet")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerRippleSignerListSetIterator{contract: _ICrossChainManager.contract, event: "RippleSignerListSet", logs: logs, sub: sub}, nil
+}
+
+// WatchRippleSignerListSet is a free log subscription operation binding the contract event 0x6ee7c5509e9ad5a6e6eeb7d133e2c61783fe9a434737575263a993c0bfaeda8a.
+//
+// Solidity: event RippleSignerListSet(uint64 chainID, string txHash, string txJson, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchRippleSignerListSet(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerRippleSignerListSet) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "RippleSignerListSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerRippleSignerListSet)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "RippleSignerListSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRippleSignerListSet is a log parse operation binding the contract event 0x6ee7c5509e9ad5a6e6eeb7d133e2c61783fe9a434737575263a993c0bfaeda8a.
+//
+// Solidity: event RippleSignerListSet(uint64 chainID, string txHash, string txJson, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseRippleSignerListSet(log types.Log) (*ICrossChainManagerRippleSignerListSet, error) {
+	event := new(ICrossChainManagerRippleSignerListSet)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "RippleSignerListSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerRippleTxIterator is returned from FilterRippleTx and is used to iterate over the raw logs and unpacked data for RippleTx events raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleTxIterator struct {
+	Event *ICrossChainManagerRippleTx // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerRippleTxIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerRippleTx)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
 			it.Event.Raw = log
 			return true
 
@@ -437,7 +1956,7 @@ func (it *ICrossChainManagerMultiSignIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ICrossChainManagerMultiSign)
+		it.Event = new(ICrossChainManagerRippleTx)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -453,45 +1972,45 @@ func (it *ICrossChainManagerMultiSignIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ICrossChainManagerMultiSignIterator) Error() error {
+func (it *ICrossChainManagerRippleTxIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ICrossChainManagerMultiSignIterator) Close() error {
+func (it *ICrossChainManagerRippleTxIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ICrossChainManagerMultiSign represents a MultiSign event raised by the ICrossChainManager contract.
-type ICrossChainManagerMultiSign struct {
+// ICrossChainManagerRippleTx represents a RippleTx event raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleTx struct {
 	FromChainId uint64
 	ToChainId   uint64
 	TxHash      string
-	Payment     string
+	TxJson      string
 	Sequence    uint32
 	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// FilterMultiSign is a free log retrieval operation binding the contract event 0x162a93de0c236723115fd6139780a23fb76844208fcc6a7a51d803138cbe11a0.
+// FilterRippleTx is a free log retrieval operation binding the contract event 0xdc5a7a51ad95eb87bc70191f6b497dec6619c6669a89b2edfed188b271e948ae.
 //
-// Solidity: event MultiSign(uint64 fromChainId, uint64 toChainId, string txHash, string payment, uint32 sequence)
-func (_ICrossChainManager *ICrossChainManagerFilterer) FilterMultiSign(opts *bind.FilterOpts) (*ICrossChainManagerMultiSignIterator, error) {
+// Solidity: event RippleTx(uint64 fromChainId, uint64 toChainId, string txHash, string txJson, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterRippleTx(opts *bind.FilterOpts) (*ICrossChainManagerRippleTxIterator, error) {
 
-	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "MultiSign")
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "RippleTx")
 	if err != nil {
 		return nil, err
 	}
-	return &ICrossChainManagerMultiSignIterator{contract: _ICrossChainManager.contract, event: "MultiSign", logs: logs, sub: sub}, nil
+	return &ICrossChainManagerRippleTxIterator{contract: _ICrossChainManager.contract, event: "RippleTx", logs: logs, sub: sub}, nil
 }
 
-// WatchMultiSign is a free log subscription operation binding the contract event 0x162a93de0c236723115fd6139780a23fb76844208fcc6a7a51d803138cbe11a0.
+// WatchRippleTx is a free log subscription operation binding the contract event 0xdc5a7a51ad95eb87bc70191f6b497dec6619c6669a89b2edfed188b271e948ae.
 //
-// Solidity: event MultiSign(uint64 fromChainId, uint64 toChainId, string txHash, string payment, uint32 sequence)
-func (_ICrossChainManager *ICrossChainManagerFilterer) WatchMultiSign(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerMultiSign) (event.Subscription, error) {
+// Solidity: event RippleTx(uint64 fromChainId, uint64 toChainId, string txHash, string txJson, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchRippleTx(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerRippleTx) (event.Subscription, error) {
 
-	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "MultiSign")
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "RippleTx")
 	if err != nil {
 		return nil, err
 	}
@@ -501,8 +2020,8 @@ func (_ICrossChainManager *ICrossChainManagerFilterer) WatchMultiSign(opts *bind
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ICrossChainManagerMultiSign)
-				if err := _ICrossChainManager.contract.UnpackLog(event, "MultiSign", log); err != nil {
+				event := new(ICrossChainManagerRippleTx)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "RippleTx", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -523,21 +2042,21 @@ func (_ICrossChainManager *ICrossChainManagerFilterer) WatchMultiSign(opts *bind
 	}), nil
 }
 
-// ParseMultiSign is a log parse operation binding the contract event 0x162a93de0c236723115fd6139780a23fb76844208fcc6a7a51d803138cbe11a0.
+// ParseRippleTx is a log parse operation binding the contract event 0xdc5a7a51ad95eb87bc70191f6b497dec6619c6669a89b2edfed188b271e948ae.
 //
-// Solidity: event MultiSign(uint64 fromChainId, uint64 toChainId, string txHash, string payment, uint32 sequence)
-func (_ICrossChainManager *ICrossChainManagerFilterer) ParseMultiSign(log types.Log) (*ICrossChainManagerMultiSign, error) {
-	event := new(ICrossChainManagerMultiSign)
-	if err := _ICrossChainManager.contract.UnpackLog(event, "MultiSign", log); err != nil {
+// Solidity: event RippleTx(uint64 fromChainId, uint64 toChainId, string txHash, string txJson, uint32 sequence)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseRippleTx(log types.Log) (*ICrossChainManagerRippleTx, error) {
+	event := new(ICrossChainManagerRippleTx)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "RippleTx", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ICrossChainManagerReplenishEventIterator is returned from FilterReplenishEvent and is used to iterate over the raw logs and unpacked data for ReplenishEvent events raised by the ICrossChainManager contract.
-type ICrossChainManagerReplenishEventIterator struct {
-	Event *ICrossChainManagerReplenishEvent // Event containing the contract specifics and raw log
+// ICrossChainManagerTransferCancelledIterator is returned from FilterTransferCancelled and is used to iterate over the raw logs and unpacked data for TransferCancelled events raised by the ICrossChainManager contract.
+type ICrossChainManagerTransferCancelledIterator struct {
+	Event *ICrossChainManagerTransferCancelled // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -551,7 +2070,7 @@ type ICrossChainManagerReplenishEventIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ICrossChainManagerReplenishEventIterator) Next() bool {
+func (it *ICrossChainManagerTransferCancelledIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -560,7 +2079,7 @@ func (it *ICrossChainManagerReplenishEventIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ICrossChainManagerReplenishEvent)
+			it.Event = new(ICrossChainManagerTransferCancelled)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -575,7 +2094,7 @@ func (it *ICrossChainManagerReplenishEventIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ICrossChainManagerReplenishEvent)
+		it.Event = new(ICrossChainManagerTransferCancelled)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -591,42 +2110,42 @@ func (it *ICrossChainManagerReplenishEventIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ICrossChainManagerReplenishEventIterator) Error() error {
+func (it *ICrossChainManagerTransferCancelledIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ICrossChainManagerReplenishEventIterator) Close() error {
+func (it *ICrossChainManagerTransferCancelledIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ICrossChainManagerReplenishEvent represents a ReplenishEvent event raised by the ICrossChainManager contract.
-type ICrossChainManagerReplenishEvent struct {
-	TxHashes []string
-	ChainID  uint64
-	Raw      types.Log // Blockchain specific contextual infos
+// ICrossChainManagerTransferCancelled represents a TransferCancelled event raised by the ICrossChainManager contract.
+type ICrossChainManagerTransferCancelled struct {
+	ChainID      uint64
+	CrossChainID []byte
+	Raw          types.Log // Blockchain specific contextual infos
 }
 
-// FilterReplenishEvent is a free log retrieval operation binding the contract event 0xac3e52c0a7de47fbd0f9a52b8f205485cd725235d94d678f638e16d02404fb38.
+// FilterTransferCancelled is a free log retrieval operation binding the contract event 0x2afbd10399c7b365b4679a30da77135bad1188475da9d40117d998c74da62ce0.
 //
-// Solidity: event ReplenishEvent(string[] txHashes, uint64 chainID)
-func (_ICrossChainManager *ICrossChainManagerFilterer) FilterReplenishEvent(opts *bind.FilterOpts) (*ICrossChainManagerReplenishEventIterator, error) {
+// Solidity: event TransferCancelled(uint64 chainID, bytes crossChainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterTransferCancelled(opts *bind.FilterOpts) (*ICrossChainManagerTransferCancelledIterator, error) {
 
-	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "ReplenishEvent")
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "TransferCancelled")
 	if err != nil {
 		return nil, err
 	}
-	return &ICrossChainManagerReplenishEventIterator{contract: _ICrossChainManager.contract, event: "ReplenishEvent", logs: logs, sub: sub}, nil
+	return &ICrossChainManagerTransferCancelledIterator{contract: _ICrossChainManager.contract, event: "TransferCancelled", logs: logs, sub: sub}, nil
 }
 
-// WatchReplenishEvent is a free log subscription operation binding the contract event 0xac3e52c0a7de47fbd0f9a52b8f205485cd725235d94d678f638e16d02404fb38.
+// WatchTransferCancelled is a free log subscription operation binding the contract event 0x2afbd10399c7b365b4679a30da77135bad1188475da9d40117d998c74da62ce0.
 //
-// Solidity: event ReplenishEvent(string[] txHashes, uint64 chainID)
-func (_ICrossChainManager *ICrossChainManagerFilterer) WatchReplenishEvent(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerReplenishEvent) (event.Subscription, error) {
+// Solidity: event TransferCancelled(uint64 chainID, bytes crossChainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchTransferCancelled(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerTransferCancelled) (event.Subscription, error) {
 
-	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "ReplenishEvent")
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "TransferCancelled")
 	if err != nil {
 		return nil, err
 	}
@@ -636,8 +2155,8 @@ func (_ICrossChainManager *ICrossChainManagerFilterer) WatchReplenishEvent(opts
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ICrossChainManagerReplenishEvent)
-				if err := _ICrossChainManager.contract.UnpackLog(event, "ReplenishEvent", log); err != nil {
+				event := new(ICrossChainManagerTransferCancelled)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "TransferCancelled", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -658,21 +2177,21 @@ func (_ICrossChainManager *ICrossChainManagerFilterer) WatchReplenishEvent(opts
 	}), nil
 }
 
-// ParseReplenishEvent is a log parse operation binding the contract event 0xac3e52c0a7de47fbd0f9a52b8f205485cd725235d94d678f638e16d02404fb38.
+// ParseTransferCancelled is a log parse operation binding the contract event 0x2afbd10399c7b365b4679a30da77135bad1188475da9d40117d998c74da62ce0.
 //
-// Solidity: event ReplenishEvent(string[] txHashes, uint64 chainID)
-func (_ICrossChainManager *ICrossChainManagerFilterer) ParseReplenishEvent(log types.Log) (*ICrossChainManagerReplenishEvent, error) {
-	event := new(ICrossChainManagerReplenishEvent)
-	if err := _ICrossChainManager.contract.UnpackLog(event, "ReplenishEvent", log); err != nil {
+// Solidity: event TransferCancelled(uint64 chainID, bytes crossChainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseTransferCancelled(log types.Log) (*ICrossChainManagerTransferCancelled, error) {
+	event := new(ICrossChainManagerTransferCancelled)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "TransferCancelled", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ICrossChainManagerRippleTxIterator is returned from FilterRippleTx and is used to iterate over the raw logs and unpacked data for RippleTx events raised by the ICrossChainManager contract.
-type ICrossChainManagerRippleTxIterator struct {
-	Event *ICrossChainManagerRippleTx // Event containing the contract specifics and raw log
+// ICrossChainManagerTransferQueuedIterator is returned from FilterTransferQueued and is used to iterate over the raw logs and unpacked data for TransferQueued events raised by the ICrossChainManager contract.
+type ICrossChainManagerTransferQueuedIterator struct {
+	Event *ICrossChainManagerTransferQueued // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -686,7 +2205,7 @@ type ICrossChainManagerRippleTxIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ICrossChainManagerRippleTxIterator) Next() bool {
+func (it *ICrossChainManagerTransferQueuedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -695,7 +2214,7 @@ func (it *ICrossChainManagerRippleTxIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ICrossChainManagerRippleTx)
+			it.Event = new(ICrossChainManagerTransferQueued)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -710,7 +2229,7 @@ func (it *ICrossChainManagerRippleTxIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ICrossChainManagerRippleTx)
+		it.Event = new(ICrossChainManagerTransferQueued)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -726,45 +2245,43 @@ func (it *ICrossChainManagerRippleTxIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ICrossChainManagerRippleTxIterator) Error() error {
+func (it *ICrossChainManagerTransferQueuedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ICrossChainManagerRippleTxIterator) Close() error {
+func (it *ICrossChainManagerTransferQueuedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ICrossChainManagerRippleTx represents a RippleTx event raised by the ICrossChainManager contract.
-type ICrossChainManagerRippleTx struct {
-	FromChainId uint64
-	ToChainId   uint64
-	TxHash      string
-	TxJson      string
-	Sequence    uint32
-	Raw         types.Log // Blockchain specific contextual infos
+// ICrossChainManagerTransferQueued represents a TransferQueued event raised by the ICrossChainManager contract.
+type ICrossChainManagerTransferQueued struct {
+	ChainID       uint64
+	CrossChainID  []byte
+	ReleaseHeight uint64
+	Raw           types.Log // Blockchain specific contextual infos
 }
 
-// FilterRippleTx is a free log retrieval operation binding the contract event 0xdc5a7a51ad95eb87bc70191f6b497dec6619c6669a89b2edfed188b271e948ae.
+// FilterTransferQueued is a free log retrieval operation binding the contract event 0x07099795c4bca7b73005321423e7d4b41a10c2f6cb48f9c6d547143e88a9be8f.
 //
-// Solidity: event RippleTx(uint64 fromChainId, uint64 toChainId, string txHash, string txJson, uint32 sequence)
-func (_ICrossChainManager *ICrossChainManagerFilterer) FilterRippleTx(opts *bind.FilterOpts) (*ICrossChainManagerRippleTxIterator, error) {
+// Solidity: event TransferQueued(uint64 chainID, bytes crossChainID, uint64 releaseHeight)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterTransferQueued(opts *bind.FilterOpts) (*ICrossChainManagerTransferQueuedIterator, error) {
 
-	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "RippleTx")
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "TransferQueued")
 	if err != nil {
 		return nil, err
 	}
-	return &ICrossChainManagerRippleTxIterator{contract: _ICrossChainManager.contract, event: "RippleTx", logs: logs, sub: sub}, nil
+	return &ICrossChainManagerTransferQueuedIterator{contract: _ICrossChainManager.contract, event: "TransferQueued", logs: logs, sub: sub}, nil
 }
 
-// WatchRippleTx is a free log subscription operation binding the contract event 0xdc5a7a51ad95eb87bc70191f6b497dec6619c6669a89b2edfed188b271e948ae.
+// WatchTransferQueued is a free log subscription operation binding the contract event 0x07099795c4bca7b73005321423e7d4b41a10c2f6cb48f9c6d547143e88a9be8f.
 //
-// Solidity: event RippleTx(uint64 fromChainId, uint64 toChainId, string txHash, string txJson, uint32 sequence)
-func (_ICrossChainManager *ICrossChainManagerFilterer) WatchRippleTx(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerRippleTx) (event.Subscription, error) {
+// Solidity: event TransferQueued(uint64 chainID, bytes crossChainID, uint64 releaseHeight)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchTransferQueued(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerTransferQueued) (event.Subscription, error) {
 
-	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "RippleTx")
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "TransferQueued")
 	if err != nil {
 		return nil, err
 	}
@@ -774,8 +2291,8 @@ func (_ICrossChainManager *ICrossChainManagerFilterer) WatchRippleTx(opts *bind.
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ICrossChainManagerRippleTx)
-				if err := _ICrossChainManager.contract.UnpackLog(event, "RippleTx", log); err != nil {
+				event := new(ICrossChainManagerTransferQueued)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "TransferQueued", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -796,12 +2313,147 @@ func (_ICrossChainManager *ICrossChainManagerFilterer) WatchRippleTx(opts *bind.
 	}), nil
 }
 
-// ParseRippleTx is a log parse operation binding the contract event 0xdc5a7a51ad95eb87bc70191f6b497dec6619c6669a89b2edfed188b271e948ae.
+// ParseTransferQueued is a log parse operation binding the contract event 0x07099795c4bca7b73005321423e7d4b41a10c2f6cb48f9c6d547143e88a9be8f.
 //
-// Solidity: event RippleTx(uint64 fromChainId, uint64 toChainId, string txHash, string txJson, uint32 sequence)
-func (_ICrossChainManager *ICrossChainManagerFilterer) ParseRippleTx(log types.Log) (*ICrossChainManagerRippleTx, error) {
-	event := new(ICrossChainManagerRippleTx)
-	if err := _ICrossChainManager.contract.UnpackLog(event, "RippleTx", log); err != nil {
+// Solidity: event TransferQueued(uint64 chainID, bytes crossChainID, uint64 releaseHeight)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseTransferQueued(log types.Log) (*ICrossChainManagerTransferQueued, error) {
+	event := new(ICrossChainManagerTransferQueued)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "TransferQueued", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerTransferReleasedIterator is returned from FilterTransferReleased and is used to iterate over the raw logs and unpacked data for TransferReleased events raised by the ICrossChainManager contract.
+type ICrossChainManagerTransferReleasedIterator struct {
+	Event *ICrossChainManagerTransferReleased // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerTransferReleasedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerTransferReleased)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerTransferReleased)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerTransferReleasedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerTransferReleasedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerTransferReleased represents a TransferReleased event raised by the ICrossChainManager contract.
+type ICrossChainManagerTransferReleased struct {
+	ChainID      uint64
+	CrossChainID []byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferReleased is a free log retrieval operation binding the contract event 0x2def4d15f41b7f7f29b0a04edd4a311394b0916e8215c13f2cf176f12b64590f.
+//
+// Solidity: event TransferReleased(uint64 chainID, bytes crossChainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterTransferReleased(opts *bind.FilterOpts) (*ICrossChainManagerTransferReleasedIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "TransferReleased")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerTransferReleasedIterator{contract: _ICrossChainManager.contract, event: "TransferReleased", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferReleased is a free log subscription operation binding the contract event 0x2def4d15f41b7f7f29b0a04edd4a311394b0916e8215c13f2cf176f12b64590f.
+//
+// Solidity: event TransferReleased(uint64 chainID, bytes crossChainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchTransferReleased(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerTransferReleased) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "TransferReleased")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerTransferReleased)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "TransferReleased", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferReleased is a log parse operation binding the contract event 0x2def4d15f41b7f7f29b0a04edd4a311394b0916e8215c13f2cf176f12b64590f.
+//
+// Solidity: event TransferReleased(uint64 chainID, bytes crossChainID)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseTransferReleased(log types.Log) (*ICrossChainManagerTransferReleased, error) {
+	event := new(ICrossChainManagerTransferReleased)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "TransferReleased", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -33,37 +33,55 @@ var (
 
 	MethodProposeConfig = "proposeConfig"
 
+	MethodProposeEconomic = "proposeEconomic"
+
+	MethodProposeSpend = "proposeSpend"
+
 	MethodVoteProposal = "voteProposal"
 
 	MethodGetCommunityProposalList = "getCommunityProposalList"
 
 	MethodGetConfigProposalList = "getConfigProposalList"
 
+	MethodGetEconomicProposalList = "getEconomicProposalList"
+
 	MethodGetProposal = "getProposal"
 
 	MethodGetProposalList = "getProposalList"
 
+	MethodGetSpendProposalList = "getSpendProposalList"
+
+	EventExecuteSpendFailed = "ExecuteSpendFailed"
+
 	EventPropose = "Propose"
 
 	EventProposeCommunity = "ProposeCommunity"
 
 	EventProposeConfig = "ProposeConfig"
 
+	EventProposeEconomic = "ProposeEconomic"
+
+	EventProposeSpend = "ProposeSpend"
+
 	EventVoteProposal = "VoteProposal"
 )
 
 // IProposalManagerABI is the input ABI used to generate the binding from.
-const IProposalManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"caller\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"stake\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"content\",\"type\":\"string\"}],\"name\":\"Propose\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"caller\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"stake\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"content\",\"type\":\"string\"}],\"name\":\"ProposeCommunity\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"caller\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"stake\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"content\",\"type\":\"string\"}],\"name\":\"ProposeConfig\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"}],\"name\":\"VoteProposal\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"getCommunityProposalList\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getConfigProposalList\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"ID\",\"type\":\"int256\"}],\"name\":\"getProposal\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getProposalList\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"content\",\"type\":\"bytes\"}],\"name\":\"propose\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"content\",\"type\":\"bytes\"}],\"name\":\"proposeCommunity\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"content\",\"type\":\"bytes\"}],\"name\":\"proposeConfig\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"ID\",\"type\":\"int256\"}],\"name\":\"voteProposal\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const IProposalManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"ExecuteSpendFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"caller\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"stake\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"content\",\"type\":\"string\"}],\"name\":\"Propose\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"caller\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"stake\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"content\",\"type\":\"string\"}],\"name\":\"ProposeCommunity\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"caller\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"stake\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"content\",\"type\":\"string\"}],\"name\":\"ProposeConfig\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"caller\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"stake\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"content\",\"type\":\"string\"}],\"name\":\"ProposeEconomic\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"caller\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"stake\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"content\",\"type\":\"string\"}],\"name\":\"ProposeSpend\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"ID\",\"type\":\"string\"}],\"name\":\"VoteProposal\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"getCommunityProposalList\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getConfigProposalList\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEconomicProposalList\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"ID\",\"type\":\"int256\"}],\"name\":\"getProposal\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getProposalList\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getSpendProposalList\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"content\",\"type\":\"bytes\"}],\"name\":\"propose\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"content\",\"type\":\"bytes\"}],\"name\":\"proposeCommunity\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"content\",\"type\":\"bytes\"}],\"name\":\"proposeConfig\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"content\",\"type\":\"bytes\"}],\"name\":\"proposeEconomic\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"content\",\"type\":\"bytes\"}],\"name\":\"proposeSpend\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"ID\",\"type\":\"int256\"}],\"name\":\"voteProposal\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // IProposalManagerFuncSigs maps the 4-byte function signature to its string representation.
 var IProposalManagerFuncSigs = map[string]string{
 	"0085b673": "getCommunityProposalList()",
 	"de63d452": "getConfigProposalList()",
+	"f776a162": "getEconomicProposalList()",
 	"2a69c349": "getProposal(int256)",
 	"346750f3": "getProposalList()",
+	"05c17da2": "getSpendProposalList()",
 	"37558af5": "propose(bytes)",
 	"8682c1d0": "proposeCommunity(bytes)",
 	"529aaa13": "proposeConfig(bytes)",
+	"4ae8fe4c": "proposeEconomic(bytes)",
+	"37fa0f04": "proposeSpend(bytes)",
 	"e3b917ca": "voteProposal(int256)",
 }
 
@@ -271,6 +289,37 @@ func (_IProposalManager *IProposalManagerCallerSession) GetConfigProposalList()
 	return _IProposalManager.Contract.GetConfigProposalList(&_IProposalManager.CallOpts)
 }
 
+// GetEconomicProposalList is a free data retrieval call binding the contract method 0xf776a162.
+//
+// Solidity: function getEconomicProposalList() view returns(bytes)
+func (_IProposalManager *IProposalManagerCaller) GetEconomicProposalList(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _IProposalManager.contract.Call(opts, &out, "getEconomicProposalList")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetEconomicProposalList is a free data retrieval call binding the contract method 0xf776a162.
+//
+// Solidity: function getEconomicProposalList() view returns(bytes)
+func (_IProposalManager *IProposalManagerSession) GetEconomicProposalList() ([]byte, error) {
+	return _IProposalManager.Contract.GetEconomicProposalList(&_IProposalManager.CallOpts)
+}
+
+// GetEconomicProposalList is a free data retrieval call binding the contract method 0xf776a162.
+//
+// Solidity: function getEconomicProposalList() view returns(bytes)
+func (_IProposalManager *IProposalManagerCallerSession) GetEconomicProposalList() ([]byte, error) {
+	return _IProposalManager.Contract.GetEconomicProposalList(&_IProposalManager.CallOpts)
+}
+
 // GetProposal is a free data retrieval call binding the contract method 0x2a69c349.
 //
 // Solidity: function getProposal(int256 ID) view returns(bytes)
@@ -333,6 +382,37 @@ func (_IProposalManager *IProposalManagerCallerSession) GetProposalList() ([]byt
 	return _IProposalManager.Contract.GetProposalList(&_IProposalManager.CallOpts)
 }
 
+// GetSpendProposalList is a free data retrieval call binding the contract method 0x05c17da2.
+//
+// Solidity: function getSpendProposalList() view returns(bytes)
+func (_IProposalManager *IProposalManagerCaller) GetSpendProposalList(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _IProposalManager.contract.Call(opts, &out, "getSpendProposalList")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetSpendProposalList is a free data retrieval call binding the contract method 0x05c17da2.
+//
+// Solidity: function getSpendProposalList() view returns(bytes)
+func (_IProposalManager *IProposalManagerSession) GetSpendProposalList() ([]byte, error) {
+	return _IProposalManager.Contract.GetSpendProposalList(&_IProposalManager.CallOpts)
+}
+
+// GetSpendProposalList is a free data retrieval call binding the contract method 0x05c17da2.
+//
+// Solidity: function getSpendProposalList() view returns(bytes)
+func (_IProposalManager *IProposalManagerCallerSession) GetSpendProposalList() ([]byte, error) {
+	return _IProposalManager.Contract.GetSpendProposalList(&_IProposalManager.CallOpts)
+}
+
 // Propose is a paid mutator transaction binding the contract method 0x37558af5.
 //
 // Solidity: function propose(bytes content) returns(bool success)
@@ -396,6 +476,48 @@ func (_IProposalManager *IProposalManagerTransactorSession) ProposeConfig(conten
 	return _IProposalManager.Contract.ProposeConfig(&_IProposalManager.TransactOpts, content)
 }
 
+// ProposeEconomic is a paid mutator transaction binding the contract method 0x4ae8fe4c.
+//
+// Solidity: function proposeEconomic(bytes content) returns(bool success)
+func (_IProposalManager *IProposalManagerTransactor) ProposeEconomic(opts *bind.TransactOpts, content []byte) (*types.Transaction, error) {
+	return _IProposalManager.contract.Transact(opts, "proposeEconomic", content)
+}
+
+// ProposeEconomic is a paid mutator transaction binding the contract method 0x4ae8fe4c.
+//
+// Solidity: function proposeEconomic(bytes content) returns(bool success)
+func (_IProposalManager *IProposalManagerSession) ProposeEconomic(content []byte) (*types.Transaction, error) {
+	return _IProposalManager.Contract.ProposeEconomic(&_IProposalManager.TransactOpts, content)
+}
+
+// ProposeEconomic is a paid mutator transaction binding the contract method 0x4ae8fe4c.
+//
+// Solidity: function proposeEconomic(bytes content) returns(bool success)
+func (_IProposalManager *IProposalManagerTransactorSession) ProposeEconomic(content []byte) (*types.Transaction, error) {
+	return _IProposalManager.Contract.ProposeEconomic(&_IProposalManager.TransactOpts, content)
+}
+
+// ProposeSpend is a paid mutator transaction binding the contract method 0x37fa0f04.
+//
+// Solidity: function proposeSpend(bytes content) returns(bool success)
+func (_IProposalManager *IProposalManagerTransactor) ProposeSpend(opts *bind.TransactOpts, content []byte) (*types.Transaction, error) {
+	return _IProposalManager.contract.Transact(opts, "proposeSpend", content)
+}
+
+// ProposeSpend is a paid mutator transaction binding the contract method 0x37fa0f04.
+//
+// Solidity: function proposeSpend(bytes content) returns(bool success)
+func (_IProposalManager *IProposalManagerSession) ProposeSpend(content []byte) (*types.Transaction, error) {
+	return _IProposalManager.Contract.ProposeSpend(&_IProposalManager.TransactOpts, content)
+}
+
+// ProposeSpend is a paid mutator transaction binding the contract method 0x37fa0f04.
+//
+// Solidity: function proposeSpend(bytes content) returns(bool success)
+func (_IProposalManager *IProposalManagerTransactorSession) ProposeSpend(content []byte) (*types.Transaction, error) {
+	return _IProposalManager.Contract.ProposeSpend(&_IProposalManager.TransactOpts, content)
+}
+
 // VoteProposal is a paid mutator transaction binding the contract method 0xe3b917ca.
 //
 // Solidity: function voteProposal(int256 ID) returns(bool success)
@@ -417,6 +539,141 @@ func (_IProposalManager *IProposalManagerTransactorSession) VoteProposal(ID *big
 	return _IProposalManager.Contract.VoteProposal(&_IProposalManager.TransactOpts, ID)
 }
 
+// IProposalManagerExecuteSpendFailedIterator is returned from FilterExecuteSpendFailed and is used to iterate over the raw logs and unpacked data for ExecuteSpendFailed events raised by the IProposalManager contract.
+type IProposalManagerExecuteSpendFailedIterator struct {
+	Event *IProposalManagerExecuteSpendFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IProposalManagerExecuteSpendFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IProposalManagerExecuteSpendFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IProposalManagerExecuteSpendFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IProposalManagerExecuteSpendFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IProposalManagerExecuteSpendFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IProposalManagerExecuteSpendFailed represents a ExecuteSpendFailed event raised by the IProposalManager contract.
+type IProposalManagerExecuteSpendFailed struct {
+	ID     string
+	Reason string
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecuteSpendFailed is a free log retrieval operation binding the contract event 0x07d12f14b2dcb6c84ec094db037fb0b11f4b007774592860f17f287b93489f43.
+//
+// Solidity: event ExecuteSpendFailed(string ID, string reason)
+func (_IProposalManager *IProposalManagerFilterer) FilterExecuteSpendFailed(opts *bind.FilterOpts) (*IProposalManagerExecuteSpendFailedIterator, error) {
+
+	logs, sub, err := _IProposalManager.contract.FilterLogs(opts, "ExecuteSpendFailed")
+	if err != nil {
+		return nil, err
+	}
+	return &IProposalManagerExecuteSpendFailedIterator{contract: _IProposalManager.contract, event: "ExecuteSpendFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchExecuteSpendFailed is a free log subscription operation binding the contract event 0x07d12f14b2dcb6c84ec094db037fb0b11f4b007774592860f17f287b93489f43.
+//
+// Solidity: event ExecuteSpendFailed(string ID, string reason)
+func (_IProposalManager *IProposalManagerFilterer) WatchExecuteSpendFailed(opts *bind.WatchOpts, sink chan<- *IProposalManagerExecuteSpendFailed) (event.Subscription, error) {
+
+	logs, sub, err := _IProposalManager.contract.WatchLogs(opts, "ExecuteSpendFailed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IProposalManagerExecuteSpendFailed)
+				if err := _IProposalManager.contract.UnpackLog(event, "ExecuteSpendFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecuteSpendFailed is a log parse operation binding the contract event 0x07d12f14b2dcb6c84ec094db037fb0b11f4b007774592860f17f287b93489f43.
+//
+// Solidity: event ExecuteSpendFailed(string ID, string reason)
+func (_IProposalManager *IProposalManagerFilterer) ParseExecuteSpendFailed(log types.Log) (*IProposalManagerExecuteSpendFailed, error) {
+	event := new(IProposalManagerExecuteSpendFailed)
+	if err := _IProposalManager.contract.UnpackLog(event, "ExecuteSpendFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // IProposalManagerProposeIterator is returned from FilterPropose and is used to iterate over the raw logs and unpacked data for Propose events raised by the IProposalManager contract.
 type IProposalManagerProposeIterator struct {
 	Event *IProposalManagerPropose // Event containing the contract specifics and raw log
@@ -828,6 +1085,280 @@ func (_IProposalManager *IProposalManagerFilterer) ParseProposeConfig(log types.
 	return event, nil
 }
 
+// IProposalManagerProposeEconomicIterator is returned from FilterProposeEconomic and is used to iterate over the raw logs and unpacked data for ProposeEconomic events raised by the IProposalManager contract.
+type IProposalManagerProposeEconomicIterator struct {
+	Event *IProposalManagerProposeEconomic // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IProposalManagerProposeEconomicIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IProposalManagerProposeEconomic)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IProposalManagerProposeEconomic)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IProposalManagerProposeEconomicIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IProposalManagerProposeEconomicIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IProposalManagerProposeEconomic represents a ProposeEconomic event raised by the IProposalManager contract.
+type IProposalManagerProposeEconomic struct {
+	ID      string
+	Caller  string
+	Stake   string
+	Content string
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposeEconomic is a free log retrieval operation binding the contract event 0xe42f149302028ed975a8d18070653b475a8680f714aec7df45a9db9601576901.
+//
+// Solidity: event ProposeEconomic(string ID, string caller, string stake, string content)
+func (_IProposalManager *IProposalManagerFilterer) FilterProposeEconomic(opts *bind.FilterOpts) (*IProposalManagerProposeEconomicIterator, error) {
+
+	logs, sub, err := _IProposalManager.contract.FilterLogs(opts, "ProposeEconomic")
+	if err != nil {
+		return nil, err
+	}
+	return &IProposalManagerProposeEconomicIterator{contract: _IProposalManager.contract, event: "ProposeEconomic", logs: logs, sub: sub}, nil
+}
+
+// WatchProposeEconomic is a free log subscription operation binding the contract event 0xe42f149302028ed975a8d18070653b475a8680f714aec7df45a9db9601576901.
+//
+// Solidity: event ProposeEconomic(string ID, string caller, string stake, string content)
+func (_IProposalManager *IProposalManagerFilterer) WatchProposeEconomic(opts *bind.WatchOpts, sink chan<- *IProposalManagerProposeEconomic) (event.Subscription, error) {
+
+	logs, sub, err := _IProposalManager.contract.WatchLogs(opts, "ProposeEconomic")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IProposalManagerProposeEconomic)
+				if err := _IProposalManager.contract.UnpackLog(event, "ProposeEconomic", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposeEconomic is a log parse operation binding the contract event 0xe42f149302028ed975a8d18070653b475a8680f714aec7df45a9db9601576901.
+//
+// Solidity: event ProposeEconomic(string ID, string caller, string stake, string content)
+func (_IProposalManager *IProposalManagerFilterer) ParseProposeEconomic(log types.Log) (*IProposalManagerProposeEconomic, error) {
+	event := new(IProposalManagerProposeEconomic)
+	if err := _IProposalManager.contract.UnpackLog(event, "ProposeEconomic", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IProposalManagerProposeSpendIterator is returned from FilterProposeSpend and is used to iterate over the raw logs and unpacked data for ProposeSpend events raised by the IProposalManager contract.
+type IProposalManagerProposeSpendIterator struct {
+	Event *IProposalManagerProposeSpend // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IProposalManagerProposeSpendIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IProposalManagerProposeSpend)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IProposalManagerProposeSpend)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IProposalManagerProposeSpendIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IProposalManagerProposeSpendIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IProposalManagerProposeSpend represents a ProposeSpend event raised by the IProposalManager contract.
+type IProposalManagerProposeSpend struct {
+	ID      string
+	Caller  string
+	Stake   string
+	Content string
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposeSpend is a free log retrieval operation binding the contract event 0x128c10ea07ab19c53e2b2f6ad5cd414f7bc71887d26cb21c77bd021bb894b9c0.
+//
+// Solidity: event ProposeSpend(string ID, string caller, string stake, string content)
+func (_IProposalManager *IProposalManagerFilterer) FilterProposeSpend(opts *bind.FilterOpts) (*IProposalManagerProposeSpendIterator, error) {
+
+	logs, sub, err := _IProposalManager.contract.FilterLogs(opts, "ProposeSpend")
+	if err != nil {
+		return nil, err
+	}
+	return &IProposalManagerProposeSpendIterator{contract: _IProposalManager.contract, event: "ProposeSpend", logs: logs, sub: sub}, nil
+}
+
+// WatchProposeSpend is a free log subscription operation binding the contract event 0x128c10ea07ab19c53e2b2f6ad5cd414f7bc71887d26cb21c77bd021bb894b9c0.
+//
+// Solidity: event ProposeSpend(string ID, string caller, string stake, string content)
+func (_IProposalManager *IProposalManagerFilterer) WatchProposeSpend(opts *bind.WatchOpts, sink chan<- *IProposalManagerProposeSpend) (event.Subscription, error) {
+
+	logs, sub, err := _IProposalManager.contract.WatchLogs(opts, "ProposeSpend")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IProposalManagerProposeSpend)
+				if err := _IProposalManager.contract.UnpackLog(event, "ProposeSpend", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposeSpend is a log parse operation binding the contract event 0x128c10ea07ab19c53e2b2f6ad5cd414f7bc71887d26cb21c77bd021bb894b9c0.
+//
+// Solidity: event ProposeSpend(string ID, string caller, string stake, string content)
+func (_IProposalManager *IProposalManagerFilterer) ParseProposeSpend(log types.Log) (*IProposalManagerProposeSpend, error) {
+	event := new(IProposalManagerProposeSpend)
+	if err := _IProposalManager.contract.UnpackLog(event, "ProposeSpend", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // IProposalManagerVoteProposalIterator is returned from FilterVoteProposal and is used to iterate over the raw logs and unpacked data for VoteProposal events raised by the IProposalManager contract.
 type IProposalManagerVoteProposalIterator struct {
 	Event *IProposalManagerVoteProposal // Event containing the contract specifics and raw log
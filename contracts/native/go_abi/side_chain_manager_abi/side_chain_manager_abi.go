@@ -47,14 +47,24 @@ var (
 
 	MethodRegisterAsset = "registerAsset"
 
+	MethodRegisterRippleCurrency = "registerRippleCurrency"
+
 	MethodRegisterSideChain = "registerSideChain"
 
 	MethodUpdateFee = "updateFee"
 
+	MethodUpdateFeeToken = "updateFeeToken"
+
+	MethodUpdateRootRetention = "updateRootRetention"
+
 	MethodUpdateSideChain = "updateSideChain"
 
 	MethodGetFee = "getFee"
 
+	MethodGetFeeToken = "getFeeToken"
+
+	MethodGetRootRetention = "getRootRetention"
+
 	MethodGetSideChain = "getSideChain"
 
 	EventApproveQuitSideChain = "ApproveQuitSideChain"
@@ -71,7 +81,7 @@ var (
 )
 
 // ISideChainManagerABI is the input ABI used to generate the binding from.
-const ISideChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveQuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveRegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveUpdateSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"QuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"name\":\"RegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"name\":\"UpdateSideChain\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveQuitSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveRegisterSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveUpdateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getFee\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getSideChain\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"internalType\":\"structISideChainManager.SideChain\",\"name\":\"sidechain\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"quitSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64[]\",\"name\":\"AssetMapKey\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"AssetMapValue\",\"type\":\"bytes[]\"},{\"internalType\":\"uint64[]\",\"name\":\"LockProxyMapKey\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"LockProxyMapValue\",\"type\":\"bytes[]\"}],\"name\":\"registerAsset\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"name\":\"registerSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"viewNum\",\"type\":\"uint64\"},{\"internalType\":\"int256\",\"name\":\"fee\",\"type\":\"int256\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"updateFee\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"name\":\"updateSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const ISideChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveQuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveRegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveUpdateSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"QuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"name\":\"RegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"name\":\"UpdateSideChain\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveQuitSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveRegisterSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveUpdateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getFee\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getFeeToken\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getRootRetention\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"window\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getSideChain\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"internalType\":\"structISideChainManager.SideChain\",\"name\":\"sidechain\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"quitSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64[]\",\"name\":\"AssetMapKey\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"AssetMapValue\",\"type\":\"bytes[]\"},{\"internalType\":\"uint64[]\",\"name\":\"LockProxyMapKey\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"LockProxyMapValue\",\"type\":\"bytes[]\"}],\"name\":\"registerAsset\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"currency\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"issuer\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"precision\",\"type\":\"uint64\"}],\"name\":\"registerRippleCurrency\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"name\":\"registerSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"viewNum\",\"type\":\"uint64\"},{\"internalType\":\"int256\",\"name\":\"fee\",\"type\":\"int256\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"updateFee\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"feeToken\",\"type\":\"address\"}],\"name\":\"updateFeeToken\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"window\",\"type\":\"uint64\"}],\"name\":\"updateRootRetention\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"name\":\"updateSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // ISideChainManagerFuncSigs maps the 4-byte function signature to its string representation.
 var ISideChainManagerFuncSigs = map[string]string{
@@ -79,11 +89,16 @@ var ISideChainManagerFuncSigs = map[string]string{
 	"c3e7746d": "approveRegisterSideChain(uint64)",
 	"678f0135": "approveUpdateSideChain(uint64)",
 	"1982b1d0": "getFee(uint64)",
+	"a7024e09": "getFeeToken(uint64)",
+	"ebc13afb": "getRootRetention(uint64)",
 	"84838fb8": "getSideChain(uint64)",
 	"78b94ab1": "quitSideChain(uint64)",
 	"e171240f": "registerAsset(uint64,uint64[],bytes[],uint64[],bytes[])",
+	"729346de": "registerRippleCurrency(uint64,string,bytes,uint64)",
 	"3a24101f": "registerSideChain(uint64,uint64,string,bytes,bytes)",
 	"db5d3488": "updateFee(uint64,uint64,int256,bytes)",
+	"ee1959e4": "updateFeeToken(uint64,address)",
+	"c7d1c15f": "updateRootRetention(uint64,uint64)",
 	"956f1463": "updateSideChain(uint64,uint64,string,bytes,bytes)",
 }
 
@@ -260,6 +275,68 @@ func (_ISideChainManager *ISideChainManagerCallerSession) GetFee(chainID uint64)
 	return _ISideChainManager.Contract.GetFee(&_ISideChainManager.CallOpts, chainID)
 }
 
+// GetFeeToken is a free data retrieval call binding the contract method 0xa7024e09.
+//
+// Solidity: function getFeeToken(uint64 chainID) view returns(address)
+func (_ISideChainManager *ISideChainManagerCaller) GetFeeToken(opts *bind.CallOpts, chainID uint64) (common.Address, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getFeeToken", chainID)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetFeeToken is a free data retrieval call binding the contract method 0xa7024e09.
+//
+// Solidity: function getFeeToken(uint64 chainID) view returns(address)
+func (_ISideChainManager *ISideChainManagerSession) GetFeeToken(chainID uint64) (common.Address, error) {
+	return _ISideChainManager.Contract.GetFeeToken(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetFeeToken is a free data retrieval call binding the contract method 0xa7024e09.
+//
+// Solidity: function getFeeToken(uint64 chainID) view returns(address)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetFeeToken(chainID uint64) (common.Address, error) {
+	return _ISideChainManager.Contract.GetFeeToken(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetRootRetention is a free data retrieval call binding the contract method 0xebc13afb.
+//
+// Solidity: function getRootRetention(uint64 chainID) view returns(uint64 window)
+func (_ISideChainManager *ISideChainManagerCaller) GetRootRetention(opts *bind.CallOpts, chainID uint64) (uint64, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getRootRetention", chainID)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// GetRootRetention is a free data retrieval call binding the contract method 0xebc13afb.
+//
+// Solidity: function getRootRetention(uint64 chainID) view returns(uint64 window)
+func (_ISideChainManager *ISideChainManagerSession) GetRootRetention(chainID uint64) (uint64, error) {
+	return _ISideChainManager.Contract.GetRootRetention(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetRootRetention is a free data retrieval call binding the contract method 0xebc13afb.
+//
+// Solidity: function getRootRetention(uint64 chainID) view returns(uint64 window)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetRootRetention(chainID uint64) (uint64, error) {
+	return _ISideChainManager.Contract.GetRootRetention(&_ISideChainManager.CallOpts, chainID)
+}
+
 // GetSideChain is a free data retrieval call binding the contract method 0x84838fb8.
 //
 // Solidity: function getSideChain(uint64 chainID) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
@@ -396,6 +473,27 @@ func (_ISideChainManager *ISideChainManagerTransactorSession) RegisterAsset(chai
 	return _ISideChainManager.Contract.RegisterAsset(&_ISideChainManager.TransactOpts, chainID, AssetMapKey, AssetMapValue, LockProxyMapKey, LockProxyMapValue)
 }
 
+// RegisterRippleCurrency is a paid mutator transaction binding the contract method 0x729346de.
+//
+// Solidity: function registerRippleCurrency(uint64 chainID, string currency, bytes issuer, uint64 precision) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) RegisterRippleCurrency(opts *bind.TransactOpts, chainID uint64, currency string, issuer []byte, precision uint64) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "registerRippleCurrency", chainID, currency, issuer, precision)
+}
+
+// RegisterRippleCurrency is a paid mutator transaction binding the contract method 0x729346de.
+//
+// Solidity: function registerRippleCurrency(uint64 chainID, string currency, bytes issuer, uint64 precision) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) RegisterRippleCurrency(chainID uint64, currency string, issuer []byte, precision uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RegisterRippleCurrency(&_ISideChainManager.TransactOpts, chainID, currency, issuer, precision)
+}
+
+// RegisterRippleCurrency is a paid mutator transaction binding the contract method 0x729346de.
+//
+// Solidity: function registerRippleCurrency(uint64 chainID, string currency, bytes issuer, uint64 precision) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) RegisterRippleCurrency(chainID uint64, currency string, issuer []byte, precision uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RegisterRippleCurrency(&_ISideChainManager.TransactOpts, chainID, currency, issuer, precision)
+}
+
 // RegisterSideChain is a paid mutator transaction binding the contract method 0x3a24101f.
 //
 // Solidity: function registerSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) returns()
@@ -438,6 +536,48 @@ func (_ISideChainManager *ISideChainManagerTransactorSession) UpdateFee(chainID
 	return _ISideChainManager.Contract.UpdateFee(&_ISideChainManager.TransactOpts, chainID, viewNum, fee, signature)
 }
 
+// UpdateFeeToken is a paid mutator transaction binding the contract method 0xee1959e4.
+//
+// Solidity: function updateFeeToken(uint64 chainID, address feeToken) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) UpdateFeeToken(opts *bind.TransactOpts, chainID uint64, feeToken common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "updateFeeToken", chainID, feeToken)
+}
+
+// UpdateFeeToken is a paid mutator transaction binding the contract method 0xee1959e4.
+//
+// Solidity: function updateFeeToken(uint64 chainID, address feeToken) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) UpdateFeeToken(chainID uint64, feeToken common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.UpdateFeeToken(&_ISideChainManager.TransactOpts, chainID, feeToken)
+}
+
+// UpdateFeeToken is a paid mutator transaction binding the contract method 0xee1959e4.
+//
+// Solidity: function updateFeeToken(uint64 chainID, address feeToken) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) UpdateFeeToken(chainID uint64, feeToken common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.UpdateFeeToken(&_ISideChainManager.TransactOpts, chainID, feeToken)
+}
+
+// UpdateRootRetention is a paid mutator transaction binding the contract method 0xc7d1c15f.
+//
+// Solidity: function updateRootRetention(uint64 chainID, uint64 window) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) UpdateRootRetention(opts *bind.TransactOpts, chainID uint64, window uint64) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "updateRootRetention", chainID, window)
+}
+
+// UpdateRootRetention is a paid mutator transaction binding the contract method 0xc7d1c15f.
+//
+// Solidity: function updateRootRetention(uint64 chainID, uint64 window) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) UpdateRootRetention(chainID uint64, window uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.UpdateRootRetention(&_ISideChainManager.TransactOpts, chainID, window)
+}
+
+// UpdateRootRetention is a paid mutator transaction binding the contract method 0xc7d1c15f.
+//
+// Solidity: function updateRootRetention(uint64 chainID, uint64 window) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) UpdateRootRetention(chainID uint64, window uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.UpdateRootRetention(&_ISideChainManager.TransactOpts, chainID, window)
+}
+
 // UpdateSideChain is a paid mutator transaction binding the contract method 0x956f1463.
 //
 // Solidity: function updateSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) returns()
@@ -29,15 +29,21 @@ var (
 var (
 	MethodAddSignature = "addSignature"
 
+	MethodAddTypedSignature = "addTypedSignature"
+
+	MethodGetSignatures = "getSignatures"
+
 	EventAddSignatureQuorumEvent = "AddSignatureQuorumEvent"
 )
 
 // ISignatureManagerABI is the input ABI used to generate the binding from.
-const ISignatureManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"id\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"subject\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"sideChainID\",\"type\":\"uint256\"}],\"name\":\"AddSignatureQuorumEvent\",\"type\":\"event\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"sideChainID\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"subject\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"addSignature\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const ISignatureManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"id\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"subject\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"sideChainID\",\"type\":\"uint256\"}],\"name\":\"AddSignatureQuorumEvent\",\"type\":\"event\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"sideChainID\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"subject\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"addSignature\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"sideChainID\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"subjectType\",\"type\":\"uint8\"},{\"internalType\":\"bytes\",\"name\":\"subject\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"addTypedSignature\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"id\",\"type\":\"bytes\"}],\"name\":\"getSignatures\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"bundle\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 // ISignatureManagerFuncSigs maps the 4-byte function signature to its string representation.
 var ISignatureManagerFuncSigs = map[string]string{
 	"29d75da9": "addSignature(address,uint256,bytes,bytes)",
+	"86280044": "addTypedSignature(address,uint256,uint8,bytes,bytes)",
+	"6b45b4e3": "getSignatures(bytes)",
 }
 
 // ISignatureManager is an auto generated Go binding around an Ethereum contract.
@@ -182,6 +188,37 @@ func (_ISignatureManager *ISignatureManagerTransactorRaw) Transact(opts *bind.Tr
 	return _ISignatureManager.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetSignatures is a free data retrieval call binding the contract method 0x6b45b4e3.
+//
+// Solidity: function getSignatures(bytes id) view returns(bytes bundle)
+func (_ISignatureManager *ISignatureManagerCaller) GetSignatures(opts *bind.CallOpts, id []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ISignatureManager.contract.Call(opts, &out, "getSignatures", id)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetSignatures is a free data retrieval call binding the contract method 0x6b45b4e3.
+//
+// Solidity: function getSignatures(bytes id) view returns(bytes bundle)
+func (_ISignatureManager *ISignatureManagerSession) GetSignatures(id []byte) ([]byte, error) {
+	return _ISignatureManager.Contract.GetSignatures(&_ISignatureManager.CallOpts, id)
+}
+
+// GetSignatures is a free data retrieval call binding the contract method 0x6b45b4e3.
+//
+// Solidity: function getSignatures(bytes id) view returns(bytes bundle)
+func (_ISignatureManager *ISignatureManagerCallerSession) GetSignatures(id []byte) ([]byte, error) {
+	return _ISignatureManager.Contract.GetSignatures(&_ISignatureManager.CallOpts, id)
+}
+
 // AddSignature is a paid mutator transaction binding the contract method 0x29d75da9.
 //
 // Solidity: function addSignature(address addr, uint256 sideChainID, bytes subject, bytes signature) returns(bool)
@@ -203,6 +240,27 @@ func (_ISignatureManager *ISignatureManagerTransactorSession) AddSignature(addr
 	return _ISignatureManager.Contract.AddSignature(&_ISignatureManager.TransactOpts, addr, sideChainID, subject, signature)
 }
 
+// AddTypedSignature is a paid mutator transaction binding the contract method 0x86280044.
+//
+// Solidity: function addTypedSignature(address addr, uint256 sideChainID, uint8 subjectType, bytes subject, bytes signature) returns(bool success)
+func (_ISignatureManager *ISignatureManagerTransactor) AddTypedSignature(opts *bind.TransactOpts, addr common.Address, sideChainID *big.Int, subjectType uint8, subject []byte, signature []byte) (*types.Transaction, error) {
+	return _ISignatureManager.contract.Transact(opts, "addTypedSignature", addr, sideChainID, subjectType, subject, signature)
+}
+
+// AddTypedSignature is a paid mutator transaction binding the contract method 0x86280044.
+//
+// Solidity: function addTypedSignature(address addr, uint256 sideChainID, uint8 subjectType, bytes subject, bytes signature) returns(bool success)
+func (_ISignatureManager *ISignatureManagerSession) AddTypedSignature(addr common.Address, sideChainID *big.Int, subjectType uint8, subject []byte, signature []byte) (*types.Transaction, error) {
+	return _ISignatureManager.Contract.AddTypedSignature(&_ISignatureManager.TransactOpts, addr, sideChainID, subjectType, subject, signature)
+}
+
+// AddTypedSignature is a paid mutator transaction binding the contract method 0x86280044.
+//
+// Solidity: function addTypedSignature(address addr, uint256 sideChainID, uint8 subjectType, bytes subject, bytes signature) returns(bool success)
+func (_ISignatureManager *ISignatureManagerTransactorSession) AddTypedSignature(addr common.Address, sideChainID *big.Int, subjectType uint8, subject []byte, signature []byte) (*types.Transaction, error) {
+	return _ISignatureManager.Contract.AddTypedSignature(&_ISignatureManager.TransactOpts, addr, sideChainID, subjectType, subject, signature)
+}
+
 // ISignatureManagerAddSignatureQuorumEventIterator is returned from FilterAddSignatureQuorumEvent and is used to iterate over the raw logs and unpacked data for AddSignatureQuorumEvent events raised by the ISignatureManager contract.
 type ISignatureManagerAddSignatureQuorumEventIterator struct {
 	Event *ISignatureManagerAddSignatureQuorumEvent // Event containing the contract specifics and raw log
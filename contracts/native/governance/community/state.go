@@ -43,6 +43,9 @@ var (
 type CommunityInfo struct {
 	CommunityRate    *big.Int
 	CommunityAddress common.Address
+	// BaseFeeRate is the share of transaction base fee sent to community pool, and
+	// the rest is burned. The whole gas fee is rewarded to validators if it's nil.
+	BaseFeeRate *big.Int `rlp:"optional"`
 }
 
 func StoreCommunityInfo(s *state.StateDB, communityRate *big.Int, communityAddress common.Address) (*CommunityInfo, error) {
@@ -56,11 +59,19 @@ func StoreCommunityInfo(s *state.StateDB, communityRate *big.Int, communityAddre
 	}
 	return communityInfo, nil
 }
+
+// StoreGenesisCommunityInfo stores the community info with fee policy in genesis block.
+func StoreGenesisCommunityInfo(s *state.StateDB, communityInfo *CommunityInfo) error {
+	return setGenesisCommunityInfo((*state.CacheDB)(s), communityInfo)
+}
  
  func setGenesisCommunityInfo(s *state.CacheDB, communityInfo *CommunityInfo) error {
 	 if communityInfo.CommunityRate.Cmp(PercentDecimal) > 0 {
 		 return fmt.Errorf("setGenesisCommunityInfo, CommunityRate over size")
 	 }
+	 if !validBaseFeeRate(communityInfo.BaseFeeRate) {
+		 return fmt.Errorf("setGenesisCommunityInfo, invalid BaseFeeRate")
+	 }
 	 key := communityInfoKey()
 	 store, err := rlp.EncodeToBytes(communityInfo)
 	 if err != nil {
@@ -74,6 +85,9 @@ func StoreCommunityInfo(s *state.StateDB, communityRate *big.Int, communityAddre
 	 if communityInfo.CommunityRate.Cmp(PercentDecimal) > 0 {
 		 return fmt.Errorf("setCommunityInfo, CommunityRate over size")
 	 }
+	 if !validBaseFeeRate(communityInfo.BaseFeeRate) {
+		 return fmt.Errorf("setCommunityInfo, invalid BaseFeeRate")
+	 }
 	 key := communityInfoKey()
 	 store, err := rlp.EncodeToBytes(communityInfo)
 	 if err != nil {
@@ -110,6 +124,11 @@ func StoreCommunityInfo(s *state.StateDB, communityRate *big.Int, communityAddre
 	 return communityInfo, nil
  }
  
+// validBaseFeeRate returns true if the rate is unset or between 0 and 100 percent.
+func validBaseFeeRate(rate *big.Int) bool {
+	return rate == nil || (rate.Sign() >= 0 && rate.Cmp(PercentDecimal) <= 0)
+}
+
  // ====================================================================
  //
  // storage basic operations
@@ -49,6 +49,7 @@ type CreateValidatorParam struct {
 	ProposalAddress  common.Address
 	Commission       *big.Int
 	Desc             string
+	Enode            []byte
 }
 
 func (m *CreateValidatorParam) Encode() ([]byte, error) {
@@ -60,6 +61,7 @@ type UpdateValidatorParam struct {
 	SignerAddress    common.Address
 	ProposalAddress  common.Address
 	Desc             string
+	Enode            []byte
 }
 
 func (m *UpdateValidatorParam) Encode() ([]byte, error) {
@@ -168,6 +170,14 @@ func (m *GetEpochInfoParam) Encode() ([]byte, error) {
 	return utils.PackMethodWithStruct(ABI, MethodGetEpochInfo, m)
 }
 
+type GetConsensusSignsParam struct {
+	EpochID *big.Int
+}
+
+func (m *GetConsensusSignsParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodGetConsensusSigns, m)
+}
+
 type GetAllValidatorsParam struct{}
 
 func (m *GetAllValidatorsParam) Encode() ([]byte, error) {
@@ -25,7 +25,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	"github.com/ethereum/go-ethereum/contracts/native/economic"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
 )
 
 const (
@@ -103,7 +108,7 @@ func withdrawStakeRewards(s *native.NativeContract, validator *Validator, stakeI
 		return utils.Dec{}, fmt.Errorf("withdrawStakeRewards, CalculateStakeRewards error: %v", err)
 	}
 
-	err = contract.NativeTransfer(s.StateDB(), this, stakeInfo.StakeAddress, rewards.BigInt())
+	err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, stakeInfo.StakeAddress, rewards.BigInt())
 	if err != nil {
 		return utils.Dec{}, fmt.Errorf("withdrawStakeRewards, nativeTransfer error: %v", err)
 	}
@@ -240,7 +245,7 @@ func withdrawCommission(s *native.NativeContract, stakeAddress common.Address, c
 		return utils.Dec{}, fmt.Errorf("withdrawCommission, setValidatorOutstandingRewards error: %v", err)
 	}
 
-	err = contract.NativeTransfer(s.StateDB(), this, stakeAddress, accumulatedCommission.Amount.BigInt())
+	err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, stakeAddress, accumulatedCommission.Amount.BigInt())
 	if err != nil {
 		return utils.Dec{}, fmt.Errorf("withdrawCommission, nativeTransfer commission error: %v", err)
 	}
@@ -301,3 +306,36 @@ func allocateRewardsToValidator(s *native.NativeContract, validator *Validator,
 	}
 	return nil
 }
+
+// DistributeGasFee implements the fee policy of community info: the share of base
+// fee is sent to community pool and the rest is burned, the priority fee is kept
+// for the block proposer and allocated to it in EndBlock. The whole gas fee is
+// deposited to be shared by the epoch validators if the policy is not set.
+func DistributeGasFee(db vm.StateDB, proposer common.Address, baseFee, tip *big.Int) {
+	if baseFee.Sign() == 0 && tip.Sign() == 0 {
+		return
+	}
+	var info *community.CommunityInfo
+	sdb, ok := db.(*state.StateDB)
+	if ok {
+		info, _ = community.GetCommunityInfoFromDB(sdb)
+	}
+	if info == nil || info.BaseFeeRate == nil {
+		db.AddBalance(this, new(big.Int).Add(baseFee, tip))
+		return
+	}
+
+	communityFee := new(big.Int).Div(new(big.Int).Mul(baseFee, info.BaseFeeRate), community.PercentDecimal)
+	db.AddBalance(info.CommunityAddress, communityFee)
+	burned := new(big.Int).Sub(baseFee, communityFee)
+	if err := economic.AddBurnedSupply((*state.CacheDB)(sdb), burned); err != nil {
+		log.Warn("DistributeGasFee, failed to record burned supply", "amount", burned, "err", err)
+	}
+	if tip.Sign() > 0 {
+		db.AddBalance(this, tip)
+		// the tip is shared by epoch validators if it's failed to be recorded
+		if err := addProposerTips((*state.CacheDB)(sdb), proposer, tip); err != nil {
+			log.Warn("DistributeGasFee, failed to record proposer tips", "proposer", proposer, "err", err)
+		}
+	}
+}
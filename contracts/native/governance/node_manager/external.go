@@ -19,13 +19,19 @@
 package node_manager
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	"github.com/ethereum/go-ethereum/contracts/native/economic"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -39,15 +45,16 @@ var (
 	GenesisVoterValidatorNum      uint64 = 4
 
 	// const
-	MaxDescLength    int       = 2000
-	MaxValidatorNum  int       = 300
-	MaxUnlockingNum  int       = 100
-	MaxStakeRate     utils.Dec = utils.NewDecFromBigInt(new(big.Int).SetUint64(6)) // user stake can not more than 5 times of self stake
-	MinBlockPerEpoch           = new(big.Int).SetUint64(10000)
+	MaxDescLength   int       = 2000
+	MaxValidatorNum int       = 300
+	MaxUnlockingNum int       = 100
+	MaxStakeRate    utils.Dec = utils.NewDecFromBigInt(new(big.Int).SetUint64(6)) // user stake can not more than 5 times of self stake
+	SignExpiration            = new(big.Int).SetUint64(50000)                     // blocks before a consensus sign collection expired
 )
 
 func init() {
 	core.RegGenesis = SetupGenesis
+	core.DistributeGasFee = DistributeGasFee
 }
 
 // store data in genesis block
@@ -55,33 +62,91 @@ func SetupGenesis(db *state.StateDB, genesis *core.Genesis) error {
 	data := genesis.Governance
 	peers := make([]common.Address, 0, len(data))
 	signers := make([]common.Address, 0, len(data))
+	proposers := make([]common.Address, 0, len(data))
 	for _, v := range data {
 		peers = append(peers, v.Validator)
 		signers = append(signers, v.Signer)
+		proposers = append(proposers, genesisProposalAddress(v))
 	}
-	if _, err := community.StoreCommunityInfo(db, genesis.CommunityRate, genesis.CommunityAddress); err != nil {
+	communityInfo := &community.CommunityInfo{
+		CommunityRate:    genesis.CommunityRate,
+		CommunityAddress: genesis.CommunityAddress,
+		BaseFeeRate:      genesis.CommunityBaseFeeRate,
+	}
+	// the community funds are held by treasury if it's enabled and the address is not specified
+	if genesis.Treasury && communityInfo.CommunityAddress == common.EmptyAddress {
+		communityInfo.CommunityAddress = utils.TreasuryContractAddress
+	}
+	if err := community.StoreGenesisCommunityInfo(db, communityInfo); err != nil {
+		return err
+	}
+	globalConfig, err := genesisGlobalConfig(genesis.NodeManager)
+	if err != nil {
+		return err
+	}
+	if err := checkGenesisExtra(genesis, globalConfig.BlockPerEpoch); err != nil {
 		return err
 	}
-	if _, err := StoreGenesisEpoch(db, peers, signers); err != nil {
+	if _, err := storeGenesisEpoch(db, peers, signers, proposers, globalConfig.BlockPerEpoch); err != nil {
 		return err
 	}
-	if err := StoreGenesisGlobalConfig(db); err != nil {
+	if err := setGenesisGlobalConfig((*state.CacheDB)(db), globalConfig); err != nil {
 		return err
 	}
+	// the economic contract falls back to the default config if it's not stored
+	if genesis.Economic != nil {
+		if _, err := economic.StoreGenesisEconomicConfig(db, genesisEconomicConfig(genesis.Economic)); err != nil {
+			return err
+		}
+	}
+	for _, v := range genesis.Vestings {
+		if err := vesting.StoreGenesisVesting(db, genesisVestingAccount(v)); err != nil {
+			return err
+		}
+	}
+	for _, v := range data {
+		if v.SelfStake == nil {
+			continue
+		}
+		if err := StoreGenesisValidator(db, v); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// checkGenesisExtra ensures the genesis epoch in hotstuff extra ends at the same height as the
+// genesis epoch info, which is the first epoch verified by the light and checkpoint sync.
+func checkGenesisExtra(genesis *core.Genesis, blockPerEpoch *big.Int) error {
+	if genesis.Config == nil || genesis.Config.HotStuff == nil {
+		return nil
+	}
+	extra, err := types.ExtractHotstuffExtraPayload(genesis.ExtraData)
+	if err != nil {
+		return err
+	}
+	if extra.StartHeight != 0 || extra.EndHeight != blockPerEpoch.Uint64() {
+		return fmt.Errorf("checkGenesisExtra, genesis extra epoch [%d, %d) mismatch with block per epoch %s",
+			extra.StartHeight, extra.EndHeight, blockPerEpoch)
+	}
+	return nil
+}
+
 func StoreGenesisEpoch(s *state.StateDB, peers []common.Address, signers []common.Address) (*EpochInfo, error) {
+	return storeGenesisEpoch(s, peers, signers, signers, GenesisBlockPerEpoch)
+}
+
+func storeGenesisEpoch(s *state.StateDB, peers, signers, proposers []common.Address, blockPerEpoch *big.Int) (*EpochInfo, error) {
 	cache := (*state.CacheDB)(s)
 	epoch := &EpochInfo{
 		ID:          StartEpochID,
 		Validators:  peers,
 		Signers:     signers,
 		Voters:      signers,
-		Proposers:   signers,
+		Proposers:   proposers,
 		StartHeight: new(big.Int),
-		EndHeight:   GenesisBlockPerEpoch,
+		EndHeight:   blockPerEpoch,
 	}
 
 	// store current epoch and epoch info
@@ -93,6 +158,21 @@ func StoreGenesisEpoch(s *state.StateDB, peers []common.Address, signers []commo
 
 func StoreGenesisGlobalConfig(s *state.StateDB) error {
 	cache := (*state.CacheDB)(s)
+	globalConfig, err := genesisGlobalConfig(nil)
+	if err != nil {
+		return err
+	}
+
+	// store current epoch and epoch info
+	if err := setGenesisGlobalConfig(cache, globalConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// genesisGlobalConfig returns the global config specified in genesis, the unset
+// fields take the genesis defaults.
+func genesisGlobalConfig(config *core.NodeManagerConfig) (*GlobalConfig, error) {
 	globalConfig := &GlobalConfig{
 		MaxCommissionChange:   GenesisMaxCommissionChange,
 		MinInitialStake:       GenesisMinInitialStake,
@@ -101,10 +181,200 @@ func StoreGenesisGlobalConfig(s *state.StateDB) error {
 		ConsensusValidatorNum: GenesisConsensusValidatorNum,
 		VoterValidatorNum:     GenesisVoterValidatorNum,
 	}
+	if config == nil {
+		return globalConfig, nil
+	}
+	if config.MaxCommissionChange != nil {
+		globalConfig.MaxCommissionChange = config.MaxCommissionChange
+	}
+	if config.MinInitialStake != nil {
+		globalConfig.MinInitialStake = config.MinInitialStake
+	}
+	if config.MinProposalStake != nil {
+		globalConfig.MinProposalStake = config.MinProposalStake
+	}
+	if config.BlockPerEpoch != nil {
+		globalConfig.BlockPerEpoch = config.BlockPerEpoch
+	}
+	if config.ConsensusValidatorNum != 0 {
+		globalConfig.ConsensusValidatorNum = config.ConsensusValidatorNum
+	}
+	if config.VoterValidatorNum != 0 {
+		globalConfig.VoterValidatorNum = config.VoterValidatorNum
+	}
 
-	// store current epoch and epoch info
-	if err := setGenesisGlobalConfig(cache, globalConfig); err != nil {
-		return err
+	if globalConfig.MaxCommissionChange.Sign() < 0 || globalConfig.MaxCommissionChange.Cmp(new(big.Int).SetUint64(10000)) > 0 {
+		return nil, fmt.Errorf("genesisGlobalConfig, invalid max commission change %s", globalConfig.MaxCommissionChange.String())
+	}
+	if globalConfig.MinInitialStake.Sign() <= 0 {
+		return nil, fmt.Errorf("genesisGlobalConfig, min initial stake must be positive")
+	}
+	if globalConfig.MinProposalStake.Sign() < 0 {
+		return nil, fmt.Errorf("genesisGlobalConfig, min proposal stake can not be negative")
+	}
+	if globalConfig.BlockPerEpoch.Sign() <= 0 {
+		return nil, fmt.Errorf("genesisGlobalConfig, block per epoch must be positive")
+	}
+	if globalConfig.ConsensusValidatorNum > uint64(MaxValidatorNum) {
+		return nil, fmt.Errorf("genesisGlobalConfig, consensus validator num is more than %d", MaxValidatorNum)
+	}
+	if globalConfig.VoterValidatorNum > globalConfig.ConsensusValidatorNum {
+		return nil, fmt.Errorf("genesisGlobalConfig, voter validator num is more than consensus validator num")
+	}
+	return globalConfig, nil
+}
+
+// genesisEconomicConfig converts the economic section of genesis spec, nil is returned
+// to take all the defaults.
+func genesisEconomicConfig(config *core.EconomicConfig) *economic.EconomicConfig {
+	if config == nil {
+		return nil
+	}
+	return &economic.EconomicConfig{
+		GenesisSupply:     config.GenesisSupply,
+		RewardPerBlock:    config.RewardPerBlock,
+		DecayInterval:     config.DecayInterval,
+		DecayRate:         config.DecayRate,
+		TargetStakingRate: config.TargetStakingRate,
+		AdjustRate:        config.AdjustRate,
+		MinRewardPerBlock: config.MinRewardPerBlock,
+		MaxRewardPerBlock: config.MaxRewardPerBlock,
+	}
+}
+
+// genesisVestingAccount converts the vesting in genesis spec, the cliff height is the
+// start height if it's not specified.
+func genesisVestingAccount(v core.GenesisVesting) *vesting.VestingAccount {
+	cliffHeight := v.CliffHeight
+	if cliffHeight < v.StartHeight {
+		cliffHeight = v.StartHeight
+	}
+	return &vesting.VestingAccount{
+		Address:         v.Address,
+		OriginalVesting: v.Amount,
+		StartHeight:     new(big.Int).SetUint64(v.StartHeight),
+		CliffHeight:     new(big.Int).SetUint64(cliffHeight),
+		EndHeight:       new(big.Int).SetUint64(v.EndHeight),
+	}
+}
+
+// StoreGenesisValidator registers the genesis validator with its self stake and
+// delegations, the stakes are transferred from the balances in genesis alloc to
+// node manager contract. The validator is locked as it's in the first epoch.
+func StoreGenesisValidator(db *state.StateDB, account core.GovernanceAccount) error {
+	s := native.NewNativeContract(db, native.NewContractRef(db, account.StakeAddress, account.StakeAddress,
+		new(big.Int), common.Hash{}, 0, nil))
+	this := utils.NodeManagerContractAddress
+
+	if account.StakeAddress == common.EmptyAddress {
+		return fmt.Errorf("StoreGenesisValidator, invalid stake address of validator %s", account.Validator.Hex())
+	}
+	commission := new(big.Int)
+	if account.Commission != nil {
+		commission = account.Commission
+	}
+	if commission.Sign() == -1 || commission.Cmp(new(big.Int).SetUint64(10000)) == 1 {
+		return fmt.Errorf("StoreGenesisValidator, invalid commission %s of validator %s", commission.String(), account.Validator.Hex())
+	}
+	if len(account.Desc) > MaxDescLength {
+		return fmt.Errorf("StoreGenesisValidator, desc length more than limit %d", MaxDescLength)
+	}
+	globalConfig, err := GetGlobalConfigImpl(s)
+	if err != nil {
+		return fmt.Errorf("StoreGenesisValidator, GetGlobalConfig error: %v", err)
+	}
+	if globalConfig.MinInitialStake.Cmp(account.SelfStake) == 1 {
+		return fmt.Errorf("StoreGenesisValidator, self stake %s of validator %s is less than min initial stake %s",
+			account.SelfStake.String(), account.Validator.Hex(), globalConfig.MinInitialStake.String())
+	}
+	_, found, err := getValidator(s, account.Validator)
+	if err != nil {
+		return fmt.Errorf("StoreGenesisValidator, getValidator error: %v", err)
+	}
+	if found {
+		return fmt.Errorf("StoreGenesisValidator, validator %s already exist", account.Validator.Hex())
+	}
+
+	selfStake := utils.NewDecFromBigInt(account.SelfStake)
+	validator := &Validator{
+		StakeAddress:     account.StakeAddress,
+		ConsensusAddress: account.Validator,
+		SignerAddress:    account.Signer,
+		ProposalAddress:  genesisProposalAddress(account),
+		Commission:       &Commission{Rate: utils.NewDecFromBigInt(commission), UpdateHeight: new(big.Int)},
+		Status:           Lock,
+		Jailed:           false,
+		UnlockHeight:     new(big.Int),
+		TotalStake:       selfStake,
+		SelfStake:        selfStake,
+		Desc:             account.Desc,
+	}
+	if err := setValidator(s, validator); err != nil {
+		return fmt.Errorf("StoreGenesisValidator, setValidator error: %v", err)
+	}
+	if err := setSignerAddr(s, validator.SignerAddress); err != nil {
+		return fmt.Errorf("StoreGenesisValidator, setSignerAddr error: %v", err)
+	}
+	if err := setProposalAddr(s, validator.ProposalAddress); err != nil {
+		return fmt.Errorf("StoreGenesisValidator, setProposalAddr error: %v", err)
+	}
+	if err := addToAllValidators(s, validator.ConsensusAddress); err != nil {
+		return fmt.Errorf("StoreGenesisValidator, addToAllValidators error: %v", err)
+	}
+	if err := AfterValidatorCreated(s, validator); err != nil {
+		return fmt.Errorf("StoreGenesisValidator, distribute.AfterValidatorCreated error: %v", err)
+	}
+
+	// lock the self stake
+	if err := contract.NativeTransfer(db, s.ContractRef().BlockHeight(), validator.StakeAddress, this, account.SelfStake); err != nil {
+		return fmt.Errorf("StoreGenesisValidator, lock self stake of validator %s error: %v", account.Validator.Hex(), err)
+	}
+	if err := deposit(s, validator.StakeAddress, selfStake, validator); err != nil {
+		return fmt.Errorf("StoreGenesisValidator, deposit error: %v", err)
+	}
+
+	// lock the delegations
+	maxTotalStake, err := validator.SelfStake.Mul(MaxStakeRate)
+	if err != nil {
+		return fmt.Errorf("StoreGenesisValidator, validator.SelfStake.Mul error: %v", err)
+	}
+	for _, d := range account.Delegations {
+		if d.Amount == nil || d.Amount.Sign() <= 0 {
+			return fmt.Errorf("StoreGenesisValidator, invalid delegation of %s to validator %s", d.Delegator.Hex(), account.Validator.Hex())
+		}
+		amount := utils.NewDecFromBigInt(d.Amount)
+		if err := contract.NativeTransfer(db, s.ContractRef().BlockHeight(), d.Delegator, this, d.Amount); err != nil {
+			return fmt.Errorf("StoreGenesisValidator, lock delegation of %s error: %v", d.Delegator.Hex(), err)
+		}
+		if err := deposit(s, d.Delegator, amount, validator); err != nil {
+			return fmt.Errorf("StoreGenesisValidator, deposit error: %v", err)
+		}
+		if d.Delegator == validator.StakeAddress {
+			if validator.SelfStake, err = validator.SelfStake.Add(amount); err != nil {
+				return fmt.Errorf("StoreGenesisValidator, validator.SelfStake.Add error: %v", err)
+			}
+			if maxTotalStake, err = validator.SelfStake.Mul(MaxStakeRate); err != nil {
+				return fmt.Errorf("StoreGenesisValidator, validator.SelfStake.Mul error: %v", err)
+			}
+		}
+		if validator.TotalStake, err = validator.TotalStake.Add(amount); err != nil {
+			return fmt.Errorf("StoreGenesisValidator, validator.TotalStake.Add error: %v", err)
+		}
+	}
+	if validator.TotalStake.GT(maxTotalStake) {
+		return fmt.Errorf("StoreGenesisValidator, stake of validator %s is more than max stake", account.Validator.Hex())
+	}
+	if err := setValidator(s, validator); err != nil {
+		return fmt.Errorf("StoreGenesisValidator, setValidator error: %v", err)
 	}
 	return nil
 }
+
+// genesisProposalAddress returns the proposal address of genesis validator, which
+// defaults to the signer address.
+func genesisProposalAddress(account core.GovernanceAccount) common.Address {
+	if account.ProposalAddress != common.EmptyAddress {
+		return account.ProposalAddress
+	}
+	return account.Signer
+}
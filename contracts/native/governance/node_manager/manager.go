@@ -30,6 +30,7 @@ import (
 	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/node_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -67,6 +68,7 @@ var (
 		MethodGetCommunityInfo:               81375,
 		MethodGetCurrentEpochInfo:            112875,
 		MethodGetEpochInfo:                   86625,
+		MethodGetConsensusSigns:              86625,
 		MethodGetAllValidators:               170625,
 		MethodGetValidator:                   60375,
 		MethodGetStakeInfo:                   76125,
@@ -108,6 +110,7 @@ func RegisterNodeManagerContract(s *native.NativeContract) {
 	s.Register(MethodGetCommunityInfo, GetCommunityInfo)
 	s.Register(MethodGetCurrentEpochInfo, GetCurrentEpochInfo)
 	s.Register(MethodGetEpochInfo, GetEpochInfo)
+	s.Register(MethodGetConsensusSigns, GetConsensusSigns)
 	s.Register(MethodGetAllValidators, GetAllValidators)
 	s.Register(MethodGetValidator, GetValidator)
 	s.Register(MethodGetStakeInfo, GetStakeInfo)
@@ -169,6 +172,13 @@ func CreateValidator(s *native.NativeContract) ([]byte, error) {
 		return nil, fmt.Errorf("CreateValidator, desc length more than limit %d", MaxDescLength)
 	}
 
+	// check enode, it could be published later by UpdateValidator
+	if len(params.Enode) > 0 {
+		if _, err := checkEnode(params.Enode, params.ConsensusAddress); err != nil {
+			return nil, fmt.Errorf("CreateValidator, %v", err)
+		}
+	}
+
 	// check to see if the pubkey has been registered before
 	_, found, err := getValidator(s, params.ConsensusAddress)
 	if err != nil {
@@ -197,6 +207,7 @@ func CreateValidator(s *native.NativeContract) ([]byte, error) {
 		TotalStake:       utils.NewDecFromBigInt(initStake),
 		SelfStake:        utils.NewDecFromBigInt(initStake),
 		Desc:             params.Desc,
+		Enode:            params.Enode,
 	}
 	err = setValidator(s, validator)
 	if err != nil {
@@ -279,6 +290,12 @@ func UpdateValidator(s *native.NativeContract) ([]byte, error) {
 		}
 		validator.Desc = params.Desc
 	}
+	if len(params.Enode) > 0 {
+		if _, err := checkEnode(params.Enode, validator.ConsensusAddress); err != nil {
+			return nil, fmt.Errorf("UpdateValidator, %v", err)
+		}
+		validator.Enode = params.Enode
+	}
 
 	err = setValidator(s, validator)
 	if err != nil {
@@ -490,10 +507,14 @@ func Withdraw(s *native.NativeContract) ([]byte, error) {
 		if err != nil {
 			return nil, fmt.Errorf("Withdraw, withdrawTotalPool error: %v", err)
 		}
-		err = contract.NativeTransfer(s.StateDB(), this, caller, amount.BigInt())
+		err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, caller, amount.BigInt())
 		if err != nil {
 			return nil, fmt.Errorf("Withdraw, nativeTransfer error: %v", err)
 		}
+		err = vesting.TrackUndelegation(s, caller, amount.BigInt())
+		if err != nil {
+			return nil, fmt.Errorf("Withdraw, vesting.TrackUndelegation error: %v", err)
+		}
 	} else {
 		return nil, fmt.Errorf("Withdraw, no asset to withdraw")
 	}
@@ -720,6 +741,20 @@ func ChangeEpoch(s *native.NativeContract) ([]byte, error) {
 		}
 	}
 
+	// signatures collected in the last epoch are not valid for the new signer set
+	if err := clearSigns(s, currentEpochInfo.ID); err != nil {
+		return nil, fmt.Errorf("ChangeEpoch, clearSigns error: %v", err)
+	}
+
+	// adjust inflation by the staking rate of the ending epoch
+	totalPool, err := getTotalPool(s)
+	if err != nil {
+		return nil, fmt.Errorf("ChangeEpoch, getTotalPool error: %v", err)
+	}
+	if err := economic.AdjustInflation(s, totalPool.TotalPool.BigInt()); err != nil {
+		return nil, fmt.Errorf("ChangeEpoch, economic.AdjustInflation error: %v", err)
+	}
+
 	// update epoch info
 	err = setCurrentEpochInfo(s, epochInfo)
 	if err != nil {
@@ -845,11 +880,37 @@ func EndBlock(s *native.NativeContract) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("EndBlock, GetCurrentEpochInfoImpl error: %v", err)
 	}
+
+	// priority fees of the block are rewarded to the proposer
+	allocateSum := utils.NewDecFromBigInt(new(big.Int))
+	tips, err := getProposerTips(s.GetCacheDB())
+	if err != nil {
+		return nil, fmt.Errorf("EndBlock, getProposerTips error: %v", err)
+	}
+	if tips != nil {
+		delProposerTips(s.GetCacheDB())
+		proposer, found, err := getValidator(s, tips.Proposer)
+		if err != nil {
+			return nil, fmt.Errorf("EndBlock, getValidator error: %v", err)
+		}
+		if found {
+			proposerRewards := utils.NewDecFromBigInt(tips.Amount)
+			if err = allocateRewardsToValidator(s, proposer, proposerRewards); err != nil {
+				return nil, fmt.Errorf("EndBlock, allocateRewardsToValidator error: %v", err)
+			}
+			if allocateSum, err = allocateSum.Add(proposerRewards); err != nil {
+				return nil, fmt.Errorf("EndBlock, allocateSum.Add error: %v", err)
+			}
+			if newRewards, err = newRewards.Sub(proposerRewards); err != nil {
+				return nil, fmt.Errorf("EndBlock, newRewards.Sub error: %v", err)
+			}
+		}
+	}
+
 	validatorRewards, err := newRewards.DivUint64(uint64(len(epochInfo.Validators)))
 	if err != nil {
 		return nil, fmt.Errorf("EndBlock, newRewards.DivUint64 error: %v", err)
 	}
-	allocateSum := utils.NewDecFromBigInt(new(big.Int))
 	for _, v := range epochInfo.Validators {
 		validator, found, err := getValidator(s, v)
 		if err != nil {
@@ -937,6 +998,51 @@ func GetEpochInfo(s *native.NativeContract) ([]byte, error) {
 	return utils.PackOutputs(ABI, MethodGetEpochInfo, enc)
 }
 
+// GetConsensusSigns returns the sign collections of the epoch which have not reached quorum and not expired yet.
+func GetConsensusSigns(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	height := s.ContractRef().BlockHeight()
+
+	params := &GetConsensusSignsParam{}
+	if err := utils.UnpackMethod(ABI, MethodGetConsensusSigns, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetConsensusSigns, unpack params error: %v", err)
+	}
+
+	hashes, err := getSignHashes(s, params.EpochID)
+	if err != nil {
+		return nil, fmt.Errorf("GetConsensusSigns, getSignHashes error: %v", err)
+	}
+	pendingSigns := &PendingSigns{List: make([]*PendingSign, 0, len(hashes))}
+	for _, hash := range hashes {
+		sign, err := getSign(s, hash)
+		if err != nil {
+			return nil, fmt.Errorf("GetConsensusSigns, getSign error: %v, hash %s", err, hash.Hex())
+		}
+		signers, err := getSigners(s, hash)
+		if err != nil && err.Error() != ErrEof.Error() {
+			return nil, fmt.Errorf("GetConsensusSigns, getSigners error: %v, hash %s", err, hash.Hex())
+		}
+		if sign.Expired(height) || uint64(len(signers)) >= sign.Quorum {
+			continue
+		}
+		pendingSigns.List = append(pendingSigns.List, &PendingSign{
+			Hash:     hash,
+			EpochID:  sign.EpochID,
+			Nonce:    sign.Nonce,
+			Method:   sign.Method,
+			Input:    sign.Input,
+			Quorum:   sign.Quorum,
+			Deadline: sign.Deadline,
+			Signers:  signers,
+		})
+	}
+	enc, err := rlp.EncodeToBytes(pendingSigns)
+	if err != nil {
+		return nil, fmt.Errorf("GetConsensusSigns, serialize pending signs error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodGetConsensusSigns, enc)
+}
+
 func GetAllValidators(s *native.NativeContract) ([]byte, error) {
 	allValidators, err := getAllValidators(s)
 	if err != nil {
@@ -22,18 +22,26 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"net"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	"github.com/ethereum/go-ethereum/contracts/native/economic"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/node_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -167,7 +175,7 @@ func TestStake(t *testing.T) {
 		contractRef := native.NewContractRef(sdb, caller, caller, blockNumber, common.Hash{}, extra, nil)
 		contractRef.SetValue(value)
 		contractRef.SetTo(utils.NodeManagerContractAddress)
-		err = contract.NativeTransfer(contractRef.StateDB(), caller, this, value)
+		err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), caller, this, value)
 		assert.Nil(t, err)
 		_, _, err = contractRef.NativeCall(caller, utils.NodeManagerContractAddress, input)
 		assert.Nil(t, err)
@@ -197,7 +205,7 @@ func TestStake(t *testing.T) {
 	contractRef := native.NewContractRef(sdb, stakeAddress, stakeAddress, blockNumber, common.Hash{}, extra, nil)
 	contractRef.SetValue(vaule)
 	contractRef.SetTo(utils.NodeManagerContractAddress)
-	err = contract.NativeTransfer(contractRef.StateDB(), stakeAddress, this, vaule)
+	err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), stakeAddress, this, vaule)
 	assert.Nil(t, err)
 	_, _, err = contractRef.NativeCall(stakeAddress, utils.NodeManagerContractAddress, input)
 	assert.Nil(t, err)
@@ -465,7 +473,7 @@ func TestChangeEpoch(t *testing.T) {
 		contractRef := native.NewContractRef(sdb, caller, caller, blockNumber, common.Hash{}, extra, nil)
 		contractRef.SetValue(value)
 		contractRef.SetTo(utils.NodeManagerContractAddress)
-		err = contract.NativeTransfer(contractRef.StateDB(), caller, this, value)
+		err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), caller, this, value)
 		assert.Nil(t, err)
 		_, _, err = contractRef.NativeCall(caller, utils.NodeManagerContractAddress, input)
 		assert.Nil(t, err)
@@ -497,7 +505,7 @@ func TestChangeEpoch(t *testing.T) {
 	contractRef = native.NewContractRef(sdb, stakeAddress, stakeAddress, blockNumber, common.Hash{}, extra, nil)
 	contractRef.SetValue(value)
 	contractRef.SetTo(utils.NodeManagerContractAddress)
-	err = contract.NativeTransfer(contractRef.StateDB(), stakeAddress, this, value)
+	err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), stakeAddress, this, value)
 	assert.Nil(t, err)
 	_, _, err = contractRef.NativeCall(stakeAddress, utils.NodeManagerContractAddress, input)
 	assert.Nil(t, err)
@@ -516,6 +524,108 @@ func TestChangeEpoch(t *testing.T) {
 	fmt.Println(epochInfo.Validators)
 }
 
+func TestConsensusSigns(t *testing.T) {
+	Init()
+	extra := uint64(21000000000000)
+	input := []byte("test consensus sign")
+
+	checkSignAs := func(signer common.Address, height uint64, signerName SignerName) (bool, error) {
+		contractRef := native.NewContractRef(sdb, signer, signer, new(big.Int).SetUint64(height), common.Hash{}, extra, nil)
+		contractRef.PushContext(&native.Context{Caller: signer, ContractAddress: utils.NodeManagerContractAddress})
+		return CheckConsensusSigns(native.NewNativeContract(sdb, contractRef), string(signerName), input, signer, signerName)
+	}
+	checkSign := func(signer common.Address, height uint64) (bool, error) {
+		return checkSignAs(signer, height, Signer)
+	}
+	pendingSignsOf := func(epochID *big.Int, height uint64) *PendingSigns {
+		param := &GetConsensusSignsParam{EpochID: epochID}
+		payload, err := param.Encode()
+		assert.Nil(t, err)
+		contractRef := native.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, new(big.Int).SetUint64(height), common.Hash{}, extra, nil)
+		ret, _, err := contractRef.NativeCall(common.EmptyAddress, utils.NodeManagerContractAddress, payload)
+		assert.Nil(t, err)
+		signs := new(PendingSigns)
+		assert.Nil(t, signs.Decode(ret))
+		return signs
+	}
+	pendingSigns := func(height uint64) *PendingSigns {
+		return pendingSignsOf(StartEpochID, height)
+	}
+
+	// collect signatures in the genesis epoch
+	height := uint64(10)
+	for i := 0; i < 2; i++ {
+		ok, err := checkSign(testGenesisPeers[i], height)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	}
+	_, err := checkSign(testGenesisPeers[0], height)
+	assert.NotNil(t, err)
+
+	signs := pendingSigns(height)
+	assert.Equal(t, 1, len(signs.List))
+	assert.Equal(t, testGenesisPeers[:2], signs.List[0].Signers)
+	assert.Equal(t, uint64(3), signs.List[0].Quorum)
+	assert.Equal(t, new(big.Int).Add(big.NewInt(10), SignExpiration), signs.List[0].Deadline)
+
+	// the collection is reset after deadline
+	height = height + SignExpiration.Uint64() + 1
+	assert.Equal(t, 0, len(pendingSigns(height).List))
+	for i := 2; i >= 0; i-- {
+		ok, err := checkSign(testGenesisPeers[i], height)
+		assert.Nil(t, err)
+		assert.Equal(t, i == 0, ok)
+	}
+	assert.Equal(t, 0, len(pendingSigns(height).List))
+	ok, err := checkSign(testGenesisPeers[3], height)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 0, len(pendingSigns(height).List))
+
+	// votes reach quorum in the genesis epoch
+	for i := 0; i < 3; i++ {
+		ok, err := checkSignAs(testGenesisPeers[i], height, Voter)
+		assert.Nil(t, err)
+		assert.Equal(t, i == 2, ok)
+	}
+
+	// collections are cleared on changing epoch
+	payload, err := utils.PackMethod(ABI, MethodChangeEpoch)
+	assert.Nil(t, err)
+	contractRef := native.NewContractRef(sdb, utils.SystemTxSender, utils.SystemTxSender, big.NewInt(399999), common.Hash{}, extra, nil)
+	_, _, err = contractRef.NativeCall(utils.SystemTxSender, utils.NodeManagerContractAddress, payload)
+	assert.Nil(t, err)
+
+	contractQuery := native.NewNativeContract(sdb, contractRef)
+	hashes, err := getSignHashes(contractQuery, StartEpochID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(hashes))
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		sign := &ConsensusSign{EpochID: StartEpochID, Nonce: nonce, Method: string(Signer), Input: input}
+		_, err = getSign(contractQuery, sign.Hash())
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, getSignerSize(contractQuery, sign.Hash()))
+		assert.False(t, findSigner(contractQuery, sign.Hash(), testGenesisPeers[0]))
+	}
+
+	// the same method and input signed in new epoch is a new collection with the next nonce
+	epochID := new(big.Int).Add(StartEpochID, common.Big1)
+	ok, err = checkSign(testGenesisPeers[0], 400001)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	signs = pendingSignsOf(epochID, 400001)
+	assert.Equal(t, 1, len(signs.List))
+	assert.Equal(t, uint64(2), signs.List[0].Nonce)
+
+	// votes collected in the last epoch can not be replayed
+	for i := 0; i < 3; i++ {
+		ok, err := checkSignAs(testGenesisPeers[i], 400001, Voter)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	}
+	assert.Equal(t, 1, len(pendingSignsOf(epochID, 400001).List))
+}
+
 func TestDistribute(t *testing.T) {
 	params.RewardPerBlock = common.Big0
 	Init()
@@ -550,7 +660,7 @@ func TestDistribute(t *testing.T) {
 		contractRef := native.NewContractRef(sdb, caller, caller, blockNumber, common.Hash{}, extra, nil)
 		contractRef.SetValue(value)
 		contractRef.SetTo(utils.NodeManagerContractAddress)
-		err = contract.NativeTransfer(contractRef.StateDB(), caller, this, value)
+		err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), caller, this, value)
 		assert.Nil(t, err)
 		_, _, err = contractRef.NativeCall(caller, utils.NodeManagerContractAddress, input)
 		assert.Nil(t, err)
@@ -570,7 +680,7 @@ func TestDistribute(t *testing.T) {
 	contractRef := native.NewContractRef(sdb, stakeAddress, stakeAddress, blockNumber, common.Hash{}, extra, nil)
 	contractRef.SetValue(value)
 	contractRef.SetTo(utils.NodeManagerContractAddress)
-	err = contract.NativeTransfer(contractRef.StateDB(), stakeAddress, this, value)
+	err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), stakeAddress, this, value)
 	assert.Nil(t, err)
 	_, _, err = contractRef.NativeCall(stakeAddress, utils.NodeManagerContractAddress, input)
 	assert.Nil(t, err)
@@ -586,7 +696,7 @@ func TestDistribute(t *testing.T) {
 	contractRef = native.NewContractRef(sdb, stakeAddress2, stakeAddress2, blockNumber, common.Hash{}, extra, nil)
 	contractRef.SetValue(value)
 	contractRef.SetTo(utils.NodeManagerContractAddress)
-	err = contract.NativeTransfer(contractRef.StateDB(), stakeAddress2, this, value)
+	err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), stakeAddress2, this, value)
 	assert.Nil(t, err)
 	_, _, err = contractRef.NativeCall(stakeAddress2, utils.NodeManagerContractAddress, input)
 	assert.Nil(t, err)
@@ -995,7 +1105,7 @@ func TestPerformance(t *testing.T) {
 		input, err := param.Encode()
 		assert.Nil(t, err)
 		value := new(big.Int).Mul(big.NewInt(1000000), params.ZNT1)
-		err = contract.NativeTransfer(sdb, caller, this, value)
+		err = contract.NativeTransfer(sdb, common.Big0, caller, this, value)
 		assert.Nil(t, err)
 		_, err = native.TestNativeCall(t, utils.NodeManagerContractAddress, "CreateValidator", input, value, caller, caller, blockNumber, extra, sdb)
 		assert.Nil(t, err)
@@ -1015,7 +1125,7 @@ func TestPerformance(t *testing.T) {
 		input, err := param1.Encode()
 		assert.Nil(t, err)
 		value := new(big.Int).Mul(big.NewInt(200), params.ZNT1)
-		err = contract.NativeTransfer(sdb, stakeAddress, this, value)
+		err = contract.NativeTransfer(sdb, common.Big0, stakeAddress, this, value)
 		assert.Nil(t, err)
 		_, err = native.TestNativeCall(t, utils.NodeManagerContractAddress, "Stake", input, value, stakeAddress, stakeAddress, blockNumber, extra, sdb)
 		assert.Nil(t, err)
@@ -1259,7 +1369,7 @@ func TestCreateValidatorParam(t *testing.T) {
 			contractRef := native.NewContractRef(sdb, caller, caller, blockNumber, common.Hash{}, extra, nil)
 			contractRef.SetValue(value)
 			contractRef.SetTo(utils.NodeManagerContractAddress)
-			err = contract.NativeTransfer(sdb, caller, this, value)
+			err = contract.NativeTransfer(sdb, common.Big0, caller, this, value)
 			assert.Nil(t, err)
 			_, _, err = contractRef.NativeCall(caller, utils.NodeManagerContractAddress, input)
 			fmt.Println("#######", err)
@@ -1394,7 +1504,7 @@ func TestStakeParam(t *testing.T) {
 	contractRef := native.NewContractRef(sdb, caller, caller, blockNumber, common.Hash{}, extra, nil)
 	contractRef.SetValue(value)
 	contractRef.SetTo(utils.NodeManagerContractAddress)
-	err = contract.NativeTransfer(sdb, caller, this, value)
+	err = contract.NativeTransfer(sdb, common.Big0, caller, this, value)
 	assert.Nil(t, err)
 	_, _, err = contractRef.NativeCall(caller, utils.NodeManagerContractAddress, input)
 	assert.Nil(t, err)
@@ -1481,3 +1591,368 @@ func TestUnStakeParam(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorEnode(t *testing.T) {
+	Init()
+
+	blockNumber := common.Big1
+	extra := uint64(21000000000000)
+	caller := crypto.PubkeyToAddress(*acct)
+	sdb.SetBalance(caller, new(big.Int).Mul(big.NewInt(100000000), params.ZNT1))
+
+	pk, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(pk.PublicKey)
+	other, _ := crypto.GenerateKey()
+
+	signRecord := func(key *ecdsa.PrivateKey, tcp int) []byte {
+		var r enr.Record
+		r.Set(enr.IP(net.IPv4(127, 0, 0, 1)))
+		if tcp > 0 {
+			r.Set(enr.TCP(tcp))
+		}
+		assert.Nil(t, enode.SignV4(&r, key))
+		blob, err := rlp.EncodeToBytes(&r)
+		assert.Nil(t, err)
+		return blob
+	}
+	createValidator := func(record []byte) error {
+		param := &CreateValidatorParam{ConsensusAddress: addr, SignerAddress: addr, ProposalAddress: addr,
+			Commission: new(big.Int).SetUint64(2000), Desc: "test", Enode: record}
+		input, err := param.Encode()
+		assert.Nil(t, err)
+		contractRef := native.NewContractRef(sdb, caller, caller, blockNumber, common.Hash{}, extra, nil)
+		contractRef.SetValue(new(big.Int).Mul(big.NewInt(100000), params.ZNT1))
+		contractRef.SetTo(utils.NodeManagerContractAddress)
+		_, _, err = contractRef.NativeCall(caller, utils.NodeManagerContractAddress, input)
+		return err
+	}
+
+	// record signed by other key
+	assert.NotNil(t, createValidator(signRecord(other, 30300)))
+	// record without tcp endpoint
+	assert.NotNil(t, createValidator(signRecord(pk, 0)))
+	// malformed record
+	assert.NotNil(t, createValidator([]byte{0x01, 0x02}))
+	assert.Nil(t, createValidator(signRecord(pk, 30300)))
+
+	contractQuery := native.NewNativeContract(sdb, native.NewContractRef(sdb, caller, caller, blockNumber, common.Hash{}, extra, nil))
+	validator, found, err := getValidator(contractQuery, addr)
+	assert.Nil(t, err)
+	assert.True(t, found)
+	node, err := checkEnode(validator.Enode, addr)
+	assert.Nil(t, err)
+	assert.Equal(t, 30300, node.TCP())
+
+	// update enode
+	updateValidator := func(from common.Address, record []byte) error {
+		param := &UpdateValidatorParam{ConsensusAddress: addr, Enode: record}
+		input, err := param.Encode()
+		assert.Nil(t, err)
+		contractRef := native.NewContractRef(sdb, from, from, blockNumber, common.Hash{}, extra, nil)
+		_, _, err = contractRef.NativeCall(from, utils.NodeManagerContractAddress, input)
+		return err
+	}
+	assert.NotNil(t, updateValidator(caller, signRecord(other, 30301)))
+	// caller is not stake address
+	assert.NotNil(t, updateValidator(addr, signRecord(pk, 30301)))
+	assert.Nil(t, updateValidator(caller, signRecord(pk, 30301)))
+
+	// the validators of current epoch without record are omitted
+	epochInfo := &EpochInfo{
+		ID:          big.NewInt(2),
+		Validators:  []common.Address{addr, testGenesisPeers[0]},
+		Signers:     []common.Address{addr, testGenesisPeers[0]},
+		Voters:      []common.Address{addr, testGenesisPeers[0]},
+		Proposers:   []common.Address{addr, testGenesisPeers[0]},
+		StartHeight: new(big.Int),
+		EndHeight:   GenesisBlockPerEpoch,
+	}
+	assert.Nil(t, setGenesisEpochInfo((*state.CacheDB)(sdb), epochInfo))
+	validators, nodes, err := GetEpochEnodesFromDB(sdb)
+	assert.Nil(t, err)
+	assert.Equal(t, epochInfo.Validators, validators)
+	assert.Equal(t, 1, len(nodes))
+	assert.Equal(t, 30301, nodes[addr].TCP())
+	assert.Equal(t, addr, crypto.PubkeyToAddress(*nodes[addr].Pubkey()))
+}
+
+func TestGenesisValidator(t *testing.T) {
+	InitNodeManager()
+	peers, _ := native.GenerateTestPeers(testGenesisNum)
+	stakers, _ := native.GenerateTestPeers(testGenesisNum)
+	delegator := common.HexToAddress("0x01")
+	proposer := common.HexToAddress("0x02")
+	selfStake := new(big.Int).Mul(big.NewInt(100000), params.ZNT1)
+	delegation := new(big.Int).Mul(big.NewInt(1000), params.ZNT1)
+
+	newGenesis := func() (*state.StateDB, *core.Genesis) {
+		db := native.NewTestStateDB()
+		genesis := &core.Genesis{CommunityRate: big.NewInt(2000)}
+		for i, peer := range peers {
+			db.AddBalance(stakers[i], selfStake)
+			genesis.Governance = append(genesis.Governance, core.GovernanceAccount{
+				Validator:    peer,
+				Signer:       peer,
+				StakeAddress: stakers[i],
+				Commission:   big.NewInt(1000),
+				SelfStake:    selfStake,
+			})
+		}
+		db.AddBalance(delegator, delegation)
+		genesis.Governance[0].ProposalAddress = proposer
+		genesis.Governance[0].Delegations = []core.GenesisDelegation{{Delegator: delegator, Amount: delegation}}
+		return db, genesis
+	}
+
+	db, genesis := newGenesis()
+	assert.Nil(t, SetupGenesis(db, genesis))
+
+	s := native.NewNativeContract(db, native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, common.Big0, common.Hash{}, 0, nil))
+	allValidators, err := getAllValidators(s)
+	assert.Nil(t, err)
+	assert.Equal(t, peers, allValidators.AllValidators)
+	for i, peer := range peers {
+		validator, found, err := getValidator(s, peer)
+		assert.Nil(t, err)
+		assert.True(t, found)
+		assert.True(t, validator.IsLocked())
+		assert.Equal(t, stakers[i], validator.StakeAddress)
+		assert.Equal(t, selfStake, validator.SelfStake.BigInt())
+		assert.Equal(t, 0, db.GetBalance(stakers[i]).Sign())
+	}
+	validator, _, err := getValidator(s, peers[0])
+	assert.Nil(t, err)
+	assert.Equal(t, proposer, validator.ProposalAddress)
+	assert.Equal(t, new(big.Int).Add(selfStake, delegation), validator.TotalStake.BigInt())
+	stakeInfo, found, err := getStakeInfo(s, delegator, peers[0])
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, delegation, stakeInfo.Amount.BigInt())
+
+	total := new(big.Int).Add(new(big.Int).Mul(selfStake, big.NewInt(int64(testGenesisNum))), delegation)
+	totalPool, err := getTotalPool(s)
+	assert.Nil(t, err)
+	assert.Equal(t, total, totalPool.TotalPool.BigInt())
+	assert.Equal(t, total, db.GetBalance(utils.NodeManagerContractAddress))
+
+	epochInfo, err := GetCurrentEpochInfoFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, proposer, epochInfo.Proposers[0])
+
+	// community address is kept empty unless treasury is enabled
+	communityInfo, err := community.GetCommunityInfoFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, common.EmptyAddress, communityInfo.CommunityAddress)
+
+	// community funds are held by treasury if it's enabled
+	db, genesis = newGenesis()
+	genesis.Treasury = true
+	assert.Nil(t, SetupGenesis(db, genesis))
+	communityInfo, err = community.GetCommunityInfoFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, utils.TreasuryContractAddress, communityInfo.CommunityAddress)
+
+	// the specified address is kept even if treasury is enabled
+	db, genesis = newGenesis()
+	genesis.Treasury = true
+	genesis.CommunityAddress = delegator
+	assert.Nil(t, SetupGenesis(db, genesis))
+	communityInfo, err = community.GetCommunityInfoFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, delegator, communityInfo.CommunityAddress)
+
+	// stakes are locked from genesis alloc
+	db, genesis = newGenesis()
+	genesis.Governance[1].SelfStake = new(big.Int).Add(selfStake, common.Big1)
+	assert.NotNil(t, SetupGenesis(db, genesis))
+
+	// self stake less than min initial stake
+	db, genesis = newGenesis()
+	genesis.Governance[1].SelfStake = common.Big1
+	assert.NotNil(t, SetupGenesis(db, genesis))
+
+	// delegations exceed max stake rate
+	db, genesis = newGenesis()
+	huge := new(big.Int).Mul(selfStake, big.NewInt(6))
+	db.AddBalance(delegator, huge)
+	genesis.Governance[0].Delegations[0].Amount = huge
+	assert.NotNil(t, SetupGenesis(db, genesis))
+}
+
+func TestGenesisVesting(t *testing.T) {
+	InitNodeManager()
+	peers, _ := native.GenerateTestPeers(testGenesisNum)
+	investor := common.HexToAddress("0x01")
+	selfStake := new(big.Int).Mul(big.NewInt(100000), params.ZNT1)
+	delegation := new(big.Int).Mul(big.NewInt(1000), params.ZNT1)
+
+	newGenesis := func() (*state.StateDB, *core.Genesis) {
+		db := native.NewTestStateDB()
+		genesis := &core.Genesis{CommunityRate: big.NewInt(2000)}
+		for _, peer := range peers {
+			db.AddBalance(peer, selfStake)
+			genesis.Governance = append(genesis.Governance, core.GovernanceAccount{
+				Validator:    peer,
+				Signer:       peer,
+				StakeAddress: peer,
+				SelfStake:    selfStake,
+			})
+		}
+		db.AddBalance(investor, new(big.Int).Mul(delegation, big.NewInt(2)))
+		genesis.Vestings = []core.GenesisVesting{{Address: investor, Amount: new(big.Int).Mul(delegation, big.NewInt(2)), EndHeight: 100}}
+		genesis.Governance[0].Delegations = []core.GenesisDelegation{{Delegator: investor, Amount: delegation}}
+		return db, genesis
+	}
+
+	// the delegation is taken from the locked balance
+	db, genesis := newGenesis()
+	assert.Nil(t, SetupGenesis(db, genesis))
+	assert.Equal(t, delegation, db.GetBalance(investor))
+	assert.Equal(t, delegation, vesting.LockedBalance(db, investor, common.Big0))
+	assert.Equal(t, new(big.Int).Div(delegation, big.NewInt(2)), vesting.LockedBalance(db, investor, big.NewInt(25)))
+	assert.Equal(t, 0, vesting.LockedBalance(db, investor, big.NewInt(100)).Sign())
+
+	// vesting amount exceeds genesis alloc
+	db, genesis = newGenesis()
+	genesis.Vestings[0].Amount = new(big.Int).Mul(delegation, big.NewInt(3))
+	assert.NotNil(t, SetupGenesis(db, genesis))
+
+	// vesting ends before start
+	db, genesis = newGenesis()
+	genesis.Vestings[0].StartHeight = 200
+	assert.NotNil(t, SetupGenesis(db, genesis))
+}
+
+func TestGenesisGlobalConfig(t *testing.T) {
+	InitNodeManager()
+	peers, _ := native.GenerateTestPeers(testGenesisNum)
+	newGenesis := func() *core.Genesis {
+		genesis := &core.Genesis{CommunityRate: big.NewInt(2000)}
+		for _, peer := range peers {
+			genesis.Governance = append(genesis.Governance, core.GovernanceAccount{Validator: peer, Signer: peer})
+		}
+		return genesis
+	}
+
+	// the unset fields take defaults
+	db := native.NewTestStateDB()
+	genesis := newGenesis()
+	genesis.NodeManager = &core.NodeManagerConfig{BlockPerEpoch: big.NewInt(100), VoterValidatorNum: 3}
+	genesis.Economic = &core.EconomicConfig{RewardPerBlock: big.NewInt(5)}
+	assert.Nil(t, SetupGenesis(db, genesis))
+
+	globalConfig, err := GetGlobalConfigFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), globalConfig.BlockPerEpoch)
+	assert.Equal(t, uint64(3), globalConfig.VoterValidatorNum)
+	assert.Equal(t, GenesisConsensusValidatorNum, globalConfig.ConsensusValidatorNum)
+	assert.Equal(t, GenesisMinInitialStake, globalConfig.MinInitialStake)
+	epochInfo, err := GetCurrentEpochInfoFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), epochInfo.EndHeight)
+	economicConfig, err := economic.GetEconomicConfigFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(5), economicConfig.RewardPerBlock)
+	assert.Equal(t, params.GenesisSupply, economicConfig.GenesisSupply)
+
+	// the economic config is not stored unless it's configured
+	db = native.NewTestStateDB()
+	assert.Nil(t, SetupGenesis(db, newGenesis()))
+	store, err := (*state.CacheDB)(db).Get(utils.ConcatKey(utils.EconomicContractAddress, []byte(economic.SKP_ECONOMIC_CONFIG)))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(store))
+	economicConfig, err = economic.GetEconomicConfigFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, economic.DefaultEconomicConfig().RewardPerBlock, economicConfig.RewardPerBlock)
+
+	invalid := []*core.NodeManagerConfig{
+		{BlockPerEpoch: new(big.Int)},
+		{MaxCommissionChange: big.NewInt(10001)},
+		{MinInitialStake: new(big.Int)},
+		{ConsensusValidatorNum: uint64(MaxValidatorNum) + 1},
+		{ConsensusValidatorNum: 4, VoterValidatorNum: 5},
+	}
+	for i, config := range invalid {
+		genesis := newGenesis()
+		genesis.NodeManager = config
+		assert.NotNil(t, SetupGenesis(native.NewTestStateDB(), genesis), "config %d", i)
+	}
+	genesis = newGenesis()
+	genesis.Economic = &core.EconomicConfig{GenesisSupply: new(big.Int)}
+	assert.NotNil(t, SetupGenesis(native.NewTestStateDB(), genesis))
+
+	// the genesis epoch in hotstuff extra should end with the block per epoch
+	for _, end := range []uint64{100, GenesisBlockPerEpoch.Uint64()} {
+		header := &types.Header{}
+		assert.Nil(t, types.HotstuffHeaderFillWithValidators(header, peers, 0, end))
+		genesis = newGenesis()
+		genesis.Config = &params.ChainConfig{HotStuff: &params.HotStuffConfig{}}
+		genesis.ExtraData = header.Extra
+		genesis.NodeManager = &core.NodeManagerConfig{BlockPerEpoch: big.NewInt(100)}
+		if end == 100 {
+			assert.Nil(t, SetupGenesis(native.NewTestStateDB(), genesis))
+		} else {
+			assert.NotNil(t, SetupGenesis(native.NewTestStateDB(), genesis))
+		}
+	}
+}
+
+func TestDistributeGasFee(t *testing.T) {
+	InitNodeManager()
+	peers, _ := native.GenerateTestPeers(testGenesisNum)
+	stakers, _ := native.GenerateTestPeers(testGenesisNum)
+	pool := common.HexToAddress("0x01")
+	selfStake := new(big.Int).Mul(big.NewInt(100000), params.ZNT1)
+	baseFee, tip := big.NewInt(1000), big.NewInt(500)
+
+	newGenesis := func(rate *big.Int) *state.StateDB {
+		db := native.NewTestStateDB()
+		genesis := &core.Genesis{CommunityRate: big.NewInt(0), CommunityAddress: pool, CommunityBaseFeeRate: rate}
+		for i, peer := range peers {
+			db.AddBalance(stakers[i], selfStake)
+			genesis.Governance = append(genesis.Governance, core.GovernanceAccount{
+				Validator: peer, Signer: peer, StakeAddress: stakers[i], SelfStake: selfStake,
+			})
+		}
+		assert.Nil(t, SetupGenesis(db, genesis))
+		return db
+	}
+
+	// the whole gas fee is deposited if the fee policy is not set
+	db := newGenesis(nil)
+	locked := new(big.Int).Set(db.GetBalance(this))
+	DistributeGasFee(db, peers[0], baseFee, tip)
+	assert.Equal(t, new(big.Int).Add(locked, big.NewInt(1500)), db.GetBalance(this))
+	assert.Equal(t, 0, db.GetBalance(pool).Sign())
+
+	// 20% of base fee is sent to community pool and the rest is burned
+	db = newGenesis(big.NewInt(2000))
+	DistributeGasFee(db, peers[0], baseFee, tip)
+	DistributeGasFee(db, peers[0], baseFee, tip)
+	assert.Equal(t, big.NewInt(400), db.GetBalance(pool))
+	assert.Equal(t, new(big.Int).Add(locked, big.NewInt(1000)), db.GetBalance(this))
+	burned, err := economic.GetBurnedSupplyFromDB(db)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1600), burned)
+	tips, err := getProposerTips((*state.CacheDB)(db))
+	assert.Nil(t, err)
+	assert.Equal(t, &ProposerTips{Proposer: peers[0], Amount: big.NewInt(1000)}, tips)
+
+	// the tips are allocated to the proposer besides the shared rewards
+	input, err := new(EndBlockParam).Encode()
+	assert.Nil(t, err)
+	_, err = native.TestNativeCall(t, this, "EndBlock", input, new(big.Int), utils.SystemTxSender, utils.SystemTxSender, 1, uint64(21000000000000), db)
+	assert.Nil(t, err)
+	tips, err = getProposerTips((*state.CacheDB)(db))
+	assert.Nil(t, err)
+	assert.Nil(t, tips)
+
+	s := native.NewNativeContract(db, native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, common.Big1, common.Hash{}, 0, nil))
+	proposer, err := getValidatorOutstandingRewards(s, peers[0])
+	assert.Nil(t, err)
+	other, err := getValidatorOutstandingRewards(s, peers[1])
+	assert.Nil(t, err)
+	diff, err := proposer.Rewards.Sub(other.Rewards)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), diff.BigInt())
+}
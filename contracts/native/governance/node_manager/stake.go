@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/contract"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
 )
 
 func deposit(s *native.NativeContract, from common.Address, amount utils.Dec, validator *Validator) error {
@@ -56,7 +57,12 @@ func deposit(s *native.NativeContract, from common.Address, amount utils.Dec, va
 		return fmt.Errorf("deposit, setStakeInfo error: %v", err)
 	}
 
-	// do not transfer native token, already transfered by value
+	// do not transfer native token, already transfered by value, but the locked balance
+	// of vesting account is delegated
+	err = vesting.TrackDelegation(s, from, amount.BigInt())
+	if err != nil {
+		return fmt.Errorf("deposit, vesting.TrackDelegation error: %v", err)
+	}
 
 	// update total token pool
 	err = depositTotalPool(s, amount)
@@ -112,10 +118,14 @@ func unStake(s *native.NativeContract, from common.Address, amount utils.Dec, va
 			return fmt.Errorf("unStake, withdrawTotalPool error: %v", err)
 		}
 		// transfer native token
-		err = contract.NativeTransfer(s.StateDB(), this, from, amount.BigInt())
+		err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, from, amount.BigInt())
 		if err != nil {
 			return fmt.Errorf("unStake, nativeTransfer error: %v", err)
 		}
+		err = vesting.TrackUndelegation(s, from, amount.BigInt())
+		if err != nil {
+			return fmt.Errorf("unStake, vesting.TrackUndelegation error: %v", err)
+		}
 	}
 
 	if validator.IsUnlocking(height) || validator.IsRemoving(height) {
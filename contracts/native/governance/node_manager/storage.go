@@ -29,6 +29,8 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 )
 
 var StartEpochID = common.Big1 // epoch started from 1, NOT 0!
@@ -55,6 +57,13 @@ const (
 	SKP_STAKE_STARTING_INFO           = "st_stake_starting_info"
 	SKP_SIGN                          = "st_sign"
 	SKP_SIGNER                        = "st_signer"
+	SKP_SIGNER_SIZE                   = "st_signer_size"
+	SKP_SIGNER_INDEX                  = "st_signer_index"
+	SKP_SIGN_LIST                     = "st_sign_list"
+	SKP_SIGN_LIST_SIZE                = "st_sign_list_size"
+	SKP_SIGN_NONCE                    = "st_sign_nonce"
+	SKP_SIGN_DONE                     = "st_sign_done"
+	SKP_PROPOSER_TIPS                 = "st_proposer_tips"
 )
 
 func setAccumulatedCommission(s *native.NativeContract, consensusAddr common.Address, accumulatedCommission *AccumulatedCommission) error {
@@ -169,6 +178,43 @@ func getOutstandingRewards(s *native.NativeContract) (*OutstandingRewards, error
 	return outstandingRewards, nil
 }
 
+func addProposerTips(s *state.CacheDB, proposer common.Address, amount *big.Int) error {
+	tips, err := getProposerTips(s)
+	if err != nil {
+		return fmt.Errorf("addProposerTips, getProposerTips error: %v", err)
+	}
+	if tips == nil || tips.Proposer != proposer {
+		tips = &ProposerTips{Proposer: proposer, Amount: new(big.Int)}
+	}
+	tips.Amount = new(big.Int).Add(tips.Amount, amount)
+	store, err := rlp.EncodeToBytes(tips)
+	if err != nil {
+		return fmt.Errorf("addProposerTips, serialize proposer tips error: %v", err)
+	}
+	customSet(s, proposerTipsKey(), store)
+	return nil
+}
+
+// getProposerTips returns nil if there is no priority fee accumulated.
+func getProposerTips(s *state.CacheDB) (*ProposerTips, error) {
+	store, err := customGet(s, proposerTipsKey())
+	if err == ErrEof {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getProposerTips, get store error: %v", err)
+	}
+	tips := new(ProposerTips)
+	if err := rlp.DecodeBytes(store, tips); err != nil {
+		return nil, fmt.Errorf("getProposerTips, deserialize proposer tips error: %v", err)
+	}
+	return tips, nil
+}
+
+func delProposerTips(s *state.CacheDB) {
+	customDel(s, proposerTipsKey())
+}
+
 func increaseReferenceCount(s *native.NativeContract, consensusAddr common.Address, period uint64) error {
 	validatorSnapshotRewards, err := getValidatorSnapshotRewards(s, consensusAddr, period)
 	if err != nil {
@@ -686,6 +732,40 @@ func GetCurrentEpochInfoFromDB(s *state.StateDB) (*EpochInfo, error) {
 	return epochInfo, nil
 }
 
+// GetEpochEnodesFromDB returns the validators of current epoch and the node records published by them,
+// the validators which have not published the record are omitted in the records.
+func GetEpochEnodesFromDB(s *state.StateDB) ([]common.Address, map[common.Address]*enode.Node, error) {
+	epochInfo, err := GetCurrentEpochInfoFromDB(s)
+	if err != nil {
+		return nil, nil, fmt.Errorf("GetEpochEnodesFromDB, GetCurrentEpochInfoFromDB error: %v", err)
+	}
+	cache := (*state.CacheDB)(s)
+	nodes := make(map[common.Address]*enode.Node)
+	for _, addr := range epochInfo.Validators {
+		store, err := customGet(cache, validatorKey(addr))
+		if err == ErrEof {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("GetEpochEnodesFromDB, get validator store error: %v", err)
+		}
+		validator := new(Validator)
+		if err := rlp.DecodeBytes(store, validator); err != nil {
+			return nil, nil, fmt.Errorf("GetEpochEnodesFromDB, deserialize validator error: %v", err)
+		}
+		if len(validator.Enode) == 0 {
+			continue
+		}
+		node, err := checkEnode(validator.Enode, addr)
+		if err != nil {
+			log.Warn("GetEpochEnodesFromDB, invalid enode", "validator", addr.Hex(), "err", err)
+			continue
+		}
+		nodes[addr] = node
+	}
+	return epochInfo.Validators, nodes, nil
+}
+
 func setEpochInfo(s *native.NativeContract, epochInfo *EpochInfo) error {
 	key := epochInfoKey(epochInfo.ID)
 	store, err := rlp.EncodeToBytes(epochInfo)
@@ -757,66 +837,122 @@ func getSign(s *native.NativeContract, hash common.Hash) (*ConsensusSign, error)
 	return sign, nil
 }
 
-func storeSigner(s *native.NativeContract, hash common.Hash, signer common.Address) error {
-	data, err := getSigners(s, hash)
-	if err != nil {
-		if err.Error() == ErrEof.Error() {
-			data = make([]common.Address, 0)
-		} else {
-			return err
+// storeSigner marks the signer of the collection and appends it to the indexed signer list, so
+// that a signature costs constant gas no matter how many signers there are.
+func storeSigner(s *native.NativeContract, hash common.Hash, signer common.Address) {
+	size := getSignerSize(s, hash)
+	set(s, signerKey(hash, signer), []byte{1})
+	set(s, signerIndexKey(hash, uint64(size)), signer.Bytes())
+	set(s, signerSizeKey(hash), utils.GetUint64Bytes(uint64(size)+1))
+}
+
+func findSigner(s *native.NativeContract, hash common.Hash, signer common.Address) bool {
+	value, err := get(s, signerKey(hash, signer))
+	return err == nil && len(value) > 0
+}
+
+func getSigners(s *native.NativeContract, hash common.Hash) ([]common.Address, error) {
+	size := getSignerSize(s, hash)
+	list := make([]common.Address, 0, size)
+	for i := 0; i < size; i++ {
+		value, err := get(s, signerIndexKey(hash, uint64(i)))
+		if err != nil {
+			return nil, err
 		}
+		list = append(list, common.BytesToAddress(value))
 	}
-	data = append(data, signer)
-	list := &AddressList{List: data}
+	return list, nil
+}
 
-	key := signerKey(hash)
-	value, err := rlp.EncodeToBytes(list)
+func getSignerSize(s *native.NativeContract, hash common.Hash) int {
+	value, err := get(s, signerSizeKey(hash))
 	if err != nil {
-		return err
+		return 0
 	}
-	set(s, key, value)
+	return int(utils.GetBytesUint64(value))
+}
 
-	return nil
+func clearSigner(s *native.NativeContract, hash common.Hash) {
+	size := getSignerSize(s, hash)
+	for i := 0; i < size; i++ {
+		key := signerIndexKey(hash, uint64(i))
+		if value, err := get(s, key); err == nil {
+			del(s, signerKey(hash, common.BytesToAddress(value)))
+		}
+		del(s, key)
+	}
+	del(s, signerSizeKey(hash))
 }
 
-func findSigner(s *native.NativeContract, hash common.Hash, signer common.Address) bool {
-	list, err := getSigners(s, hash)
+// storeSignHash appends the collection hash to the indexed list of the epoch.
+func storeSignHash(s *native.NativeContract, epochID *big.Int, hash common.Hash) {
+	size := getSignHashSize(s, epochID)
+	set(s, signListKey(epochID, size), hash.Bytes())
+	set(s, signListSizeKey(epochID), utils.GetUint64Bytes(size+1))
+}
+
+func getSignHashSize(s *native.NativeContract, epochID *big.Int) uint64 {
+	value, err := get(s, signListSizeKey(epochID))
 	if err != nil {
-		return false
+		return 0
 	}
-	for _, v := range list {
-		if v == signer {
-			return true
+	return utils.GetBytesUint64(value)
+}
+
+func getSignHashes(s *native.NativeContract, epochID *big.Int) ([]common.Hash, error) {
+	size := getSignHashSize(s, epochID)
+	list := make([]common.Hash, 0, size)
+	for i := uint64(0); i < size; i++ {
+		value, err := get(s, signListKey(epochID, i))
+		if err != nil {
+			return nil, err
 		}
+		list = append(list, common.BytesToHash(value))
 	}
-	return false
+	return list, nil
 }
 
-func getSigners(s *native.NativeContract, hash common.Hash) ([]common.Address, error) {
-	key := signerKey(hash)
-	value, err := get(s, key)
+// clearSigns remove all of the sign collections created in the epoch, the completion records
+// and nonces are kept so that the collected signatures can not be replayed in the next epoch.
+func clearSigns(s *native.NativeContract, epochID *big.Int) error {
+	list, err := getSignHashes(s, epochID)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	var list *AddressList
-	if err := rlp.DecodeBytes(value, &list); err != nil {
-		return nil, err
+	for i, hash := range list {
+		delSign(s, hash)
+		clearSigner(s, hash)
+		del(s, signListKey(epochID, uint64(i)))
 	}
-	return list.List, nil
+	del(s, signListSizeKey(epochID))
+	return nil
 }
 
-func getSignerSize(s *native.NativeContract, hash common.Hash) int {
-	list, err := getSigners(s, hash)
+// getSignNonce returns the number of collections opened for the method and input, it is bumped
+// whenever a collection expires or reaches quorum.
+func getSignNonce(s *native.NativeContract, action common.Hash) uint64 {
+	value, err := get(s, signNonceKey(action))
 	if err != nil {
 		return 0
 	}
-	return len(list)
+	return utils.GetBytesUint64(value)
 }
 
-func clearSigner(s *native.NativeContract, hash common.Hash) {
-	key := signerKey(hash)
-	del(s, key)
+func setSignNonce(s *native.NativeContract, action common.Hash, nonce uint64) {
+	set(s, signNonceKey(action), utils.GetUint64Bytes(nonce))
+}
+
+// getSignDone returns the epoch in which the method and input reached quorum last time.
+func getSignDone(s *native.NativeContract, action common.Hash) (*big.Int, bool) {
+	value, err := get(s, signDoneKey(action))
+	if err != nil {
+		return nil, false
+	}
+	return new(big.Int).SetBytes(value), true
+}
+
+func setSignDone(s *native.NativeContract, action common.Hash, epochID *big.Int) {
+	set(s, signDoneKey(action), epochID.Bytes())
 }
 
 // ====================================================================
@@ -918,6 +1054,10 @@ func outstandingRewardsKey() []byte {
 	return utils.ConcatKey(this, []byte(SKP_OUTSTANDING_REWARDS))
 }
 
+func proposerTipsKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_PROPOSER_TIPS))
+}
+
 func validatorSnapshotRewardsKey(consensusAddr common.Address, period uint64) []byte {
 	return utils.ConcatKey(this, []byte(SKP_VALIDATOR_SNAPSHOT_REWARDS), consensusAddr[:], utils.Uint64Bytes(period))
 }
@@ -930,6 +1070,30 @@ func signKey(hash common.Hash) []byte {
 	return utils.ConcatKey(this, []byte(SKP_SIGN), hash.Bytes())
 }
 
-func signerKey(hash common.Hash) []byte {
-	return utils.ConcatKey(this, []byte(SKP_SIGNER), hash.Bytes())
+func signerKey(hash common.Hash, signer common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SIGNER), hash.Bytes(), signer[:])
+}
+
+func signerSizeKey(hash common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SIGNER_SIZE), hash.Bytes())
+}
+
+func signerIndexKey(hash common.Hash, index uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SIGNER_INDEX), hash.Bytes(), utils.Uint64Bytes(index))
+}
+
+func signListKey(epochID *big.Int, index uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SIGN_LIST), epochID.Bytes(), utils.Uint64Bytes(index))
+}
+
+func signListSizeKey(epochID *big.Int) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SIGN_LIST_SIZE), epochID.Bytes())
+}
+
+func signNonceKey(action common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SIGN_NONCE), action.Bytes())
+}
+
+func signDoneKey(action common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SIGN_DONE), action.Bytes())
 }
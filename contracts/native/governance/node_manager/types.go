@@ -63,6 +63,8 @@ type Validator struct {
 	TotalStake       utils.Dec
 	SelfStake        utils.Dec
 	Desc             string
+	// Enode is the signed node record of the consensus node, published for the consensus peer discovery
+	Enode []byte `rlp:"optional"`
 }
 
 func (m *Validator) Decode(payload []byte) error {
@@ -264,6 +266,13 @@ type OutstandingRewards struct {
 	Rewards utils.Dec
 }
 
+// ProposerTips is the priority fees of current block accumulated for the proposer,
+// which are allocated to the proposer in EndBlock.
+type ProposerTips struct {
+	Proposer common.Address
+	Amount   *big.Int
+}
+
 func (m *OutstandingRewards) Decode(payload []byte) error {
 	var data struct {
 		OutstandingRewards []byte
@@ -323,10 +332,17 @@ type AddressList struct {
 	List []common.Address
 }
 
+// ConsensusSign is a multi-signature collection of governance method, it is bound to the epoch in
+// which it was created and the nonce of the method and input, and the signatures are discarded if
+// quorum is not reached before deadline.
 type ConsensusSign struct {
-	Method string
-	Input  []byte
-	hash   atomic.Value
+	EpochID  *big.Int
+	Nonce    uint64
+	Method   string
+	Input    []byte
+	Quorum   uint64
+	Deadline *big.Int
+	hash     atomic.Value
 }
 
 func (m *ConsensusSign) Hash() common.Hash {
@@ -334,17 +350,56 @@ func (m *ConsensusSign) Hash() common.Hash {
 		return hash.(common.Hash)
 	}
 	var inf = struct {
-		Method string
-		Input  []byte
+		EpochID *big.Int
+		Nonce   uint64
+		Method  string
+		Input   []byte
 	}{
-		Method: m.Method,
-		Input:  m.Input,
+		EpochID: m.EpochID,
+		Nonce:   m.Nonce,
+		Method:  m.Method,
+		Input:   m.Input,
 	}
 	v := utils.RLPHash(inf)
 	m.hash.Store(v)
 	return v
 }
 
+// Action identifies the method and input regardless of the epoch and nonce.
+func (m *ConsensusSign) Action() common.Hash {
+	return utils.RLPHash([]interface{}{m.Method, m.Input})
+}
+
+func (m *ConsensusSign) Expired(height *big.Int) bool {
+	return m.Deadline != nil && height.Cmp(m.Deadline) > 0
+}
+
+// PendingSign is the collection which is still waiting for signatures.
+type PendingSign struct {
+	Hash     common.Hash
+	EpochID  *big.Int
+	Nonce    uint64
+	Method   string
+	Input    []byte
+	Quorum   uint64
+	Deadline *big.Int
+	Signers  []common.Address
+}
+
+type PendingSigns struct {
+	List []*PendingSign
+}
+
+func (m *PendingSigns) Decode(payload []byte) error {
+	var data struct {
+		PendingSigns []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGetConsensusSigns, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.PendingSigns, m)
+}
+
 type TotalPool struct {
 	TotalPool utils.Dec
 }
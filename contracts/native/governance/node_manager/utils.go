@@ -20,10 +20,16 @@ package node_manager
 
 import (
 	"fmt"
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 type SignerName string
@@ -66,20 +72,39 @@ func CheckConsensusSigns(s *native.NativeContract, method string, input []byte,
 		quorum = epoch.ProposerQuorumSize()
 	}
 
-	// get or set consensus sign info
-	sign := &ConsensusSign{Method: method, Input: input}
+	// get or set consensus sign info, the collection is bound to current epoch and the nonce of
+	// method and input, the nonce is bumped if it was expired before reaching quorum.
+	height := s.ContractRef().BlockHeight()
+	sign := &ConsensusSign{EpochID: epoch.ID, Method: method, Input: input}
+	action := sign.Action()
+
+	// the method and input have been approved, late signs in the same epoch are redundant, and
+	// votes are detached signatures which could be replayed by anyone after the epoch changed.
+	if doneEpoch, ok := getSignDone(s, action); ok {
+		if doneEpoch.Cmp(epoch.ID) == 0 || signerName == Voter {
+			return false, nil
+		}
+	}
+
+	sign.Nonce = getSignNonce(s, action)
 	if exist, err := getSign(s, sign.Hash()); err != nil {
 		if err.Error() == "EOF" {
-			if err := storeSign(s, sign); err != nil {
+			if err := storeNewSign(s, sign, epoch, quorum, height); err != nil {
 				return false, fmt.Errorf("CheckConsensusSigns, storeSign error: %v, hash %s", err, sign.Hash().Hex())
-			} else {
-				log.Trace("checkConsensusSign", "store sign, hash", sign.Hash().Hex())
 			}
+			log.Trace("checkConsensusSign", "store sign, hash", sign.Hash().Hex())
 		} else {
 			return false, fmt.Errorf("CheckConsensusSigns, get sign error: %v, hash %s", err, sign.Hash().Hex())
 		}
-	} else if exist.Hash() != sign.Hash() {
-		return false, fmt.Errorf("CheckConsensusSigns, check sign hash failed, expect: %s, got %s", exist.Hash().Hex(), sign.Hash().Hex())
+	} else if exist.EpochID.Cmp(epoch.ID) != 0 {
+		return false, fmt.Errorf("CheckConsensusSigns, sign for stale epoch %v, current epoch %v", exist.EpochID, epoch.ID)
+	} else if exist.Expired(height) {
+		sign = &ConsensusSign{EpochID: epoch.ID, Nonce: exist.Nonce + 1, Method: method, Input: input}
+		setSignNonce(s, action, sign.Nonce)
+		if err := storeNewSign(s, sign, epoch, quorum, height); err != nil {
+			return false, fmt.Errorf("CheckConsensusSigns, reset expired sign error: %v, hash %s", err, sign.Hash().Hex())
+		}
+		log.Trace("checkConsensusSign", "reset expired sign, hash", sign.Hash().Hex(), "deadline", exist.Deadline)
 	}
 
 	// check duplicate signature
@@ -87,21 +112,34 @@ func CheckConsensusSigns(s *native.NativeContract, method string, input []byte,
 		return false, fmt.Errorf("CheckConsensusSigns, signer already exist: %s, hash %s", signer.Hex(), sign.Hash().Hex())
 	}
 
-	// do not store redundancy sign
-	sizeBeforeSign := getSignerSize(s, sign.Hash())
-	log.Trace("checkConsensusSign", "sign hash", sign.Hash().Hex(), "size before sign", sizeBeforeSign)
-	if sizeBeforeSign >= quorum {
+	// store signer address
+	storeSigner(s, sign.Hash(), signer)
+	sizeAfterSign := getSignerSize(s, sign.Hash())
+	log.Trace("checkConsensusSign", "sign hash", sign.Hash().Hex(), "size after sign", sizeAfterSign)
+	if sizeAfterSign < quorum {
 		return false, nil
 	}
 
-	// store signer address and emit event log
-	if err := storeSigner(s, sign.Hash(), signer); err != nil {
-		return false, fmt.Errorf("CheckConsensusSigns, store signer failed: %s, hash %s", err, sign.Hash().Hex())
-	}
-	sizeAfterSign := getSignerSize(s, sign.Hash())
-	log.Trace("checkConsensusSign", "sign hash", sign.Hash().Hex(), "size after sign", sizeAfterSign)
+	// quorum reached, the next collection of the same method and input starts with a new nonce.
+	setSignDone(s, action, epoch.ID)
+	setSignNonce(s, action, sign.Nonce+1)
+	return true, nil
+}
 
-	return sizeAfterSign >= quorum, nil
+// storeNewSign set the quorum and deadline of the sign collection, the deadline never exceeds the
+// end of epoch since collections are cleared on epoch changing.
+func storeNewSign(s *native.NativeContract, sign *ConsensusSign, epoch *EpochInfo, quorum int, height *big.Int) error {
+	deadline := new(big.Int).Add(height, SignExpiration)
+	if epoch.EndHeight != nil && deadline.Cmp(epoch.EndHeight) > 0 {
+		deadline = new(big.Int).Set(epoch.EndHeight)
+	}
+	sign.Quorum = uint64(quorum)
+	sign.Deadline = deadline
+	if err := storeSign(s, sign); err != nil {
+		return err
+	}
+	storeSignHash(s, epoch.ID, sign.Hash())
+	return nil
 }
 
 func CheckSignerAuthority(origin, caller common.Address, epoch *EpochInfo) error {
@@ -158,3 +196,26 @@ func EpochChangeAtNextBlock(curHeight, epochStartHeight uint64) bool {
 	}
 	return false
 }
+
+// checkEnode decodes the node record published by validator, the record should be signed by the
+// node key of consensus address and carry the endpoint for the consensus peers to dial.
+func checkEnode(record []byte, consensusAddr common.Address) (*enode.Node, error) {
+	if len(record) > enr.SizeLimit {
+		return nil, fmt.Errorf("checkEnode, record size %d exceeds limit %d", len(record), enr.SizeLimit)
+	}
+	var r enr.Record
+	if err := rlp.DecodeBytes(record, &r); err != nil {
+		return nil, fmt.Errorf("checkEnode, decode record error: %v", err)
+	}
+	node, err := enode.New(enode.ValidSchemes, &r)
+	if err != nil {
+		return nil, fmt.Errorf("checkEnode, invalid record: %v", err)
+	}
+	if node.Pubkey() == nil || crypto.PubkeyToAddress(*node.Pubkey()) != consensusAddr {
+		return nil, fmt.Errorf("checkEnode, record is not signed by consensus address %s", consensusAddr.Hex())
+	}
+	if node.IP() == nil || node.TCP() == 0 {
+		return nil, fmt.Errorf("checkEnode, record has no tcp endpoint")
+	}
+	return node, nil
+}
@@ -66,6 +66,22 @@ func (m *ProposeCommunityParam) Encode() ([]byte, error) {
 	return utils.PackMethodWithStruct(ABI, MethodProposeCommunity, m)
 }
 
+type ProposeEconomicParam struct {
+	Content []byte
+}
+
+func (m *ProposeEconomicParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodProposeEconomic, m)
+}
+
+type ProposeSpendParam struct {
+	Content []byte
+}
+
+func (m *ProposeSpendParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodProposeSpend, m)
+}
+
 type VoteProposalParam struct {
 	ID *big.Int
 }
@@ -99,3 +115,15 @@ type GetCommunityProposalListParam struct{}
 func (m *GetCommunityProposalListParam) Encode() ([]byte, error) {
 	return utils.PackMethod(ABI, MethodGetCommunityProposalList)
 }
+
+type GetEconomicProposalListParam struct{}
+
+func (m *GetEconomicProposalListParam) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodGetEconomicProposalList)
+}
+
+type GetSpendProposalListParam struct{}
+
+func (m *GetSpendProposalListParam) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodGetSpendProposalList)
+}
@@ -26,18 +26,23 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	"github.com/ethereum/go-ethereum/contracts/native/economic"
 	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/proposal_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/treasury"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
 const (
-	PROPOSE_EVENT           = "Propose"
-	PROPOSE_CONFIG_EVENT    = "ProposeConfig"
-	PROPOSE_COMMUNITY_EVENT = "ProposeCommunity"
-	VOTE_PROPOSAL_EVENT     = "VoteProposal"
+	PROPOSE_EVENT              = "Propose"
+	PROPOSE_CONFIG_EVENT       = "ProposeConfig"
+	PROPOSE_COMMUNITY_EVENT    = "ProposeCommunity"
+	PROPOSE_ECONOMIC_EVENT     = "ProposeEconomic"
+	PROPOSE_SPEND_EVENT        = "ProposeSpend"
+	VOTE_PROPOSAL_EVENT        = "VoteProposal"
+	EXECUTE_SPEND_FAILED_EVENT = "ExecuteSpendFailed"
 
 	MaxContentLength int = 4000
 )
@@ -47,11 +52,15 @@ var (
 		MethodPropose:                  979125,
 		MethodProposeConfig:            756000,
 		MethodProposeCommunity:         693000,
+		MethodProposeEconomic:          693000,
+		MethodProposeSpend:             693000,
 		MethodVoteProposal:             603750,
 		MethodGetProposal:              118125,
 		MethodGetProposalList:          94500,
 		MethodGetConfigProposalList:    73500,
 		MethodGetCommunityProposalList: 84000,
+		MethodGetEconomicProposalList:  84000,
+		MethodGetSpendProposalList:     84000,
 	}
 )
 
@@ -66,11 +75,15 @@ func RegisterProposalManagerContract(s *native.NativeContract) {
 	s.Register(MethodPropose, Propose)
 	s.Register(MethodProposeConfig, ProposeConfig)
 	s.Register(MethodProposeCommunity, ProposeCommunity)
+	s.Register(MethodProposeEconomic, ProposeEconomic)
+	s.Register(MethodProposeSpend, ProposeSpend)
 	s.Register(MethodVoteProposal, VoteProposal)
 	s.Register(MethodGetProposal, GetProposal)
 	s.Register(MethodGetProposalList, GetProposalList)
 	s.Register(MethodGetConfigProposalList, GetConfigProposalList)
 	s.Register(MethodGetCommunityProposalList, GetCommunityProposalList)
+	s.Register(MethodGetEconomicProposalList, GetEconomicProposalList)
+	s.Register(MethodGetSpendProposalList, GetSpendProposalList)
 }
 
 func Propose(s *native.NativeContract) ([]byte, error) {
@@ -183,12 +196,6 @@ func ProposeConfig(s *native.NativeContract) ([]byte, error) {
 		return nil, fmt.Errorf("ProposeConfig, deserialize global config error: %v", err)
 	}
 
-	if config.ConsensusValidatorNum != 0 && config.ConsensusValidatorNum < node_manager.GenesisConsensusValidatorNum {
-		return nil, fmt.Errorf("ProposeConfig, consensus num is less than %d", node_manager.GenesisConsensusValidatorNum)
-	}
-	if config.BlockPerEpoch.Cmp(node_manager.MinBlockPerEpoch) < 0 {
-		return nil, fmt.Errorf("ProposeConfig, block per epoch is less than %d", node_manager.MinBlockPerEpoch)
-	}
 	if config.MaxCommissionChange.Cmp(node_manager.GenesisMaxCommissionChange) > 0 {
 		return nil, fmt.Errorf("ProposeConfig, MaxCommissionChange is more than %d", node_manager.GenesisMaxCommissionChange)
 	}
@@ -284,6 +291,9 @@ func ProposeCommunity(s *native.NativeContract) ([]byte, error) {
 	if info.CommunityRate.Cmp(node_manager.PercentDecimal) == 1 {
 		return nil, fmt.Errorf("UpdateCommission, communityRate can not more than 100 percent")
 	}
+	if info.BaseFeeRate != nil && (info.BaseFeeRate.Sign() == -1 || info.BaseFeeRate.Cmp(node_manager.PercentDecimal) == 1) {
+		return nil, fmt.Errorf("ProposeCommunity, baseFeeRate must be between 0 and 100 percent")
+	}
 
 	// remove expired proposal
 	err = removeExpiredFromCommunityProposalList(s)
@@ -329,6 +339,175 @@ func ProposeCommunity(s *native.NativeContract) ([]byte, error) {
 	return utils.PackOutputs(ABI, MethodProposeCommunity, true)
 }
 
+func ProposeEconomic(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	height := s.ContractRef().BlockHeight()
+	caller := ctx.Caller
+	value := s.ContractRef().Value()
+	toAddress := s.ContractRef().TxTo()
+
+	if ctx.Caller != s.ContractRef().TxOrigin() {
+		return nil, fmt.Errorf("ProposeEconomic, contract call forbidden")
+	}
+	globalConfig, err := node_manager.GetGlobalConfigImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, GetGlobalConfigImpl error: %v", err)
+	}
+	if toAddress != utils.ProposalManagerContractAddress {
+		return nil, fmt.Errorf("ProposeEconomic, to address %x must be proposal manager contract address %x", toAddress, utils.ProposalManagerContractAddress)
+	}
+	if value.Cmp(globalConfig.MinProposalStake) == -1 {
+		return nil, fmt.Errorf("ProposeEconomic, value is less than globalConfig.MinProposalStake")
+	}
+
+	params := &ProposeEconomicParam{}
+	if err := utils.UnpackMethod(ABI, MethodProposeEconomic, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, unpack params error: %v", err)
+	}
+
+	if len(params.Content) > MaxContentLength {
+		return nil, fmt.Errorf("ProposeEconomic, content is more than max length")
+	}
+
+	// the proposal replaces the whole schedule, and the genesis supply is never changed
+	config := new(economic.EconomicConfig)
+	err = rlp.DecodeBytes(params.Content, config)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, deserialize economic config error: %v", err)
+	}
+	if err := economic.CheckEconomicConfig(config); err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, %v", err)
+	}
+
+	// remove expired proposal
+	err = removeExpiredFromEconomicProposalList(s)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, removeExpiredFromEconomicProposalList error: %v", err)
+	}
+
+	proposalID, err := getProposalID(s)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, getProposalID error: %v", err)
+	}
+	economicProposalList, err := getEconomicProposalList(s)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, getEconomicProposalList error: %v", err)
+	}
+	if len(economicProposalList.EconomicProposalList) >= ProposalListLen {
+		return nil, fmt.Errorf("ProposeEconomic, proposal is more than max length %d", ProposalListLen)
+	}
+	proposal := &Proposal{
+		ID:        proposalID,
+		Address:   ctx.Caller,
+		Type:      UpdateEconomicConfig,
+		Content:   params.Content,
+		EndHeight: new(big.Int).Add(height, globalConfig.BlockPerEpoch),
+		Stake:     value,
+	}
+	economicProposalList.EconomicProposalList = append(economicProposalList.EconomicProposalList, proposal.ID)
+	err = setEconomicProposalList(s, economicProposalList)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, setEconomicProposalList error: %v", err)
+	}
+	err = setProposal(s, proposal)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, setProposal error: %v", err)
+	}
+	setProposalID(s, new(big.Int).Add(proposalID, common.Big1))
+
+	err = s.AddNotify(ABI, []string{PROPOSE_ECONOMIC_EVENT}, proposal.ID.String(), caller.Hex(), proposal.Stake.String(), hex.EncodeToString(params.Content))
+	if err != nil {
+		return nil, fmt.Errorf("ProposeEconomic, AddNotify error: %v", err)
+	}
+
+	return utils.PackOutputs(ABI, MethodProposeEconomic, true)
+}
+
+// ProposeSpend proposes to spend from treasury, the spend proposals are independent of
+// each other, and the amount is transferred only when the proposal passes.
+func ProposeSpend(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	height := s.ContractRef().BlockHeight()
+	caller := ctx.Caller
+	value := s.ContractRef().Value()
+	toAddress := s.ContractRef().TxTo()
+
+	if ctx.Caller != s.ContractRef().TxOrigin() {
+		return nil, fmt.Errorf("ProposeSpend, contract call forbidden")
+	}
+	globalConfig, err := node_manager.GetGlobalConfigImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeSpend, GetGlobalConfigImpl error: %v", err)
+	}
+	if toAddress != utils.ProposalManagerContractAddress {
+		return nil, fmt.Errorf("ProposeSpend, to address %x must be proposal manager contract address %x", toAddress, utils.ProposalManagerContractAddress)
+	}
+	if value.Cmp(globalConfig.MinProposalStake) == -1 {
+		return nil, fmt.Errorf("ProposeSpend, value is less than globalConfig.MinProposalStake")
+	}
+
+	params := &ProposeSpendParam{}
+	if err := utils.UnpackMethod(ABI, MethodProposeSpend, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("ProposeSpend, unpack params error: %v", err)
+	}
+
+	if len(params.Content) > MaxContentLength {
+		return nil, fmt.Errorf("ProposeSpend, content is more than max length")
+	}
+
+	spend := new(treasury.Spend)
+	err = rlp.DecodeBytes(params.Content, spend)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeSpend, deserialize spend error: %v", err)
+	}
+	if err := treasury.CheckSpend(s, spend); err != nil {
+		return nil, fmt.Errorf("ProposeSpend, %v", err)
+	}
+
+	// remove expired proposal
+	err = removeExpiredFromSpendProposalList(s)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeSpend, removeExpiredFromSpendProposalList error: %v", err)
+	}
+
+	proposalID, err := getProposalID(s)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeSpend, getProposalID error: %v", err)
+	}
+	spendProposalList, err := getSpendProposalList(s)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeSpend, getSpendProposalList error: %v", err)
+	}
+	if len(spendProposalList.SpendProposalList) >= ProposalListLen {
+		return nil, fmt.Errorf("ProposeSpend, proposal is more than max length %d", ProposalListLen)
+	}
+	proposal := &Proposal{
+		ID:        proposalID,
+		Address:   ctx.Caller,
+		Type:      SpendTreasury,
+		Content:   params.Content,
+		EndHeight: new(big.Int).Add(height, globalConfig.BlockPerEpoch),
+		Stake:     value,
+	}
+	spendProposalList.SpendProposalList = append(spendProposalList.SpendProposalList, proposal.ID)
+	err = setSpendProposalList(s, spendProposalList)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeSpend, setSpendProposalList error: %v", err)
+	}
+	err = setProposal(s, proposal)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeSpend, setProposal error: %v", err)
+	}
+	setProposalID(s, new(big.Int).Add(proposalID, common.Big1))
+
+	err = s.AddNotify(ABI, []string{PROPOSE_SPEND_EVENT}, proposal.ID.String(), caller.Hex(), proposal.Stake.String(), hex.EncodeToString(params.Content))
+	if err != nil {
+		return nil, fmt.Errorf("ProposeSpend, AddNotify error: %v", err)
+	}
+
+	return utils.PackOutputs(ABI, MethodProposeSpend, true)
+}
+
 func VoteProposal(s *native.NativeContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
 	caller := ctx.Caller
@@ -343,7 +522,7 @@ func VoteProposal(s *native.NativeContract) ([]byte, error) {
 		return nil, fmt.Errorf("VoteProposal, getProposal error: %v", err)
 	}
 
-	if proposal.Status == PASS {
+	if proposal.Status == PASS || proposal.Status == EXECUTEFAIL {
 		return utils.PackOutputs(ABI, MethodVoteProposal, true)
 	}
 	if proposal.Status == FAIL || proposal.EndHeight.Cmp(s.ContractRef().BlockHeight()) < 0 {
@@ -363,7 +542,7 @@ func VoteProposal(s *native.NativeContract) ([]byte, error) {
 		}
 
 		// transfer token
-		err = contract.NativeTransfer(s.StateDB(), this, proposal.Address, proposal.Stake)
+		err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, proposal.Address, proposal.Stake)
 		if err != nil {
 			return nil, fmt.Errorf("Propose, utils.NativeTransfer error: %v", err)
 		}
@@ -385,7 +564,7 @@ func VoteProposal(s *native.NativeContract) ([]byte, error) {
 			if err != nil {
 				return nil, fmt.Errorf("VoteProposal, node_manager.GetGlobalConfigImpl error: %v", err)
 			}
-			if config.ConsensusValidatorNum >= node_manager.GenesisConsensusValidatorNum {
+			if config.ConsensusValidatorNum > 0 {
 				globalConfig.ConsensusValidatorNum = config.ConsensusValidatorNum
 			}
 			if config.VoterValidatorNum > 0 {
@@ -394,7 +573,7 @@ func VoteProposal(s *native.NativeContract) ([]byte, error) {
 			if globalConfig.ConsensusValidatorNum < globalConfig.VoterValidatorNum {
 				globalConfig.VoterValidatorNum = globalConfig.ConsensusValidatorNum
 			}
-			if config.BlockPerEpoch.Cmp(node_manager.MinBlockPerEpoch) > 0 {
+			if config.BlockPerEpoch.Sign() > 0 {
 				globalConfig.BlockPerEpoch = config.BlockPerEpoch
 			}
 			if config.MaxCommissionChange.Cmp(node_manager.GenesisMaxCommissionChange) < 0 {
@@ -429,7 +608,7 @@ func VoteProposal(s *native.NativeContract) ([]byte, error) {
 					}
 
 					// transfer token to community pool
-					err = contract.NativeTransfer(s.StateDB(), this, communityInfo.CommunityAddress, p.Stake)
+					err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, communityInfo.CommunityAddress, p.Stake)
 					if err != nil {
 						return nil, fmt.Errorf("Propose, utils.NativeTransfer error: %v", err)
 					}
@@ -453,6 +632,9 @@ func VoteProposal(s *native.NativeContract) ([]byte, error) {
 			if info.CommunityRate.Sign() > 0 {
 				communityInfo.CommunityRate = info.CommunityRate
 			}
+			if info.BaseFeeRate != nil {
+				communityInfo.BaseFeeRate = info.BaseFeeRate
+			}
 			err = community.SetCommunityInfo(s, communityInfo)
 			if err != nil {
 				return nil, fmt.Errorf("VoteProposal, node_manager.SetCommunityInfo error: %v", err)
@@ -476,7 +658,7 @@ func VoteProposal(s *native.NativeContract) ([]byte, error) {
 					}
 
 					// transfer token to community pool
-					err = contract.NativeTransfer(s.StateDB(), this, communityInfo.CommunityAddress, p.Stake)
+					err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, communityInfo.CommunityAddress, p.Stake)
 					if err != nil {
 						return nil, fmt.Errorf("Propose, utils.NativeTransfer error: %v", err)
 					}
@@ -488,6 +670,74 @@ func VoteProposal(s *native.NativeContract) ([]byte, error) {
 			if err != nil {
 				return nil, fmt.Errorf("VoteProposal, cleanCommunityProposalList error: %v", err)
 			}
+		case UpdateEconomicConfig:
+			config := new(economic.EconomicConfig)
+			err := rlp.DecodeBytes(proposal.Content, config)
+			if err != nil {
+				return nil, fmt.Errorf("VoteProposal, deserialize economic config error: %v", err)
+			}
+			err = economic.SetEconomicConfig(s, config)
+			if err != nil {
+				return nil, fmt.Errorf("VoteProposal, economic.SetEconomicConfig error: %v", err)
+			}
+
+			// change other economic proposal to fail
+			economicProposalList, err := getEconomicProposalList(s)
+			if err != nil {
+				return nil, fmt.Errorf("VoteProposal, getEconomicProposalList error: %v", err)
+			}
+			for _, ID := range economicProposalList.EconomicProposalList {
+				if ID.Cmp(proposal.ID) != 0 {
+					p, err := getProposal(s, ID)
+					if err != nil {
+						return nil, fmt.Errorf("VoteProposal, getProposal economic error: %v", err)
+					}
+					p.Status = FAIL
+					err = setProposal(s, p)
+					if err != nil {
+						return nil, fmt.Errorf("VoteProposal, setProposal economic error: %v", err)
+					}
+
+					// transfer token to community pool
+					err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, communityInfo.CommunityAddress, p.Stake)
+					if err != nil {
+						return nil, fmt.Errorf("Propose, utils.NativeTransfer error: %v", err)
+					}
+				}
+			}
+
+			// remove from economic proposal list
+			err = cleanEconomicProposalList(s)
+			if err != nil {
+				return nil, fmt.Errorf("VoteProposal, cleanEconomicProposalList error: %v", err)
+			}
+		case SpendTreasury:
+			spend := new(treasury.Spend)
+			err := rlp.DecodeBytes(proposal.Content, spend)
+			if err != nil {
+				return nil, fmt.Errorf("VoteProposal, deserialize spend error: %v", err)
+			}
+			// the vote is kept even if the spend can not be executed, the changes of the
+			// spend are reverted and the proposal is marked as execute failed
+			snapshot := s.StateDB().Snapshot()
+			if _, execErr := treasury.ExecuteSpend(s, proposal.ID, spend); execErr != nil {
+				s.StateDB().RevertToSnapshot(snapshot)
+				proposal.Status = EXECUTEFAIL
+				err = setProposal(s, proposal)
+				if err != nil {
+					return nil, fmt.Errorf("VoteProposal, setProposal spend error: %v", err)
+				}
+				err = s.AddNotify(ABI, []string{EXECUTE_SPEND_FAILED_EVENT}, proposal.ID.String(), execErr.Error())
+				if err != nil {
+					return nil, fmt.Errorf("VoteProposal, AddNotify error: %v", err)
+				}
+			}
+
+			// remove from spend proposal list
+			err = removeFromSpendProposalList(s, params.ID)
+			if err != nil {
+				return nil, fmt.Errorf("VoteProposal, removeFromSpendProposalList error: %v", err)
+			}
 		case Normal:
 			// remove from proposal list
 			err = removeFromProposalList(s, params.ID)
@@ -561,3 +811,29 @@ func GetCommunityProposalList(s *native.NativeContract) ([]byte, error) {
 	}
 	return utils.PackOutputs(ABI, MethodGetCommunityProposalList, enc)
 }
+
+func GetEconomicProposalList(s *native.NativeContract) ([]byte, error) {
+	economicProposalList, err := getEconomicProposalList(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetEconomicProposalList, getEconomicProposalList error: %v", err)
+	}
+
+	enc, err := rlp.EncodeToBytes(economicProposalList)
+	if err != nil {
+		return nil, fmt.Errorf("GetEconomicProposalList, serialize economic proposal list error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodGetEconomicProposalList, enc)
+}
+
+func GetSpendProposalList(s *native.NativeContract) ([]byte, error) {
+	spendProposalList, err := getSpendProposalList(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetSpendProposalList, getSpendProposalList error: %v", err)
+	}
+
+	enc, err := rlp.EncodeToBytes(spendProposalList)
+	if err != nil {
+		return nil, fmt.Errorf("GetSpendProposalList, serialize spend proposal list error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodGetSpendProposalList, enc)
+}
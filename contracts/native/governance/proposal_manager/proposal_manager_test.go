@@ -30,8 +30,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/economic"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/community"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/treasury"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
@@ -84,7 +87,7 @@ func TestProposalManager(t *testing.T) {
 		param.Content = make([]byte, 4000)
 		input, err := param.Encode()
 		assert.Nil(t, err)
-		err = contract.NativeTransfer(contractRef.StateDB(), common.EmptyAddress, this, value)
+		err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), common.EmptyAddress, this, value)
 		assert.Nil(t, err)
 		_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "Propose", input, value, common.EmptyAddress, common.EmptyAddress, 1, extra, sdb)
 		assert.Nil(t, err)
@@ -93,12 +96,12 @@ func TestProposalManager(t *testing.T) {
 	// Propose config
 	param2 := new(ProposeConfigParam)
 	globalConfig.VoterValidatorNum = 2
-	globalConfig.BlockPerEpoch = big.NewInt(10000)
+	globalConfig.BlockPerEpoch = big.NewInt(100)
 	param2.Content, err = rlp.EncodeToBytes(globalConfig)
 	assert.Nil(t, err)
 	input, err := param2.Encode()
 	assert.Nil(t, err)
-	err = contract.NativeTransfer(contractRef.StateDB(), common.EmptyAddress, this, value)
+	err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), common.EmptyAddress, this, value)
 	assert.Nil(t, err)
 	_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "ProposeConfig", input, value, common.EmptyAddress, common.EmptyAddress, 1, extra, sdb)
 	assert.Nil(t, err)
@@ -110,20 +113,31 @@ func TestProposalManager(t *testing.T) {
 		assert.Nil(t, err)
 		input, err := param.Encode()
 		assert.Nil(t, err)
-		err = contract.NativeTransfer(contractRef.StateDB(), common.EmptyAddress, this, value)
+		err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), common.EmptyAddress, this, value)
 		assert.Nil(t, err)
 		_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "ProposeConfig", input, value, common.EmptyAddress, common.EmptyAddress, 1, extra, sdb)
 		assert.Nil(t, err)
 	}
 
+	// Propose community with invalid base fee rate
+	invalidInfo := &community.CommunityInfo{CommunityRate: new(big.Int).SetUint64(1000), BaseFeeRate: new(big.Int).SetUint64(10001)}
+	invalidParam := new(ProposeCommunityParam)
+	invalidParam.Content, err = rlp.EncodeToBytes(invalidInfo)
+	assert.Nil(t, err)
+	input, err = invalidParam.Encode()
+	assert.Nil(t, err)
+	_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "ProposeCommunity", input, value, common.EmptyAddress, common.EmptyAddress, 1, extra, sdb)
+	assert.NotNil(t, err)
+
 	// Propose community
 	param3 := new(ProposeCommunityParam)
 	communityInfo.CommunityRate = new(big.Int).SetUint64(1000)
+	communityInfo.BaseFeeRate = new(big.Int).SetUint64(5000)
 	param3.Content, err = rlp.EncodeToBytes(communityInfo)
 	assert.Nil(t, err)
 	input, err = param3.Encode()
 	assert.Nil(t, err)
-	err = contract.NativeTransfer(contractRef.StateDB(), common.EmptyAddress, this, value)
+	err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), common.EmptyAddress, this, value)
 	assert.Nil(t, err)
 	_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "ProposeCommunity", input, value, common.EmptyAddress, common.EmptyAddress, 1, extra, sdb)
 	assert.Nil(t, err)
@@ -131,11 +145,12 @@ func TestProposalManager(t *testing.T) {
 	for i := 0; i < ProposalListLen-1; i++ {
 		param := new(ProposeCommunityParam)
 		communityInfo.CommunityRate = new(big.Int).SetUint64(3000)
+		communityInfo.BaseFeeRate = nil
 		param.Content, err = rlp.EncodeToBytes(communityInfo)
 		assert.Nil(t, err)
 		input, err := param.Encode()
 		assert.Nil(t, err)
-		err = contract.NativeTransfer(contractRef.StateDB(), common.EmptyAddress, this, value)
+		err = contract.NativeTransfer(contractRef.StateDB(), contractRef.BlockHeight(), common.EmptyAddress, this, value)
 		assert.Nil(t, err)
 		_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "ProposeCommunity", input, value, common.EmptyAddress, common.EmptyAddress, 1, extra, sdb)
 		assert.Nil(t, err)
@@ -260,9 +275,155 @@ func TestProposalManager(t *testing.T) {
 	globalConfig, err = node_manager.GetGlobalConfigImpl(c)
 	assert.Nil(t, err)
 	assert.Equal(t, globalConfig.VoterValidatorNum, uint64(2))
+	assert.Equal(t, globalConfig.BlockPerEpoch, big.NewInt(100))
 	communityInfo, err = community.GetCommunityInfoImpl(c)
 	assert.Nil(t, err)
 	assert.Equal(t, communityInfo.CommunityRate, big.NewInt(1000))
+	assert.Equal(t, communityInfo.BaseFeeRate, big.NewInt(5000))
 	assert.Equal(t, sdb.GetBalance(common.EmptyAddress), new(big.Int).Mul(big.NewInt(9981000), params.ZNT1))
 	assert.Equal(t, sdb.GetBalance(communityInfo.CommunityAddress), new(big.Int).Mul(big.NewInt(9981000), params.ZNT1))
 }
+
+func TestProposeEconomic(t *testing.T) {
+	db := native.NewTestStateDB()
+	community.StoreCommunityInfo(db, big.NewInt(2000), common.EmptyAddress)
+	node_manager.StoreGenesisEpoch(db, testGenesisPeers, testGenesisPeers)
+	node_manager.StoreGenesisGlobalConfig(db)
+
+	extra := uint64(21000000000000)
+	value := new(big.Int).Mul(big.NewInt(1000), params.ZNT1)
+	db.SetBalance(common.EmptyAddress, new(big.Int).Mul(big.NewInt(10000), params.ZNT1))
+	propose := func(config *economic.EconomicConfig) error {
+		param := new(ProposeEconomicParam)
+		content, err := rlp.EncodeToBytes(config)
+		assert.Nil(t, err)
+		param.Content = content
+		input, err := param.Encode()
+		assert.Nil(t, err)
+		assert.Nil(t, contract.NativeTransfer(db, common.Big0, common.EmptyAddress, this, value))
+		_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "ProposeEconomic", input, value, common.EmptyAddress, common.EmptyAddress, 1, extra, db)
+		return err
+	}
+
+	config := economic.DefaultEconomicConfig()
+	config.DecayRate = big.NewInt(10001)
+	assert.NotNil(t, propose(config))
+
+	config.RewardPerBlock = new(big.Int).Mul(big.NewInt(2), params.ZNT1)
+	config.DecayInterval = big.NewInt(100)
+	config.DecayRate = big.NewInt(5000)
+	assert.Nil(t, propose(config))
+	config.DecayInterval = big.NewInt(200)
+	assert.Nil(t, propose(config))
+
+	param := new(GetEconomicProposalListParam)
+	input, err := param.Encode()
+	assert.Nil(t, err)
+	ret, err := native.TestNativeCall(t, utils.ProposalManagerContractAddress, "GetEconomicProposalList", input, new(big.Int), common.EmptyAddress, common.EmptyAddress, 1, extra, db)
+	assert.Nil(t, err)
+	economicProposalList := new(EconomicProposalList)
+	assert.Nil(t, economicProposalList.Decode(ret))
+	assert.Equal(t, 2, len(economicProposalList.EconomicProposalList))
+
+	vote := &VoteProposalParam{ID: economicProposalList.EconomicProposalList[0]}
+	input, err = vote.Encode()
+	assert.Nil(t, err)
+	for i := 0; i < testGenesisNum; i++ {
+		_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "VoteProposal", input, new(big.Int), testGenesisPeers[i], testGenesisPeers[i], 1, extra, db)
+		assert.Nil(t, err)
+	}
+
+	_, c := native.GenerateTestContext(t, common.Big0, this, 1, db)
+	p, err := getProposal(c, economicProposalList.EconomicProposalList[1])
+	assert.Nil(t, err)
+	assert.Equal(t, FAIL, p.Status)
+	economicProposalList, err = getEconomicProposalList(c)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(economicProposalList.EconomicProposalList))
+
+	// the new reward takes effect from the block of vote
+	got, err := economic.GetEconomicConfigImpl(c)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), got.DecayInterval)
+	assert.Equal(t, params.GenesisSupply, got.GenesisSupply)
+	inflation, err := economic.GetInflationImpl(c)
+	assert.Nil(t, err)
+	assert.Equal(t, config.RewardPerBlock, inflation.RewardPerBlock)
+	assert.Equal(t, new(big.Int).Add(params.GenesisSupply, config.RewardPerBlock), inflation.SupplyAt(common.Big1))
+}
+
+func TestProposeSpend(t *testing.T) {
+	db := native.NewTestStateDB()
+	community.StoreCommunityInfo(db, big.NewInt(2000), utils.TreasuryContractAddress)
+	node_manager.StoreGenesisEpoch(db, testGenesisPeers, testGenesisPeers)
+	node_manager.StoreGenesisGlobalConfig(db)
+
+	extra := uint64(21000000000000)
+	value := new(big.Int).Mul(big.NewInt(1000), params.ZNT1)
+	funds := new(big.Int).Mul(big.NewInt(500), params.ZNT1)
+	db.SetBalance(common.EmptyAddress, new(big.Int).Mul(big.NewInt(10000), params.ZNT1))
+	db.SetBalance(utils.TreasuryContractAddress, funds)
+	propose := func(spend *treasury.Spend) error {
+		param := new(ProposeSpendParam)
+		content, err := rlp.EncodeToBytes(spend)
+		assert.Nil(t, err)
+		param.Content = content
+		input, err := param.Encode()
+		assert.Nil(t, err)
+		assert.Nil(t, contract.NativeTransfer(db, common.Big0, common.EmptyAddress, this, value))
+		_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "ProposeSpend", input, value, common.EmptyAddress, common.EmptyAddress, 1, extra, db)
+		return err
+	}
+	vote := func(ID *big.Int) error {
+		input, err := (&VoteProposalParam{ID: ID}).Encode()
+		assert.Nil(t, err)
+		for i := 0; i < testGenesisNum; i++ {
+			_, err = native.TestNativeCall(t, utils.ProposalManagerContractAddress, "VoteProposal", input, new(big.Int), testGenesisPeers[i], testGenesisPeers[i], 1, extra, db)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	grantee, investor := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	amount := new(big.Int).Mul(big.NewInt(100), params.ZNT1)
+	assert.NotNil(t, propose(&treasury.Spend{Recipient: common.EmptyAddress, Amount: amount}))
+	assert.NotNil(t, propose(&treasury.Spend{Recipient: grantee, Amount: common.Big0}))
+	assert.NotNil(t, propose(&treasury.Spend{Recipient: investor, Amount: amount, StartHeight: big.NewInt(10), CliffHeight: big.NewInt(5), EndHeight: big.NewInt(100)}))
+
+	assert.Nil(t, propose(&treasury.Spend{Recipient: grantee, Amount: amount}))
+	assert.Nil(t, propose(&treasury.Spend{Recipient: investor, Amount: amount, StartHeight: big.NewInt(0), CliffHeight: big.NewInt(0), EndHeight: big.NewInt(100)}))
+	assert.Nil(t, propose(&treasury.Spend{Recipient: grantee, Amount: new(big.Int).Add(funds, common.Big1)}))
+
+	input, err := new(GetSpendProposalListParam).Encode()
+	assert.Nil(t, err)
+	ret, err := native.TestNativeCall(t, utils.ProposalManagerContractAddress, "GetSpendProposalList", input, new(big.Int), common.EmptyAddress, common.EmptyAddress, 1, extra, db)
+	assert.Nil(t, err)
+	spendProposalList := new(SpendProposalList)
+	assert.Nil(t, spendProposalList.Decode(ret))
+	assert.Equal(t, 3, len(spendProposalList.SpendProposalList))
+	// nothing is spent before the proposals pass
+	assert.Equal(t, funds, db.GetBalance(utils.TreasuryContractAddress))
+
+	// the spends are independent of each other
+	assert.Nil(t, vote(spendProposalList.SpendProposalList[0]))
+	assert.Nil(t, vote(spendProposalList.SpendProposalList[1]))
+	// the vote of spend exceeding treasury balance is kept
+	assert.Nil(t, vote(spendProposalList.SpendProposalList[2]))
+	assert.Equal(t, amount, db.GetBalance(grantee))
+	assert.Equal(t, amount, db.GetBalance(investor))
+	assert.Equal(t, new(big.Int).Div(amount, big.NewInt(2)), vesting.LockedBalance(db, investor, big.NewInt(50)))
+	assert.Equal(t, new(big.Int).Sub(funds, new(big.Int).Mul(amount, big.NewInt(2))), db.GetBalance(utils.TreasuryContractAddress))
+
+	_, c := native.GenerateTestContext(t, common.Big0, this, 1, db)
+	for i, status := range []Status{PASS, PASS, EXECUTEFAIL} {
+		proposal, err := getProposal(c, spendProposalList.SpendProposalList[i])
+		assert.Nil(t, err)
+		assert.Equal(t, status, proposal.Status)
+	}
+	assert.Nil(t, vote(spendProposalList.SpendProposalList[2]))
+	spendProposalList, err = getSpendProposalList(c)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(spendProposalList.SpendProposalList))
+}
@@ -39,6 +39,8 @@ const (
 	SKP_PROPOSAL_LIST           = "st_proposal_list"
 	SKP_CONFIG_PROPOSAL_LIST    = "st_config_proposal_list"
 	SKP_COMMUNITY_PROPOSAL_LIST = "st_community_proposal_list"
+	SKP_ECONOMIC_PROPOSAL_LIST  = "st_economic_proposal_list"
+	SKP_SPEND_PROPOSAL_LIST     = "st_spend_proposal_list"
 )
 
 func getProposalID(s *native.NativeContract) (*big.Int, error) {
@@ -133,7 +135,7 @@ func removeExpiredFromProposalList(s *native.NativeContract) error {
 			j++
 		} else {
 			// transfer token to community pool
-			err = contract.NativeTransfer(s.StateDB(), this, communityInfo.CommunityAddress, proposal.Stake)
+			err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, communityInfo.CommunityAddress, proposal.Stake)
 			if err != nil {
 				return fmt.Errorf("removeExpiredFromProposalList, utils.NativeTransfer error: %v", err)
 			}
@@ -208,7 +210,7 @@ func removeExpiredFromConfigProposalList(s *native.NativeContract) error {
 			j++
 		} else {
 			// transfer token to community pool
-			err = contract.NativeTransfer(s.StateDB(), this, communityInfo.CommunityAddress, proposal.Stake)
+			err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, communityInfo.CommunityAddress, proposal.Stake)
 			if err != nil {
 				return fmt.Errorf("removeExpiredFromConfigProposalList, utils.NativeTransfer error: %v", err)
 			}
@@ -283,7 +285,7 @@ func removeExpiredFromCommunityProposalList(s *native.NativeContract) error {
 			j++
 		} else {
 			// transfer token to community pool
-			err = contract.NativeTransfer(s.StateDB(), this, communityInfo.CommunityAddress, proposal.Stake)
+			err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, communityInfo.CommunityAddress, proposal.Stake)
 			if err != nil {
 				return fmt.Errorf("removeExpiredFromCommunityProposalList, utils.NativeTransfer error: %v", err)
 			}
@@ -297,6 +299,169 @@ func removeExpiredFromCommunityProposalList(s *native.NativeContract) error {
 	return nil
 }
 
+func getEconomicProposalList(s *native.NativeContract) (*EconomicProposalList, error) {
+	economicProposalList := &EconomicProposalList{
+		make([]*big.Int, 0),
+	}
+	key := economicProposalListKey()
+	store, err := get(s, key)
+	if err == ErrEof {
+		return economicProposalList, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getEconomicProposalList, get store error: %v", err)
+	}
+	if err := rlp.DecodeBytes(store, economicProposalList); err != nil {
+		return nil, fmt.Errorf("getEconomicProposalList, deserialize economic proposal list error: %v", err)
+	}
+	return economicProposalList, nil
+}
+
+func setEconomicProposalList(s *native.NativeContract, economicProposalList *EconomicProposalList) error {
+	key := economicProposalListKey()
+	store, err := rlp.EncodeToBytes(economicProposalList)
+	if err != nil {
+		return fmt.Errorf("setEconomicProposalList, serialize economic proposal list error: %v", err)
+	}
+	set(s, key, store)
+	return nil
+}
+
+func cleanEconomicProposalList(s *native.NativeContract) error {
+	err := setEconomicProposalList(s, &EconomicProposalList{make([]*big.Int, 0)})
+	if err != nil {
+		return fmt.Errorf("cleanEconomicProposalList, setEconomicProposalList error: %v", err)
+	}
+	return nil
+}
+
+func removeExpiredFromEconomicProposalList(s *native.NativeContract) error {
+	communityInfo, err := community.GetCommunityInfoImpl(s)
+	if err != nil {
+		return fmt.Errorf("removeExpiredFromEconomicProposalList, node_manager.GetCommunityInfoImpl error: %v", err)
+	}
+
+	economicProposalList, err := getEconomicProposalList(s)
+	if err != nil {
+		return fmt.Errorf("removeExpiredFromEconomicProposalList, getEconomicProposalList error: %v", err)
+	}
+	if len(economicProposalList.EconomicProposalList) == 0 {
+		return nil
+	}
+
+	j := 0
+	for _, proposalID := range economicProposalList.EconomicProposalList {
+		proposal, err := getProposal(s, proposalID)
+		if err != nil {
+			return fmt.Errorf("removeExpiredFromEconomicProposalList, getProposal error: %v", err)
+		}
+		if proposal.EndHeight.Cmp(s.ContractRef().BlockHeight()) > 0 {
+			economicProposalList.EconomicProposalList[j] = proposalID
+			j++
+		} else {
+			// transfer token to community pool
+			err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, communityInfo.CommunityAddress, proposal.Stake)
+			if err != nil {
+				return fmt.Errorf("removeExpiredFromEconomicProposalList, utils.NativeTransfer error: %v", err)
+			}
+		}
+	}
+	economicProposalList.EconomicProposalList = economicProposalList.EconomicProposalList[:j]
+	err = setEconomicProposalList(s, economicProposalList)
+	if err != nil {
+		return fmt.Errorf("removeExpiredFromEconomicProposalList, setEconomicProposalList error: %v", err)
+	}
+	return nil
+}
+
+func getSpendProposalList(s *native.NativeContract) (*SpendProposalList, error) {
+	spendProposalList := &SpendProposalList{
+		make([]*big.Int, 0),
+	}
+	key := spendProposalListKey()
+	store, err := get(s, key)
+	if err == ErrEof {
+		return spendProposalList, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getSpendProposalList, get store error: %v", err)
+	}
+	if err := rlp.DecodeBytes(store, spendProposalList); err != nil {
+		return nil, fmt.Errorf("getSpendProposalList, deserialize spend proposal list error: %v", err)
+	}
+	return spendProposalList, nil
+}
+
+func setSpendProposalList(s *native.NativeContract, spendProposalList *SpendProposalList) error {
+	key := spendProposalListKey()
+	store, err := rlp.EncodeToBytes(spendProposalList)
+	if err != nil {
+		return fmt.Errorf("setSpendProposalList, serialize spendProposalList error: %v", err)
+	}
+	set(s, key, store)
+	return nil
+}
+
+func removeFromSpendProposalList(s *native.NativeContract, ID *big.Int) error {
+	spendProposalList, err := getSpendProposalList(s)
+	if err != nil {
+		return fmt.Errorf("removeFromSpendProposalList, getSpendProposalList error: %v", err)
+	}
+
+	j := 0
+	for _, proposalID := range spendProposalList.SpendProposalList {
+		if proposalID.Cmp(ID) != 0 {
+			spendProposalList.SpendProposalList[j] = proposalID
+			j++
+		}
+	}
+	spendProposalList.SpendProposalList = spendProposalList.SpendProposalList[:j]
+	err = setSpendProposalList(s, spendProposalList)
+	if err != nil {
+		return fmt.Errorf("removeFromSpendProposalList, setSpendProposalList error: %v", err)
+	}
+	return nil
+}
+
+func removeExpiredFromSpendProposalList(s *native.NativeContract) error {
+	communityInfo, err := community.GetCommunityInfoImpl(s)
+	if err != nil {
+		return fmt.Errorf("removeExpiredFromSpendProposalList, node_manager.GetCommunityInfoImpl error: %v", err)
+	}
+
+	spendProposalList, err := getSpendProposalList(s)
+	if err != nil {
+		return fmt.Errorf("removeExpiredFromSpendProposalList, getSpendProposalList error: %v", err)
+	}
+	if len(spendProposalList.SpendProposalList) == 0 {
+		return nil
+	}
+
+	j := 0
+	for _, proposalID := range spendProposalList.SpendProposalList {
+		proposal, err := getProposal(s, proposalID)
+		if err != nil {
+			return fmt.Errorf("removeExpiredFromSpendProposalList, getProposal error: %v", err)
+		}
+		if proposal.EndHeight.Cmp(s.ContractRef().BlockHeight()) > 0 {
+			spendProposalList.SpendProposalList[j] = proposalID
+			j++
+		} else {
+			// transfer token to community pool
+			err = contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, communityInfo.CommunityAddress, proposal.Stake)
+			if err != nil {
+				return fmt.Errorf("removeExpiredFromSpendProposalList, utils.NativeTransfer error: %v", err)
+			}
+		}
+	}
+	spendProposalList.SpendProposalList = spendProposalList.SpendProposalList[:j]
+	err = setSpendProposalList(s, spendProposalList)
+	if err != nil {
+		return fmt.Errorf("removeExpiredFromSpendProposalList, setSpendProposalList error: %v", err)
+	}
+	return nil
+}
+
 func getProposal(s *native.NativeContract, ID *big.Int) (*Proposal, error) {
 	proposal := new(Proposal)
 	key := proposalKey(ID)
@@ -382,3 +547,11 @@ func configProposalListKey() []byte {
 func communityProposalListKey() []byte {
 	return utils.ConcatKey(this, []byte(SKP_COMMUNITY_PROPOSAL_LIST))
 }
+
+func economicProposalListKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_ECONOMIC_PROPOSAL_LIST))
+}
+
+func spendProposalListKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_SPEND_PROPOSAL_LIST))
+}
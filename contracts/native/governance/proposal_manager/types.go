@@ -12,13 +12,17 @@ type ProposalType uint8
 type Status uint8
 
 const (
-	Normal              ProposalType = 0
-	UpdateGlobalConfig  ProposalType = 1
-	UpdateCommunityInfo ProposalType = 2
+	Normal               ProposalType = 0
+	UpdateGlobalConfig   ProposalType = 1
+	UpdateCommunityInfo  ProposalType = 2
+	UpdateEconomicConfig ProposalType = 3
+	SpendTreasury        ProposalType = 4
 
 	NOTPASS Status = 0
 	PASS    Status = 1
 	FAIL    Status = 2
+	// the proposal passes but fails to be executed, e.g. the spend of treasury
+	EXECUTEFAIL Status = 3
 
 	ProposalListLen int = 20
 )
@@ -65,6 +69,34 @@ func (m *CommunityProposalList) Decode(payload []byte) error {
 	return rlp.DecodeBytes(data.ProposalList, m)
 }
 
+type EconomicProposalList struct {
+	EconomicProposalList []*big.Int
+}
+
+func (m *EconomicProposalList) Decode(payload []byte) error {
+	var data struct {
+		ProposalList []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGetEconomicProposalList, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.ProposalList, m)
+}
+
+type SpendProposalList struct {
+	SpendProposalList []*big.Int
+}
+
+func (m *SpendProposalList) Decode(payload []byte) error {
+	var data struct {
+		ProposalList []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGetSpendProposalList, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.ProposalList, m)
+}
+
 type Proposal struct {
 	ID        *big.Int
 	Address   common.Address
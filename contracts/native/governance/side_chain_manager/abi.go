@@ -21,6 +21,7 @@ package side_chain_manager
 import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native/go_abi/side_chain_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -88,6 +89,24 @@ func (m *UpdateFeeParam) Digest() ([]byte, error) {
 	return digest, nil
 }
 
+type UpdateFeeTokenParam struct {
+	ChainID  uint64
+	FeeToken common.Address
+}
+
+func (m *UpdateFeeTokenParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodUpdateFeeToken, m)
+}
+
+type UpdateRootRetentionParam struct {
+	ChainID uint64
+	Window  uint64
+}
+
+func (m *UpdateRootRetentionParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodUpdateRootRetention, m)
+}
+
 type RegisterAssetParam struct {
 	ChainID           uint64
 	AssetMapKey       []uint64
@@ -99,3 +118,14 @@ type RegisterAssetParam struct {
 func (m *RegisterAssetParam) Encode() ([]byte, error) {
 	return utils.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodRegisterAsset, m)
 }
+
+type RegisterRippleCurrencyParam struct {
+	ChainID   uint64
+	Currency  string
+	Issuer    []byte
+	Precision uint64
+}
+
+func (m *RegisterRippleCurrencyParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodRegisterRippleCurrency, m)
+}
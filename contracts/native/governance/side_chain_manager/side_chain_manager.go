@@ -19,11 +19,13 @@
 package side_chain_manager
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
+	"math"
 	"math/big"
 	"sort"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native/go_abi/side_chain_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/rubblelabs/ripple/data"
 )
 
 const (
@@ -42,6 +45,8 @@ const (
 	SIDE_CHAIN                = "sideChain"
 	FEE                       = "fee"
 	FEE_INFO                  = "feeInfo"
+	FEE_TOKEN                 = "feeToken"
+	ROOT_RETENTION            = "rootRetention"
 	ASSET_BIND                = "assetBind"
 
 	UPDATE_FEE_TIMEOUT = 100
@@ -58,8 +63,13 @@ var (
 		side_chain_manager_abi.MethodQuitSideChain:            635250,
 		side_chain_manager_abi.MethodApproveQuitSideChain:     223125,
 		side_chain_manager_abi.MethodRegisterAsset:            10751875,
+		side_chain_manager_abi.MethodRegisterRippleCurrency:   1270500,
 		side_chain_manager_abi.MethodUpdateFee:                5635625,
 		side_chain_manager_abi.MethodGetFee:                   3751875,
+		side_chain_manager_abi.MethodUpdateFeeToken:           1270500,
+		side_chain_manager_abi.MethodGetFeeToken:              3751875,
+		side_chain_manager_abi.MethodUpdateRootRetention:      1270500,
+		side_chain_manager_abi.MethodGetRootRetention:         3751875,
 	}
 
 	ABI *abi.ABI
@@ -82,8 +92,13 @@ func RegisterSideChainManagerContract(s *native.NativeContract) {
 	s.Register(side_chain_manager_abi.MethodQuitSideChain, QuitSideChain)
 	s.Register(side_chain_manager_abi.MethodApproveQuitSideChain, ApproveQuitSideChain)
 	s.Register(side_chain_manager_abi.MethodRegisterAsset, RegisterAsset)
+	s.Register(side_chain_manager_abi.MethodRegisterRippleCurrency, RegisterRippleCurrency)
 	s.Register(side_chain_manager_abi.MethodUpdateFee, UpdateFee)
 	s.Register(side_chain_manager_abi.MethodGetFee, GetFee)
+	s.Register(side_chain_manager_abi.MethodUpdateFeeToken, UpdateFeeToken)
+	s.Register(side_chain_manager_abi.MethodGetFeeToken, GetFeeToken)
+	s.Register(side_chain_manager_abi.MethodUpdateRootRetention, UpdateRootRetention)
+	s.Register(side_chain_manager_abi.MethodGetRootRetention, GetRootRetention)
 }
 
 func GetSideChain(s *native.NativeContract) ([]byte, error) {
@@ -359,11 +374,11 @@ func RegisterAsset(s *native.NativeContract) ([]byte, error) {
 		return nil, fmt.Errorf("invalid lock proxy map length")
 	}
 
-	rippleExtraInfo, err := GetRippleExtraInfo(s, params.ChainID)
+	operator, err := getAssetOperator(s, params.ChainID)
 	if err != nil {
-		return nil, fmt.Errorf("RegisterAsset, GetRippleExtraInfo error: %v", err)
+		return nil, fmt.Errorf("RegisterAsset, getAssetOperator error: %v", err)
 	}
-	if rippleExtraInfo.Operator != ctx.Caller {
+	if operator != ctx.Caller {
 		return nil, fmt.Errorf("RegisterAsset, caller is not operator")
 	}
 
@@ -384,6 +399,75 @@ func RegisterAsset(s *native.NativeContract) ([]byte, error) {
 	return utils.PackOutputs(ABI, side_chain_manager_abi.MethodRegisterAsset, true)
 }
 
+// RegisterRippleCurrency sets the issued currency bridged by the ripple side chain, XRP is bridged if the
+// currency is empty or "XRP".
+func RegisterRippleCurrency(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &RegisterRippleCurrencyParam{}
+	if err := utils.UnpackMethod(ABI, side_chain_manager_abi.MethodRegisterRippleCurrency, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("RegisterRippleCurrency, GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil || sideChain.Router != utils.RIPPLE_ROUTER {
+		return nil, fmt.Errorf("RegisterRippleCurrency, side chain %d is not ripple", params.ChainID)
+	}
+	operator, err := getAssetOperator(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("RegisterRippleCurrency, getAssetOperator error: %v", err)
+	}
+	if operator != ctx.Caller {
+		return nil, fmt.Errorf("RegisterRippleCurrency, caller is not operator")
+	}
+
+	assetBind, err := GetAssetBind(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("RegisterRippleCurrency, GetAssetBind error: %v", err)
+	}
+	assetBind.Currency, assetBind.Issuer, assetBind.Precision = params.Currency, params.Issuer, params.Precision
+	if assetBind.IsIssuedCurrency() {
+		if _, err := data.NewCurrency(params.Currency); err != nil {
+			return nil, fmt.Errorf("RegisterRippleCurrency, invalid currency %s: %v", params.Currency, err)
+		}
+		if len(params.Issuer) != len(data.Account{}) || bytes.Equal(params.Issuer, make([]byte, len(data.Account{}))) {
+			return nil, fmt.Errorf("RegisterRippleCurrency, invalid issuer %x", params.Issuer)
+		}
+	} else if len(params.Issuer) != 0 || params.Precision != 0 {
+		return nil, fmt.Errorf("RegisterRippleCurrency, issuer and precision should be empty for XRP")
+	}
+
+	if err := PutAssetBind(s, params.ChainID, assetBind); err != nil {
+		return nil, fmt.Errorf("RegisterRippleCurrency, PutAssetBind error: %v", err)
+	}
+	return utils.PackOutputs(ABI, side_chain_manager_abi.MethodRegisterRippleCurrency, true)
+}
+
+// getAssetOperator returns the operator in the extra info of side chain which holds the assets itself
+func getAssetOperator(s *native.NativeContract, chainID uint64) (common.Address, error) {
+	sideChain, err := GetSideChainObject(s, chainID)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil {
+		return common.Address{}, fmt.Errorf("side chain %d is not registered", chainID)
+	}
+	if sideChain.Router == utils.BTC_ROUTER {
+		info, err := GetBtcExtraInfo(s, chainID)
+		if err != nil {
+			return common.Address{}, err
+		}
+		return info.Operator, nil
+	}
+	info, err := GetRippleExtraInfo(s, chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return info.Operator, nil
+}
+
 func UpdateFee(s *native.NativeContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
 	blockHeight := s.ContractRef().BlockHeight().Uint64()
@@ -487,3 +571,88 @@ func GetFee(s *native.NativeContract) ([]byte, error) {
 	}
 	return utils.PackOutputs(ABI, side_chain_manager_abi.MethodGetFee, b)
 }
+
+func UpdateFeeToken(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &UpdateFeeTokenParam{}
+	if err := utils.UnpackMethod(ABI, side_chain_manager_abi.MethodUpdateFeeToken, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateFeeToken, GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("UpdateFeeToken, side chain %d is not registered", params.ChainID)
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodUpdateFeeToken, ctx.Payload, s.ContractRef().MsgSender(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateFeeToken, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(ABI, side_chain_manager_abi.MethodUpdateFeeToken, true)
+	}
+
+	PutFeeToken(s, params.ChainID, params.FeeToken)
+	return utils.PackOutputs(ABI, side_chain_manager_abi.MethodUpdateFeeToken, true)
+}
+
+func GetFeeToken(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := utils.UnpackMethod(ABI, side_chain_manager_abi.MethodGetFeeToken, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	token, err := GetFeeTokenObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetFeeToken, GetFeeTokenObj error: %v", err)
+	}
+	return utils.PackOutputs(ABI, side_chain_manager_abi.MethodGetFeeToken, token)
+}
+
+// UpdateRootRetention sets the number of recent side chain heights whose root infos are kept by
+// info_sync, the older ones are pruned. zero means keeping all the root infos.
+func UpdateRootRetention(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &UpdateRootRetentionParam{}
+	if err := utils.UnpackMethod(ABI, side_chain_manager_abi.MethodUpdateRootRetention, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if params.Window > math.MaxUint32 {
+		return nil, fmt.Errorf("UpdateRootRetention, window %d exceeds max height", params.Window)
+	}
+
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateRootRetention, GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("UpdateRootRetention, side chain %d is not registered", params.ChainID)
+	}
+
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodUpdateRootRetention, ctx.Payload, s.ContractRef().MsgSender(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateRootRetention, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return utils.PackOutputs(ABI, side_chain_manager_abi.MethodUpdateRootRetention, true)
+	}
+
+	PutRootRetention(s, params.ChainID, params.Window)
+	return utils.PackOutputs(ABI, side_chain_manager_abi.MethodUpdateRootRetention, true)
+}
+
+func GetRootRetention(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := utils.UnpackMethod(ABI, side_chain_manager_abi.MethodGetRootRetention, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	window, err := GetRootRetentionObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetRootRetention, GetRootRetentionObj error: %v", err)
+	}
+	return utils.PackOutputs(ABI, side_chain_manager_abi.MethodGetRootRetention, window)
+}
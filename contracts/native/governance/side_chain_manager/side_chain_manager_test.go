@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -319,3 +320,28 @@ func TestApproveQuiteSideChain(t *testing.T) {
 	}
 	tr.Dump()
 }
+
+func TestAssetBindRLP(t *testing.T) {
+	assetBind := &AssetBind{
+		AssetMap:     map[uint64][]byte{1: {1}},
+		LockProxyMap: map[uint64][]byte{2: {2}},
+	}
+	blob, err := rlp.EncodeToBytes(assetBind)
+	assert.Nil(t, err)
+	// XRP asset bind keeps the legacy encoding
+	legacy, err := rlp.EncodeToBytes([]interface{}{[]*BindInfo{{1, []byte{1}}}, []*BindInfo{{2, []byte{2}}}})
+	assert.Nil(t, err)
+	assert.Equal(t, legacy, blob)
+	decoded := new(AssetBind)
+	assert.Nil(t, rlp.DecodeBytes(blob, decoded))
+	assert.Equal(t, assetBind, decoded)
+	assert.False(t, decoded.IsIssuedCurrency())
+
+	assetBind.Currency, assetBind.Issuer, assetBind.Precision = "USD", common.HexToAddress("0x01").Bytes(), 6
+	blob, err = rlp.EncodeToBytes(assetBind)
+	assert.Nil(t, err)
+	decoded = new(AssetBind)
+	assert.Nil(t, rlp.DecodeBytes(blob, decoded))
+	assert.Equal(t, assetBind, decoded)
+	assert.True(t, decoded.IsIssuedCurrency())
+}
@@ -82,6 +82,10 @@ func (this *FeeInfo) DecodeRLP(s *rlp.Stream) error {
 	return nil
 }
 
+// RippleExtraInfo is the extra info of ripple side chain. `Sequence` is the next sequence of multisign
+// account. when the signers are rotated, the retired signer set is kept in `SignerSets` with the
+// sequence of the SignerListSet tx, the txs up to it are still signed by the retired signers since
+// they are executed before the rotation.
 type RippleExtraInfo struct {
 	Operator      common.Address
 	Sequence      uint64
@@ -89,11 +93,65 @@ type RippleExtraInfo struct {
 	SignerNum     uint64
 	Pks           [][]byte
 	ReserveAmount *big.Int
+	SignerSets    []*RippleSignerSet `rlp:"optional"`
 }
 
+// RippleSignerSet is a retired signer set which signs the txs up to `LastSequence`.
+type RippleSignerSet struct {
+	LastSequence uint64
+	Pks          [][]byte
+	Quorum       uint64
+}
+
+// Rotate retires current signers at the sequence of the SignerListSet tx.
+func (this *RippleExtraInfo) Rotate(sequence uint64, pks [][]byte, quorum uint64) {
+	this.SignerSets = append(this.SignerSets, &RippleSignerSet{
+		LastSequence: sequence,
+		Pks:          this.Pks,
+		Quorum:       this.Quorum,
+	})
+	this.Pks = pks
+	this.Quorum = quorum
+	this.SignerNum = uint64(len(pks))
+}
+
+// SignerSet returns the signers and quorum of the tx with sequence
+func (this *RippleExtraInfo) SignerSet(sequence uint64) ([][]byte, uint64) {
+	for _, set := range this.SignerSets {
+		if sequence <= set.LastSequence {
+			return set.Pks, set.Quorum
+		}
+	}
+	return this.Pks, this.Quorum
+}
+
+// BtcExtraInfo is the extra info of bitcoin side chain, the assets are held by the P2WSH address of
+// `RedeemScript`, which is a standard m-of-n multisig script of the federation. the headers are synced
+// from `StartHeader` at `StartHeight`, which should be at a retarget boundary unless the network does
+// not retarget.
+type BtcExtraInfo struct {
+	Operator         common.Address
+	NetParams        string
+	RedeemScript     []byte
+	StartHeader      []byte
+	StartHeight      uint32
+	MinConfirmations uint32
+}
+
+// AssetBind binds the asset of side chain holding the assets itself to the assets and lock proxies of
+// other chains. for ripple, `Currency` and `Issuer` specify the issued currency bridged instead of XRP,
+// whose value is the transferred amount scaled down by `Precision`.
 type AssetBind struct {
 	AssetMap     map[uint64][]byte
 	LockProxyMap map[uint64][]byte
+	Currency     string
+	Issuer       []byte
+	Precision    uint64
+}
+
+// IsIssuedCurrency returns whether the asset is ripple issued currency other than XRP
+func (this *AssetBind) IsIssuedCurrency() bool {
+	return this.Currency != "" && this.Currency != "XRP"
 }
 
 type BindInfo struct {
@@ -117,13 +175,20 @@ func (this *AssetBind) EncodeRLP(w io.Writer) error {
 	sort.SliceStable(lockProxyList, func(i, j int) bool {
 		return lockProxyList[i].ChainId > lockProxyList[j].ChainId
 	})
-	return rlp.Encode(w, []interface{}{assetList, lockProxyList})
+	// the asset bind of XRP keeps the encoding before issued currency is supported
+	if this.Currency == "" && len(this.Issuer) == 0 && this.Precision == 0 {
+		return rlp.Encode(w, []interface{}{assetList, lockProxyList})
+	}
+	return rlp.Encode(w, []interface{}{assetList, lockProxyList, this.Currency, this.Issuer, this.Precision})
 }
 
 func (this *AssetBind) DecodeRLP(s *rlp.Stream) error {
 	var data struct {
 		AssetList     []*BindInfo
 		LockProxyList []*BindInfo
+		Currency      string `rlp:"optional"`
+		Issuer        []byte `rlp:"optional"`
+		Precision     uint64 `rlp:"optional"`
 	}
 
 	if err := s.Decode(&data); err != nil {
@@ -140,6 +205,9 @@ func (this *AssetBind) DecodeRLP(s *rlp.Stream) error {
 	}
 	this.AssetMap = assetMap
 	this.LockProxyMap = lockProxyMap
+	this.Currency = data.Currency
+	this.Issuer = data.Issuer
+	this.Precision = data.Precision
 
 	return nil
 }
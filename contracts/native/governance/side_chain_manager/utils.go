@@ -227,6 +227,24 @@ func GetRippleExtraInfo(native *native.NativeContract, chainId uint64) (*RippleE
 	return rippleExtraInfo, nil
 }
 
+func GetBtcExtraInfo(native *native.NativeContract, chainId uint64) (*BtcExtraInfo, error) {
+	sideChainInfo, err := GetSideChainObject(native, chainId)
+	if err != nil {
+		return nil, fmt.Errorf("GetBtcExtraInfo, GetSideChainObject error: %v", err)
+	}
+	if sideChainInfo == nil {
+		return nil, fmt.Errorf("GetBtcExtraInfo, side chain info is nil")
+	}
+	if sideChainInfo.Router != utils.BTC_ROUTER {
+		return nil, fmt.Errorf("GetBtcExtraInfo, side chain %d is not bitcoin", chainId)
+	}
+	btcExtraInfo := new(BtcExtraInfo)
+	if err := rlp.DecodeBytes(sideChainInfo.ExtraInfo, btcExtraInfo); err != nil {
+		return nil, fmt.Errorf("GetBtcExtraInfo, deserialize info error: %v", err)
+	}
+	return btcExtraInfo, nil
+}
+
 func PutRippleExtraInfo(native *native.NativeContract, chainId uint64, rippleExtraInfo *RippleExtraInfo) error {
 	blob, err := rlp.EncodeToBytes(rippleExtraInfo)
 	if err != nil {
@@ -273,3 +291,46 @@ func GetAssetBind(native *native.NativeContract, chainId uint64) (*AssetBind, er
 	}
 	return assetBind, nil
 }
+
+func PutFeeToken(native *native.NativeContract, chainID uint64, token common.Address) {
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	key := utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(FEE_TOKEN), chainIDBytes)
+	native.GetCacheDB().Put(key, token.Bytes())
+}
+
+// GetFeeTokenObj returns the token which the cross chain fee to the side chain is paid in, empty address
+// stands for the native token.
+func GetFeeTokenObj(native *native.NativeContract, chainID uint64) (common.Address, error) {
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	key := utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(FEE_TOKEN), chainIDBytes)
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return common.EmptyAddress, fmt.Errorf("GetFeeTokenObj, get fee token store error: %v", err)
+	}
+	return common.BytesToAddress(store), nil
+}
+
+func PutRootRetention(native *native.NativeContract, chainID uint64, window uint64) {
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	key := utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(ROOT_RETENTION), chainIDBytes)
+	if window == 0 {
+		native.GetCacheDB().Delete(key)
+		return
+	}
+	native.GetCacheDB().Put(key, utils.GetUint64Bytes(window))
+}
+
+// GetRootRetentionObj returns the retention window of root infos synced from the side chain, zero
+// means no retention.
+func GetRootRetentionObj(native *native.NativeContract, chainID uint64) (uint64, error) {
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	key := utils.ConcatKey(utils.SideChainManagerContractAddress, []byte(ROOT_RETENTION), chainIDBytes)
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return 0, fmt.Errorf("GetRootRetentionObj, get root retention store error: %v", err)
+	}
+	if store == nil {
+		return 0, nil
+	}
+	return utils.GetBytesUint64(store), nil
+}
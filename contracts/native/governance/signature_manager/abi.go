@@ -45,3 +45,15 @@ func (p *AddSignatureParam) DecodeRLP(s *rlp.Stream) error {
 	p.Addr, p.SideChainID, p.Subject, p.Signature = data.Address, data.SideChainID, data.Subject, data.Signature
 	return nil
 }
+
+type AddTypedSignatureParam struct {
+	Addr        common.Address
+	SideChainID *big.Int
+	SubjectType uint8
+	Subject     []byte
+	Signature   []byte
+}
+
+type GetSignaturesParam struct {
+	ID []byte
+}
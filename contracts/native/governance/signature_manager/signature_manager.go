@@ -17,21 +17,27 @@
 package signature_manager
 
 import (
-	"crypto/sha256"
+	"bytes"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/contract"
 	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/signature_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 var (
 	this = utils.SignatureManagerContractAddress
 
 	gasTable = map[string]uint64{
-		MethodAddSignature: 100000,
+		MethodAddSignature:      100000,
+		MethodAddTypedSignature: 100000,
+		MethodGetSignatures:     57750,
 	}
 
 	ABI *abi.ABI
@@ -47,6 +53,8 @@ func RegisterSignatureManagerContract(s *native.NativeContract) {
 	s.Prepare(ABI, gasTable)
 
 	s.Register(MethodAddSignature, AddSignature)
+	s.Register(MethodAddTypedSignature, AddTypedSignature)
+	s.Register(MethodGetSignatures, GetSignatures)
 }
 
 func AddSignature(s *native.NativeContract) ([]byte, error) {
@@ -61,11 +69,45 @@ func AddSignature(s *native.NativeContract) ([]byte, error) {
 	if err := contract.ValidateOwner(s, params.Addr); err != nil {
 		return utils.BYTE_FALSE, fmt.Errorf("AddSignature, checkWitness: %s, error: %v", params.Addr, err)
 	}
+	if bytes.HasPrefix(params.Subject, TypedSubjectTag) {
+		return utils.BYTE_FALSE, fmt.Errorf("AddSignature, raw subject starts with the typed subject tag")
+	}
+
+	return addSignature(s, params.Addr, params.SideChainID, SubjectRaw, params.Subject, params.Signature)
+}
+
+// AddTypedSignature collects the signatures of typed subject, the signature should be signed by the
+// voter over the id so that side chains can verify the bundle with the voters of the epoch.
+func AddTypedSignature(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &AddTypedSignatureParam{}
+	if err := utils.UnpackMethod(ABI, MethodAddTypedSignature, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	//check witness
+	if err := contract.ValidateOwner(s, params.Addr); err != nil {
+		return utils.BYTE_FALSE, fmt.Errorf("AddTypedSignature, checkWitness: %s, error: %v", params.Addr, err)
+	}
+
+	typ := SubjectType(params.SubjectType)
+	if err := checkSubject(typ, params.Subject, params.SideChainID); err != nil {
+		return utils.BYTE_FALSE, fmt.Errorf("AddTypedSignature, checkSubject error: %v", err)
+	}
+	pub, err := crypto.SigToPub(SubjectID(typ, params.Subject), params.Signature)
+	if err != nil {
+		return utils.BYTE_FALSE, fmt.Errorf("AddTypedSignature, recover signer error: %v", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pub); signer != params.Addr {
+		return utils.BYTE_FALSE, fmt.Errorf("AddTypedSignature, signature is signed by %s", signer.Hex())
+	}
+	return addSignature(s, params.Addr, params.SideChainID, typ, params.Subject, params.Signature)
+}
 
-	temp := sha256.Sum256(params.Subject)
-	id := temp[:]
+func addSignature(s *native.NativeContract, addr common.Address, sideChainID *big.Int, typ SubjectType, subject, sig []byte) ([]byte, error) {
+	id := SubjectID(typ, subject)
 	//check consensus signs
-	ok, err := CheckSigns(s, id, params.Signature, params.Addr)
+	ok, err := CheckSigns(s, id, sig, addr)
 	if err != nil {
 		return utils.BYTE_FALSE, fmt.Errorf("AddSignature, CheckSigns error: %v", err)
 	}
@@ -73,8 +115,31 @@ func AddSignature(s *native.NativeContract) ([]byte, error) {
 		return utils.BYTE_TRUE, nil
 	}
 
-	if err := s.AddNotify(ABI, []string{EventAddSignatureQuorumEvent}, id, params.Subject, params.SideChainID); err != nil {
+	if err := putSignatureBundle(s, id, typ, sideChainID, subject); err != nil {
+		return utils.BYTE_FALSE, fmt.Errorf("AddSignature, putSignatureBundle error: %v", err)
+	}
+	if err := s.AddNotify(ABI, []string{EventAddSignatureQuorumEvent}, id, subject, sideChainID); err != nil {
 		return utils.BYTE_FALSE, err
 	}
 	return utils.PackOutputs(ABI, MethodAddSignature, true)
 }
+
+func GetSignatures(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetSignaturesParam{}
+	if err := utils.UnpackMethod(ABI, MethodGetSignatures, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	bundle, err := getSignatureBundle(s, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetSignatures, getSignatureBundle error: %v", err)
+	}
+	if bundle == nil {
+		return nil, fmt.Errorf("GetSignatures, signatures of %x not found", params.ID)
+	}
+	enc, err := rlp.EncodeToBytes(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("GetSignatures, serialize bundle error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodGetSignatures, enc)
+}
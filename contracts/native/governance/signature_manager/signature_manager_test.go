@@ -19,6 +19,7 @@
 package signature_manager
 
 import (
+	"crypto/sha256"
 	"math/big"
 	"os"
 	"testing"
@@ -29,7 +30,10 @@ import (
 	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/signature_manager_abi"
 	nm "github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -124,3 +128,73 @@ func TestAddSignature(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func TestAddTypedSignature(t *testing.T) {
+	sdb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	peers, keys := native.GenerateTestPeers(4)
+	nm.StoreGenesisEpoch(sdb, peers, peers)
+
+	chainID := big.NewInt(2)
+	subject, err := rlp.EncodeToBytes(&EpochValidatorsSubject{SideChainID: 2, EpochID: 1, Validators: peers})
+	assert.NoError(t, err)
+	id := SubjectID(SubjectEpochValidators, subject)
+	// the raw subject is hashed as it is, and the typed subject is prefixed with the tag
+	rawID := sha256.Sum256(subject)
+	assert.Equal(t, rawID[:], SubjectID(SubjectRaw, subject))
+	assert.NotEqual(t, id, SubjectID(SubjectRaw, append([]byte{byte(SubjectEpochValidators)}, subject...)))
+	tagged := append(append(append([]byte{}, TypedSubjectTag...), byte(SubjectEpochValidators)), subject...)
+	assert.Equal(t, id, SubjectID(SubjectRaw, tagged))
+	call := func(sender common.Address, payload []byte) ([]byte, error) {
+		contractRef := native.NewContractRef(sdb, sender, sender, big.NewInt(1), common.Hash{}, gasTable[MethodAddTypedSignature], nil)
+		ret, _, err := contractRef.NativeCall(sender, this, payload)
+		return ret, err
+	}
+	sign := func(i int) []byte {
+		sig, err := crypto.Sign(id, keys[i])
+		assert.NoError(t, err)
+		return sig
+	}
+
+	// the raw subject colliding with the typed one is refused
+	payload, err := utils.PackMethod(ABI, MethodAddSignature, peers[0], chainID, tagged, sign(0))
+	assert.NoError(t, err)
+	_, err = call(peers[0], payload)
+	assert.NotNil(t, err)
+
+	// subject bound to another side chain
+	payload, err = utils.PackMethod(ABI, MethodAddTypedSignature, peers[0], big.NewInt(3), uint8(SubjectEpochValidators), subject, sign(0))
+	assert.NoError(t, err)
+	_, err = call(peers[0], payload)
+	assert.NotNil(t, err)
+
+	// signature of others
+	payload, err = utils.PackMethod(ABI, MethodAddTypedSignature, peers[0], chainID, uint8(SubjectEpochValidators), subject, sign(1))
+	assert.NoError(t, err)
+	_, err = call(peers[0], payload)
+	assert.NotNil(t, err)
+
+	getPayload, err := utils.PackMethod(ABI, MethodGetSignatures, id)
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = call(peers[i], getPayload)
+		assert.NotNil(t, err)
+
+		payload, err = utils.PackMethod(ABI, MethodAddTypedSignature, peers[i], chainID, uint8(SubjectEpochValidators), subject, sign(i))
+		assert.NoError(t, err)
+		_, err = call(peers[i], payload)
+		assert.NoError(t, err)
+	}
+
+	ret, err := call(common.Address{}, getPayload)
+	assert.NoError(t, err)
+	bundle := new(SignatureBundle)
+	assert.NoError(t, bundle.Decode(ret))
+	assert.Equal(t, SubjectEpochValidators, bundle.SubjectType)
+	assert.Equal(t, subject, bundle.Subject)
+	assert.Equal(t, 3, len(bundle.Signatures))
+	for _, sig := range bundle.Signatures {
+		pub, err := crypto.SigToPub(id, sig.Signature)
+		assert.NoError(t, err)
+		assert.Equal(t, sig.Signer, crypto.PubkeyToAddress(*pub))
+	}
+}
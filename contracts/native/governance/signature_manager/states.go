@@ -17,9 +17,15 @@
 package signature_manager
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"io"
+	"math/big"
 	"sort"
 
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/signature_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -82,3 +88,98 @@ func (this *SigInfo) DecodeRLP(s *rlp.Stream) error {
 
 	return nil
 }
+
+// SubjectType tells side chains how to interpret the subject, the opaque subject of `addSignature`
+// is of type SubjectRaw.
+type SubjectType uint8
+
+const (
+	SubjectRaw SubjectType = iota
+	SubjectEpochValidators
+	SubjectAssetConfig
+)
+
+// TypedSubjectTag prefixes the typed subjects to compute their ids, the raw subjects starting with
+// the tag are refused, so that the ids of raw subjects never collide with the typed ones.
+var TypedSubjectTag = []byte("\x19Zion Typed Subject:\n")
+
+// SubjectID is the id of signatures, the raw subject is hashed as it is, and the typed subject is
+// prefixed with the tag and its type so that the same bytes of different types are not mixed up.
+func SubjectID(typ SubjectType, subject []byte) []byte {
+	if typ == SubjectRaw {
+		id := sha256.Sum256(subject)
+		return id[:]
+	}
+	data := make([]byte, 0, len(TypedSubjectTag)+1+len(subject))
+	data = append(append(append(data, TypedSubjectTag...), byte(typ)), subject...)
+	id := sha256.Sum256(data)
+	return id[:]
+}
+
+// EpochValidatorsSubject attests the validator set of side chain since the epoch
+type EpochValidatorsSubject struct {
+	SideChainID uint64
+	EpochID     uint64
+	Validators  []common.Address
+}
+
+// AssetConfigSubject attests the config of asset on side chain, which is interpreted by the side chain
+type AssetConfigSubject struct {
+	SideChainID uint64
+	Asset       []byte
+	Config      []byte
+}
+
+// checkSubject checks that the typed subject is well formed and bound to the side chain
+func checkSubject(typ SubjectType, subject []byte, sideChainID *big.Int) error {
+	var chainID uint64
+	switch typ {
+	case SubjectEpochValidators:
+		data := new(EpochValidatorsSubject)
+		if err := rlp.DecodeBytes(subject, data); err != nil {
+			return fmt.Errorf("decode epoch validators subject error: %v", err)
+		}
+		if len(data.Validators) == 0 {
+			return fmt.Errorf("empty validators")
+		}
+		chainID = data.SideChainID
+	case SubjectAssetConfig:
+		data := new(AssetConfigSubject)
+		if err := rlp.DecodeBytes(subject, data); err != nil {
+			return fmt.Errorf("decode asset config subject error: %v", err)
+		}
+		chainID = data.SideChainID
+	default:
+		return fmt.Errorf("unknown subject type %d", typ)
+	}
+	if sideChainID == nil || !sideChainID.IsUint64() || sideChainID.Uint64() != chainID {
+		return fmt.Errorf("subject is bound to side chain %d", chainID)
+	}
+	return nil
+}
+
+type SignerSignature struct {
+	Signer    common.Address
+	Signature []byte
+}
+
+// SignatureBundle is the signatures collected until the quorum is reached, the signatures of typed
+// subject are secp256k1 signatures of voters over the id.
+type SignatureBundle struct {
+	SubjectType SubjectType
+	SideChainID *big.Int
+	Subject     []byte
+	EpochID     *big.Int
+	Height      uint64
+	Signatures  []SignerSignature
+}
+
+func (m *SignatureBundle) Decode(payload []byte) error {
+	var data struct {
+		Bundle []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGetSignatures, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Bundle, m)
+}
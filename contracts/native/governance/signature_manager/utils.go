@@ -17,7 +17,10 @@
 package signature_manager
 
 import (
+	"bytes"
 	"fmt"
+	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
@@ -27,7 +30,8 @@ import (
 )
 
 const (
-	SIG_INFO = "sigInfo"
+	SIG_INFO   = "sigInfo"
+	SIG_BUNDLE = "sigBundle"
 )
 
 func CheckSigns(native *native.NativeContract, id, sig []byte, address common.Address) (bool, error) {
@@ -107,3 +111,52 @@ func putSigInfo(native *native.NativeContract, id []byte, sigInfo *SigInfo) {
 
 	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(SIG_INFO), id), sigInfoBytes)
 }
+
+// putSignatureBundle stores the signatures collected when the quorum is reached
+func putSignatureBundle(native *native.NativeContract, id []byte, typ SubjectType, sideChainID *big.Int, subject []byte) error {
+	sigInfo, err := getSigInfo(native, id)
+	if err != nil {
+		return fmt.Errorf("putSignatureBundle, getSigInfo error: %v", err)
+	}
+	epochInfo, err := node_manager.GetCurrentEpochInfoImpl(native)
+	if err != nil {
+		return fmt.Errorf("putSignatureBundle, node_manager.GetCurrentEpochInfo error: %v", err)
+	}
+
+	bundle := &SignatureBundle{
+		SubjectType: typ,
+		SideChainID: sideChainID,
+		Subject:     subject,
+		EpochID:     epochInfo.ID,
+		Height:      native.ContractRef().BlockHeight().Uint64(),
+		Signatures:  make([]SignerSignature, 0, len(sigInfo.m)),
+	}
+	for addr, sig := range sigInfo.m {
+		bundle.Signatures = append(bundle.Signatures, SignerSignature{Signer: common.HexToAddress(addr), Signature: sig})
+	}
+	sort.Slice(bundle.Signatures, func(i, j int) bool {
+		return bytes.Compare(bundle.Signatures[i].Signer[:], bundle.Signatures[j].Signer[:]) < 0
+	})
+
+	blob, err := rlp.EncodeToBytes(bundle)
+	if err != nil {
+		return fmt.Errorf("putSignatureBundle, serialize bundle error: %v", err)
+	}
+	native.GetCacheDB().Put(utils.ConcatKey(utils.SignatureManagerContractAddress, []byte(SIG_BUNDLE), id), blob)
+	return nil
+}
+
+func getSignatureBundle(native *native.NativeContract, id []byte) (*SignatureBundle, error) {
+	blob, err := native.GetCacheDB().Get(utils.ConcatKey(utils.SignatureManagerContractAddress, []byte(SIG_BUNDLE), id))
+	if err != nil {
+		return nil, fmt.Errorf("getSignatureBundle, get bundle store error: %v", err)
+	}
+	if blob == nil {
+		return nil, nil
+	}
+	bundle := new(SignatureBundle)
+	if err := rlp.DecodeBytes(blob, bundle); err != nil {
+		return nil, fmt.Errorf("getSignatureBundle, deserialize bundle error: %v", err)
+	}
+	return bundle, nil
+}
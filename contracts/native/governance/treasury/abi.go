@@ -0,0 +1,69 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package treasury
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/treasury_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "treasury"
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(ITreasuryABI))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = utils.TreasuryContractAddress
+)
+
+type DepositParam struct{}
+
+func (m *DepositParam) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodDeposit)
+}
+
+type BalanceParam struct{}
+
+func (m *BalanceParam) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodBalance)
+}
+
+type GetSpendParam struct {
+	ID *big.Int
+}
+
+func (m *GetSpendParam) Encode() ([]byte, error) {
+	return utils.PackMethodWithStruct(ABI, MethodGetSpend, m)
+}
+
+type GetSpendCountParam struct{}
+
+func (m *GetSpendCountParam) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodGetSpendCount)
+}
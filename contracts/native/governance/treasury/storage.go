@@ -0,0 +1,77 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package treasury
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_SPEND_COUNT = "st_spend_count"
+	SKP_SPEND       = "st_spend"
+)
+
+func getSpendCount(s *native.NativeContract) (*big.Int, error) {
+	store, err := s.GetCacheDB().Get(spendCountKey())
+	if err != nil {
+		return nil, fmt.Errorf("getSpendCount, get store error: %v", err)
+	}
+	return new(big.Int).SetBytes(store), nil
+}
+
+func setSpendCount(s *native.NativeContract, count *big.Int) {
+	s.GetCacheDB().Put(spendCountKey(), count.Bytes())
+}
+
+func getSpendRecord(s *native.NativeContract, ID *big.Int) (*SpendRecord, bool, error) {
+	store, err := s.GetCacheDB().Get(spendKey(ID))
+	if err != nil {
+		return nil, false, fmt.Errorf("getSpendRecord, get store error: %v", err)
+	}
+	if len(store) == 0 {
+		return nil, false, nil
+	}
+	record := new(SpendRecord)
+	if err := rlp.DecodeBytes(store, record); err != nil {
+		return nil, false, fmt.Errorf("getSpendRecord, deserialize spend record error: %v", err)
+	}
+	return record, true, nil
+}
+
+func setSpendRecord(s *native.NativeContract, record *SpendRecord) error {
+	store, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return fmt.Errorf("setSpendRecord, serialize spend record error: %v", err)
+	}
+	s.GetCacheDB().Put(spendKey(record.ID), store)
+	return nil
+}
+
+func spendCountKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_SPEND_COUNT))
+}
+
+func spendKey(ID *big.Int) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SPEND), ID.Bytes())
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package treasury
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/treasury_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	DEPOSIT_EVENT = "Deposit"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodName:          39375,
+		MethodDeposit:       63000,
+		MethodBalance:       39375,
+		MethodGetSpend:      84000,
+		MethodGetSpendCount: 39375,
+	}
+)
+
+func InitTreasury() {
+	InitABI()
+	native.Contracts[this] = RegisterTreasuryContract
+}
+
+func RegisterTreasuryContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.Register(MethodName, Name)
+	s.Register(MethodDeposit, Deposit)
+	s.Register(MethodBalance, Balance)
+	s.Register(MethodGetSpend, GetSpend)
+	s.Register(MethodGetSpendCount, GetSpendCount)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodName, contractName)
+}
+
+// Deposit adds the value sent by caller to treasury, the community share of block
+// rewards and the stakes of failed proposals are sent to treasury directly if it's
+// the community address.
+func Deposit(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	caller := ctx.Caller
+	value := s.ContractRef().Value()
+	toAddress := s.ContractRef().TxTo()
+
+	if ctx.Caller != s.ContractRef().TxOrigin() {
+		return nil, fmt.Errorf("Deposit, contract call forbidden")
+	}
+	if toAddress != utils.TreasuryContractAddress {
+		return nil, fmt.Errorf("Deposit, to address %x must be treasury contract address %x", toAddress, utils.TreasuryContractAddress)
+	}
+	if value.Sign() <= 0 {
+		return nil, fmt.Errorf("Deposit, value must be positive")
+	}
+
+	err := s.AddNotify(ABI, []string{DEPOSIT_EVENT}, caller.Hex(), value.String())
+	if err != nil {
+		return nil, fmt.Errorf("Deposit, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodDeposit, true)
+}
+
+func Balance(s *native.NativeContract) ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodBalance, s.StateDB().GetBalance(this))
+}
+
+func GetSpend(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetSpendParam{}
+	if err := utils.UnpackMethod(ABI, MethodGetSpend, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetSpend, unpack params error: %v", err)
+	}
+
+	record, found, err := getSpendRecord(s, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetSpend, getSpendRecord error: %v", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("GetSpend, spend %s not found", params.ID)
+	}
+	enc, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return nil, fmt.Errorf("GetSpend, serialize spend record error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodGetSpend, enc)
+}
+
+func GetSpendCount(s *native.NativeContract) ([]byte, error) {
+	count, err := getSpendCount(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetSpendCount, getSpendCount error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodGetSpendCount, count)
+}
+
+// CheckSpend checks the content of spend proposal, the balance of treasury is checked
+// when the spend is executed.
+func CheckSpend(s *native.NativeContract, spend *Spend) error {
+	if err := spend.validate(); err != nil {
+		return err
+	}
+	if spend.IsVesting() {
+		if err := vesting.CheckVestingAccount(s, spend.vestingAccount()); err != nil {
+			return fmt.Errorf("vesting.CheckVestingAccount error: %v", err)
+		}
+	}
+	return nil
+}
+
+// ExecuteSpend transfers the amount of spend from treasury to recipient, which is called
+// by proposal manager once the spend proposal passes.
+func ExecuteSpend(s *native.NativeContract, proposalID *big.Int, spend *Spend) (*SpendRecord, error) {
+	if err := spend.validate(); err != nil {
+		return nil, fmt.Errorf("ExecuteSpend, %v", err)
+	}
+	if balance := s.StateDB().GetBalance(this); balance.Cmp(spend.Amount) < 0 {
+		return nil, fmt.Errorf("ExecuteSpend, treasury balance %s is less than spend amount %s", balance, spend.Amount)
+	}
+	if spend.IsVesting() {
+		if err := vesting.CreateVestingAccount(s, this, spend.vestingAccount()); err != nil {
+			return nil, fmt.Errorf("ExecuteSpend, vesting.CreateVestingAccount error: %v", err)
+		}
+	} else {
+		if err := contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), this, spend.Recipient, spend.Amount); err != nil {
+			return nil, fmt.Errorf("ExecuteSpend, utils.NativeTransfer error: %v", err)
+		}
+	}
+
+	count, err := getSpendCount(s)
+	if err != nil {
+		return nil, fmt.Errorf("ExecuteSpend, getSpendCount error: %v", err)
+	}
+	record := &SpendRecord{
+		ID:         count,
+		ProposalID: proposalID,
+		Height:     s.ContractRef().BlockHeight(),
+		Recipient:  spend.Recipient,
+		Amount:     spend.Amount,
+		Vesting:    spend.IsVesting(),
+	}
+	if err := setSpendRecord(s, record); err != nil {
+		return nil, fmt.Errorf("ExecuteSpend, %v", err)
+	}
+	setSpendCount(s, new(big.Int).Add(count, common.Big1))
+	return record, nil
+}
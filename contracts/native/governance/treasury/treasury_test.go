@@ -0,0 +1,109 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package treasury
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/treasury_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	InitTreasury()
+	os.Exit(m.Run())
+}
+
+func TestDeposit(t *testing.T) {
+	caller := common.HexToAddress("0x01")
+	value := big.NewInt(1000)
+	sdb := native.NewTestStateDB()
+	// the value is transferred to the contract by evm
+	sdb.AddBalance(this, value)
+
+	payload, err := new(DepositParam).Encode()
+	assert.NoError(t, err)
+	_, err = native.TestNativeCall(t, this, MethodDeposit, payload, value, caller, sdb, gasTable[MethodDeposit])
+	assert.NoError(t, err)
+	_, err = native.TestNativeCall(t, this, MethodDeposit, payload, common.Big0, caller, sdb, gasTable[MethodDeposit])
+	assert.Error(t, err)
+
+	payload, err = new(BalanceParam).Encode()
+	assert.NoError(t, err)
+	raw, err := native.TestNativeCall(t, this, MethodBalance, payload, common.Big0, sdb, gasTable[MethodBalance])
+	assert.NoError(t, err)
+	var balance *big.Int
+	assert.NoError(t, utils.UnpackOutputs(ABI, MethodBalance, &balance, raw))
+	assert.Equal(t, value, balance)
+}
+
+func TestExecuteSpend(t *testing.T) {
+	grantee, investor := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	sdb, s := native.GenerateTestContext(t, common.Big0, this, 10)
+	sdb.AddBalance(this, big.NewInt(1000))
+
+	_, err := ExecuteSpend(s, big.NewInt(7), &Spend{Recipient: grantee, Amount: big.NewInt(1001)})
+	assert.Error(t, err)
+
+	record, err := ExecuteSpend(s, big.NewInt(7), &Spend{Recipient: grantee, Amount: big.NewInt(300)})
+	assert.NoError(t, err)
+	assert.Equal(t, common.Big0, record.ID)
+	assert.Equal(t, big.NewInt(300), sdb.GetBalance(grantee))
+
+	spend := &Spend{Recipient: investor, Amount: big.NewInt(600), EndHeight: big.NewInt(110)}
+	assert.NoError(t, CheckSpend(s, spend))
+	_, err = ExecuteSpend(s, big.NewInt(8), spend)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(600), sdb.GetBalance(investor))
+	assert.Equal(t, big.NewInt(300), vesting.LockedBalance(sdb, investor, big.NewInt(55)))
+	assert.Equal(t, big.NewInt(100), sdb.GetBalance(this))
+	// another vesting account of investor can be created, but not the one already ended
+	assert.NoError(t, CheckSpend(s, &Spend{Recipient: investor, Amount: big.NewInt(100), EndHeight: big.NewInt(110)}))
+	assert.Error(t, CheckSpend(s, &Spend{Recipient: investor, Amount: big.NewInt(100), EndHeight: big.NewInt(10)}))
+
+	payload, err := new(GetSpendCountParam).Encode()
+	assert.NoError(t, err)
+	raw, err := native.TestNativeCall(t, this, MethodGetSpendCount, payload, common.Big0, sdb, gasTable[MethodGetSpendCount])
+	assert.NoError(t, err)
+	var count *big.Int
+	assert.NoError(t, utils.UnpackOutputs(ABI, MethodGetSpendCount, &count, raw))
+	assert.Equal(t, big.NewInt(2), count)
+
+	payload, err = (&GetSpendParam{ID: common.Big1}).Encode()
+	assert.NoError(t, err)
+	raw, err = native.TestNativeCall(t, this, MethodGetSpend, payload, common.Big0, sdb, gasTable[MethodGetSpend])
+	assert.NoError(t, err)
+	got := new(SpendRecord)
+	assert.NoError(t, got.Decode(raw))
+	assert.Equal(t, big.NewInt(8), got.ProposalID)
+	assert.Equal(t, investor, got.Recipient)
+	assert.Equal(t, big.NewInt(600), got.Amount)
+	assert.True(t, got.Vesting)
+
+	payload, err = (&GetSpendParam{ID: big.NewInt(2)}).Encode()
+	assert.NoError(t, err)
+	_, err = native.TestNativeCall(t, this, MethodGetSpend, payload, common.Big0, sdb, gasTable[MethodGetSpend])
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package treasury
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/treasury_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/contracts/native/vesting"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Spend is the content of spend proposal, the amount is transferred to recipient from
+// treasury once the proposal passes. The amount is locked in a new vesting account of
+// recipient if EndHeight is set.
+type Spend struct {
+	Recipient   common.Address
+	Amount      *big.Int
+	StartHeight *big.Int `rlp:"optional"`
+	CliffHeight *big.Int `rlp:"optional"`
+	EndHeight   *big.Int `rlp:"optional"`
+}
+
+// IsVesting returns true if the amount is released by vesting schedule.
+func (m *Spend) IsVesting() bool {
+	return m.EndHeight != nil && m.EndHeight.Sign() > 0
+}
+
+func (m *Spend) validate() error {
+	if m.Recipient == common.EmptyAddress {
+		return fmt.Errorf("recipient is empty")
+	}
+	if m.Amount == nil || m.Amount.Sign() <= 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+	return nil
+}
+
+func (m *Spend) vestingAccount() *vesting.VestingAccount {
+	heightOf := func(height *big.Int) *big.Int {
+		if height == nil {
+			return new(big.Int)
+		}
+		return new(big.Int).Set(height)
+	}
+	return &vesting.VestingAccount{
+		Address:         m.Recipient,
+		OriginalVesting: new(big.Int).Set(m.Amount),
+		StartHeight:     heightOf(m.StartHeight),
+		CliffHeight:     heightOf(m.CliffHeight),
+		EndHeight:       heightOf(m.EndHeight),
+	}
+}
+
+// SpendRecord is the history of spend executed by treasury.
+type SpendRecord struct {
+	ID         *big.Int
+	ProposalID *big.Int
+	Height     *big.Int
+	Recipient  common.Address
+	Amount     *big.Int
+	Vesting    bool
+}
+
+func (m *SpendRecord) Decode(payload []byte) error {
+	var data struct {
+		Spend []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGetSpend, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Spend, m)
+}
@@ -20,6 +20,7 @@ package info_sync
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"log"
 	"math/big"
 	"testing"
@@ -189,3 +190,71 @@ func TestReplenish(t *testing.T) {
 	_, err = native.TestNativeCall(t, utils.InfoSyncContractAddress, "Replenish", input, common.Big0, extra, sdb)
 	assert.Nil(t, err)
 }
+
+func TestRootInfoRetention(t *testing.T) {
+	Init()
+	contractRef := native.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil)
+	contractRef.PushContext(&native.Context{ContractAddress: utils.InfoSyncContractAddress})
+	contract := native.NewNativeContract(sdb, contractRef)
+
+	// root infos synced before retention are pruned as well, and the heights synced out of order do
+	// not block pruning.
+	assert.Nil(t, PutRootInfo(contract, CHAIN_ID, 1, []byte{1}))
+	side_chain_manager.PutRootRetention(contract, CHAIN_ID, 10)
+	for _, height := range []uint32{2, 4, 3} {
+		assert.Nil(t, PutRootInfo(contract, CHAIN_ID, height, []byte{byte(height)}))
+	}
+	for height := uint32(5); height <= 50; height++ {
+		assert.Nil(t, PutRootInfo(contract, CHAIN_ID, height, []byte{byte(height)}))
+	}
+
+	_, err := GetRootInfo(contract, CHAIN_ID, 40)
+	assert.True(t, errors.Is(err, ErrRootInfoPruned))
+	info, err := GetRootInfo(contract, CHAIN_ID, 41)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{41}, info)
+	assert.True(t, errors.Is(PutRootInfo(contract, CHAIN_ID, 40, []byte{40}), ErrRootInfoPruned))
+
+	rootInfoKey := func(height uint32) []byte {
+		return utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INFO), utils.GetUint64Bytes(CHAIN_ID), utils.GetUint32Bytes(height))
+	}
+	for height := uint32(1); height <= 50; height++ {
+		store, err := contract.GetCacheDB().Get(rootInfoKey(height))
+		assert.Nil(t, err)
+		assert.Equal(t, height > 40, store != nil)
+	}
+
+	// the pruned root infos are reported as pruned without retention
+	side_chain_manager.PutRootRetention(contract, CHAIN_ID, 0)
+	_, err = GetRootInfo(contract, CHAIN_ID, 1)
+	assert.True(t, errors.Is(err, ErrRootInfoPruned))
+	assert.True(t, errors.Is(PutRootInfo(contract, CHAIN_ID, 1, []byte{1}), ErrRootInfoPruned))
+	info, err = GetRootInfo(contract, CHAIN_ID, 41)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{41}, info)
+}
+
+func TestRootInfoRetentionSparse(t *testing.T) {
+	Init()
+	contractRef := native.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil)
+	contractRef.PushContext(&native.Context{ContractAddress: utils.InfoSyncContractAddress})
+	contract := native.NewNativeContract(sdb, contractRef)
+
+	// the heights never synced are not scanned, so that pruning keeps up with sparse side chains.
+	side_chain_manager.PutRootRetention(contract, CHAIN_ID, 10)
+	heights := make([]uint32, 0)
+	for height := uint32(1000); height <= 1000*MAX_PRUNE_PER_PUT; height += 1000 {
+		assert.Nil(t, PutRootInfo(contract, CHAIN_ID, height, []byte{1}))
+		heights = append(heights, height)
+	}
+
+	last := heights[len(heights)-1]
+	for _, height := range heights {
+		store, err := contract.GetCacheDB().Get(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INFO), utils.GetUint64Bytes(CHAIN_ID), utils.GetUint32Bytes(height)))
+		assert.Nil(t, err)
+		assert.Equal(t, height == last, store != nil)
+	}
+	head, tail, err := getRootIndex(contract, CHAIN_ID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), tail-head)
+}
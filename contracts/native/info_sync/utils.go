@@ -1,8 +1,10 @@
 package info_sync
 
 import (
+	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/side_chain_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 )
 
@@ -10,23 +12,57 @@ const (
 	//key prefix
 	ROOT_INFO            = "rootInfo"
 	CURRENT_HEIGHT       = "currentHeight"
+	ROOT_PRUNED          = "rootPruned"
+	ROOT_INDEX           = "rootIndex"
+	ROOT_INDEX_HEAD      = "rootIndexHead"
+	ROOT_INDEX_TAIL      = "rootIndexTail"
 	SYNC_ROOT_INFO_EVENT = "SyncRootInfoEvent"
 	REPLENISH_EVENT      = "ReplenishEvent"
 )
 
+// max root infos pruned in one put, so that the cost of sync is bounded
+const MAX_PRUNE_PER_PUT = 256
+
+var ErrRootInfoPruned = errors.New("root info is pruned")
+
 func PutRootInfo(native *native.NativeContract, chainID uint64, height uint32, info []byte) error {
 	contract := utils.InfoSyncContractAddress
 	chainIDBytes := utils.GetUint64Bytes(chainID)
 	heightBytes := utils.GetUint32Bytes(height)
 
-	native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(ROOT_INFO), chainIDBytes, heightBytes),
-		info)
+	window, err := side_chain_manager.GetRootRetentionObj(native, chainID)
+	if err != nil {
+		return fmt.Errorf("PutRootInfo, side_chain_manager.GetRootRetentionObj error: %v", err)
+	}
 	currentHeight, err := GetCurrentHeight(native, chainID)
 	if err != nil {
 		return fmt.Errorf("PutRootInfo, GetCurrentHeight error: %v", err)
 	}
+	pruned, err := getPrunedHeight(native, chainID)
+	if err != nil {
+		return fmt.Errorf("PutRootInfo, getPrunedHeight error: %v", err)
+	}
+	if height < prunedHeight(currentHeight, window) || height < pruned {
+		return fmt.Errorf("PutRootInfo, height %d of chain %d is out of retention window: %w", height, chainID, ErrRootInfoPruned)
+	}
+
+	key := utils.ConcatKey(contract, []byte(ROOT_INFO), chainIDBytes, heightBytes)
+	store, err := native.GetCacheDB().Get(key)
+	if err != nil {
+		return fmt.Errorf("PutRootInfo, native.GetCacheDB().Get error: %v", err)
+	}
+	if store == nil {
+		if err := appendRootIndex(native, chainID, height); err != nil {
+			return fmt.Errorf("PutRootInfo, appendRootIndex error: %v", err)
+		}
+	}
+	native.GetCacheDB().Put(key, info)
 	if currentHeight < height {
 		native.GetCacheDB().Put(utils.ConcatKey(contract, []byte(CURRENT_HEIGHT), chainIDBytes), heightBytes)
+		currentHeight = height
+	}
+	if err := pruneRootInfo(native, chainID, prunedHeight(currentHeight, window)); err != nil {
+		return fmt.Errorf("PutRootInfo, pruneRootInfo error: %v", err)
 	}
 	err = NotifyPutRootInfo(native, chainID, height)
 	if err != nil {
@@ -35,11 +71,35 @@ func PutRootInfo(native *native.NativeContract, chainID uint64, height uint32, i
 	return nil
 }
 
+// GetRootInfo returns the root info of side chain at height, the root info out of the retention window
+// of side chain is regarded as pruned, even if it is not deleted yet. the pruned root info is reported
+// as pruned even if the retention is disabled later.
 func GetRootInfo(native *native.NativeContract, chainID uint64, height uint32) ([]byte, error) {
 	contract := utils.InfoSyncContractAddress
 	chainIDBytes := utils.GetUint64Bytes(chainID)
 	heightBytes := utils.GetUint32Bytes(height)
 
+	window, err := side_chain_manager.GetRootRetentionObj(native, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetRootInfo, side_chain_manager.GetRootRetentionObj error: %v", err)
+	}
+	pruned, err := getPrunedHeight(native, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetRootInfo, getPrunedHeight error: %v", err)
+	}
+	if height < pruned {
+		return nil, fmt.Errorf("GetRootInfo, height %d of chain %d is pruned: %w", height, chainID, ErrRootInfoPruned)
+	}
+	if window > 0 {
+		currentHeight, err := GetCurrentHeight(native, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("GetRootInfo, GetCurrentHeight error: %v", err)
+		}
+		if height < prunedHeight(currentHeight, window) {
+			return nil, fmt.Errorf("GetRootInfo, height %d of chain %d is out of retention window %d: %w", height, chainID, window, ErrRootInfoPruned)
+		}
+	}
+
 	r, err := native.GetCacheDB().Get(utils.ConcatKey(contract, []byte(ROOT_INFO), chainIDBytes, heightBytes))
 	if err != nil {
 		return nil, fmt.Errorf("GetRootInfo, native.GetCacheDB().Get error: %v", err)
@@ -58,6 +118,87 @@ func GetCurrentHeight(native *native.NativeContract, chainID uint64) (uint32, er
 	return utils.GetBytesUint32(r), nil
 }
 
+// prunedHeight returns the lowest height kept in the window of recent heights
+func prunedHeight(currentHeight uint32, window uint64) uint32 {
+	if window == 0 || uint64(currentHeight) < window {
+		return 0
+	}
+	return uint32(uint64(currentHeight) - window + 1)
+}
+
+// pruneRootInfo deletes the root infos below the height in the order they are synced, at most
+// MAX_PRUNE_PER_PUT root infos are deleted in one put. the synced heights are kept in an index, so
+// that the heights never synced are skipped, and the sparse side chains won't fall behind.
+func pruneRootInfo(native *native.NativeContract, chainID uint64, below uint32) error {
+	pruned, err := getPrunedHeight(native, chainID)
+	if err != nil {
+		return err
+	}
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	if below > pruned {
+		native.GetCacheDB().Put(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_PRUNED), chainIDBytes), utils.GetUint32Bytes(below))
+	} else {
+		below = pruned
+	}
+
+	head, tail, err := getRootIndex(native, chainID)
+	if err != nil {
+		return err
+	}
+	for count := 0; count < MAX_PRUNE_PER_PUT && head < tail; count++ {
+		indexKey := utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INDEX), chainIDBytes, utils.GetUint64Bytes(head))
+		store, err := native.GetCacheDB().Get(indexKey)
+		if err != nil {
+			return fmt.Errorf("get root index error: %v", err)
+		}
+		// heights synced out of order are pruned after the heights synced before them
+		height := utils.GetBytesUint32(store)
+		if height >= below {
+			break
+		}
+		native.GetCacheDB().Delete(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INFO), chainIDBytes, utils.GetUint32Bytes(height)))
+		native.GetCacheDB().Delete(indexKey)
+		head++
+		native.GetCacheDB().Put(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INDEX_HEAD), chainIDBytes), utils.GetUint64Bytes(head))
+	}
+	return nil
+}
+
+// appendRootIndex appends the height to the index of synced heights
+func appendRootIndex(native *native.NativeContract, chainID uint64, height uint32) error {
+	_, tail, err := getRootIndex(native, chainID)
+	if err != nil {
+		return err
+	}
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	native.GetCacheDB().Put(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INDEX), chainIDBytes, utils.GetUint64Bytes(tail)), utils.GetUint32Bytes(height))
+	native.GetCacheDB().Put(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INDEX_TAIL), chainIDBytes), utils.GetUint64Bytes(tail+1))
+	return nil
+}
+
+// getRootIndex returns the range [head, tail) of the index of synced heights not pruned yet
+func getRootIndex(native *native.NativeContract, chainID uint64) (uint64, uint64, error) {
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	head, err := native.GetCacheDB().Get(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INDEX_HEAD), chainIDBytes))
+	if err != nil {
+		return 0, 0, fmt.Errorf("get root index head error: %v", err)
+	}
+	tail, err := native.GetCacheDB().Get(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_INDEX_TAIL), chainIDBytes))
+	if err != nil {
+		return 0, 0, fmt.Errorf("get root index tail error: %v", err)
+	}
+	return utils.GetBytesUint64(head), utils.GetBytesUint64(tail), nil
+}
+
+// getPrunedHeight returns the height below which the root infos are pruned
+func getPrunedHeight(native *native.NativeContract, chainID uint64) (uint32, error) {
+	store, err := native.GetCacheDB().Get(utils.ConcatKey(utils.InfoSyncContractAddress, []byte(ROOT_PRUNED), utils.GetUint64Bytes(chainID)))
+	if err != nil {
+		return 0, fmt.Errorf("get pruned height error: %v", err)
+	}
+	return utils.GetBytesUint32(store), nil
+}
+
 func NotifyPutRootInfo(native *native.NativeContract, chainID uint64, height uint32) error {
 	err := native.AddNotify(ABI, []string{SYNC_ROOT_INFO_EVENT}, chainID, height, native.ContractRef().BlockHeight())
 	if err != nil {
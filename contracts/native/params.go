@@ -33,11 +33,11 @@ const (
 	NativeEconomic           = "economic"
 	NativeSignatureManager   = "signature_manager"
 	NativeProposalManager    = "proposal_manager"
+	NativeVesting            = "vesting"
+	NativeTreasury           = "treasury"
 
 	// native backup contracts
 	NativeExtra5  = "extra5"
-	NativeExtra6  = "extra6"
-	NativeExtra7  = "extra7"
 	NativeExtra8  = "extra8"
 	NativeExtra9  = "extra9"
 	NativeExtra10 = "extra10"
@@ -62,8 +62,8 @@ var NativeContractAddrMap = map[string]common.Address{
 	NativeNeo3StateManager:   utils.Neo3StateManagerContractAddress,
 	NativeSignatureManager:   utils.SignatureManagerContractAddress,
 	NativeProposalManager:    utils.ProposalManagerContractAddress,
-	NativeExtra6:             common.HexToAddress("0x0000000000000000000000000000000000001009"),
-	NativeExtra7:             common.HexToAddress("0x000000000000000000000000000000000000100a"),
+	NativeVesting:            utils.VestingContractAddress,
+	NativeTreasury:           utils.TreasuryContractAddress,
 	NativeExtra8:             common.HexToAddress("0x000000000000000000000000000000000000100b"),
 	NativeExtra9:             common.HexToAddress("0x000000000000000000000000000000000000100c"),
 	NativeExtra10:            common.HexToAddress("0x000000000000000000000000000000000000100d"),
@@ -86,3 +86,13 @@ func IsNativeContract(addr common.Address) bool {
 	}
 	return false
 }
+
+// lockedBalanceReceivers are the native contracts accepting the locked balance of vesting
+// accounts as stake, which should be tracked as delegated vesting by the contract.
+var lockedBalanceReceivers = map[common.Address]bool{
+	utils.NodeManagerContractAddress: true,
+}
+
+func AcceptsLockedBalance(addr common.Address) bool {
+	return lockedBalanceReceivers[addr]
+}
@@ -6,14 +6,36 @@ interface ICrossChainManager {
     event ReplenishEvent(string[] txHashes, uint64 chainID);
     event MultiSign(uint64 fromChainId, uint64 toChainId, string txHash, string payment, uint32 sequence);
     event RippleTx(uint64 fromChainId, uint64 toChainId, string txHash, string txJson, uint32 sequence);
+    event RippleSignerListSet(uint64 chainID, string txHash, string txJson, uint32 sequence);
+    event DistributeFee(address token, uint256 voterFee, uint256 relayerFee, uint256 communityFee);
+    event CircuitBreak(uint64 chainID, bytes asset, uint256 usage, uint256 threshold);
+    event TransferQueued(uint64 chainID, bytes crossChainID, uint64 releaseHeight);
+    event TransferCancelled(uint64 chainID, bytes crossChainID);
+    event TransferReleased(uint64 chainID, bytes crossChainID);
+    event BtcTx(uint64 fromChainId, uint64 toChainId, string txHash, string rawTx);
+    event BtcMultiSign(uint64 chainID, string txHash, string signedTx);
 
     function name() external view returns(string memory Name);
     
-    function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes memory Proof, bytes memory Extra, bytes memory Signature) external returns(bool success);
+    function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes memory Proof, bytes memory Extra, bytes memory Signature) external payable returns(bool success);
 
     function multiSignRipple(uint64 ToChainId, bytes calldata AssetAddress, uint64 FromChainId, bytes calldata TxHash, string calldata TxJson) external returns(bool success);
 
+    function syncBtcHeaders(uint64 chainID, bytes[] calldata headers) external returns(bool success);
+
+    function multiSignBtc(uint64 chainID, bytes calldata txHash, bytes calldata pubKey, bytes[] calldata sigs) external returns(bool success);
+
+    function getBtcBestHeader(uint64 chainID) external view returns(bytes memory);
+
+    function getBtcUtxos(uint64 chainID) external view returns(bytes memory);
+
     function reconstructRippleTx(uint64 FromChainId, bytes calldata TxHash, uint64 ToChainId) external returns(bool success);
+
+    function rotateRippleSigners(uint64 chainID, bytes[] calldata pks, uint64 quorum) external returns(bool success);
+
+    function bumpRippleFee(uint64 FromChainId, bytes calldata TxHash, uint64 ToChainId) external returns(bool success);
+
+    function getRippleTxRecord(uint64 chainID, uint32 sequence) external view returns(bytes memory);
   
     function checkDone(uint64 chainID, bytes memory crossChainID) external view returns(bool success);
 
@@ -22,4 +44,28 @@ interface ICrossChainManager {
     function WhiteChain(uint64 ChainID) external returns(bool success);
 
     function replenish(uint64 chainID, string[] calldata txHashes) external returns(bool success);
+
+    function setFeeConfig(uint64 voterRate, uint64 relayerRate, uint64 communityRate, uint64 period) external returns(bool success);
+
+    function getFeeConfig() external view returns(bytes memory);
+
+    function getFeePool(address token) external view returns(bytes memory);
+
+    function distributeFee() external returns(bool success);
+
+    function setRateLimit(uint64 chainID, bytes calldata asset, uint64 window, uint256 limit, uint256 pauseThreshold) external returns(bool success);
+
+    function getRateLimit(uint64 chainID, bytes calldata asset) external view returns(bytes memory);
+
+    function setTransferDelay(uint64 chainID, bytes calldata asset, uint256 threshold, uint64 delay) external returns(bool success);
+
+    function getTransferDelay(uint64 chainID, bytes calldata asset) external view returns(bytes memory);
+
+    function cancelTransfer(uint64 chainID, bytes calldata crossChainID) external returns(bool success);
+
+    function releaseTransfer(uint64 chainID, bytes calldata crossChainID) external returns(bool success);
+
+    function getPendingTransfer(uint64 chainID, bytes calldata crossChainID) external view returns(bytes memory);
+
+    function getPendingTransfers() external view returns(bytes memory);
 }
@@ -1,8 +1,8 @@
 pragma solidity >=0.7.0 <0.9.0;
 
 interface INodeManager {
-    function createValidator(address consensusAddress, address signerAddress, address proposalAddress, int commission, string calldata desc) external returns(bool success);
-    function updateValidator(address consensusAddress, address signerAddress, address proposalAddress, string calldata desc) external returns(bool success);
+    function createValidator(address consensusAddress, address signerAddress, address proposalAddress, int commission, string calldata desc, bytes calldata enode) external returns(bool success);
+    function updateValidator(address consensusAddress, address signerAddress, address proposalAddress, string calldata desc, bytes calldata enode) external returns(bool success);
     function updateCommission(address consensusAddress, int commission) external returns(bool success);
     function stake(address consensusAddress) external returns(bool success);
     function unStake(address consensusAddress, int amount) external returns(bool success);
@@ -17,6 +17,7 @@ interface INodeManager {
     function getCommunityInfo() external view returns (bytes memory);
     function getCurrentEpochInfo() external view returns (bytes memory);
     function getEpochInfo(int id) external view returns (bytes memory);
+    function getConsensusSigns(int epochID) external view returns (bytes memory);
     function getAllValidators() external view returns (bytes memory);
     function getValidator(address consensusAddress) external view returns (bytes memory);
     function getStakeInfo(address consensusAddress, address stakeAddress) external view returns (bytes memory);
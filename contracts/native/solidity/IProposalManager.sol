@@ -4,14 +4,21 @@ interface IProposalManager {
     function propose(bytes calldata content) external returns(bool success);
     function proposeConfig(bytes calldata content) external returns(bool success);
     function proposeCommunity(bytes calldata content) external returns(bool success);
+    function proposeEconomic(bytes calldata content) external returns(bool success);
+    function proposeSpend(bytes calldata content) external returns(bool success);
     function voteProposal(int ID) external returns(bool success);
     function getProposal(int ID) external view returns(bytes memory);
     function getProposalList() external view returns(bytes memory);
     function getConfigProposalList() external view returns(bytes memory);
     function getCommunityProposalList() external view returns(bytes memory);
+    function getEconomicProposalList() external view returns(bytes memory);
+    function getSpendProposalList() external view returns(bytes memory);
 
     event Propose(string ID, string caller, string stake, string content);
     event ProposeConfig(string ID, string caller, string stake, string content);
     event ProposeCommunity(string ID, string caller, string stake, string content);
+    event ProposeEconomic(string ID, string caller, string stake, string content);
+    event ProposeSpend(string ID, string caller, string stake, string content);
     event VoteProposal(string ID);
+    event ExecuteSpendFailed(string ID, string reason);
 }
@@ -39,5 +39,15 @@ interface ISideChainManager {
 
     function registerAsset(uint64 chainID, uint64[] calldata AssetMapKey, bytes[] calldata AssetMapValue, uint64[] calldata LockProxyMapKey, bytes[] calldata LockProxyMapValue) external returns (bool success);
 
+    function registerRippleCurrency(uint64 chainID, string calldata currency, bytes calldata issuer, uint64 precision) external returns (bool success);
+
     function getFee(uint64 chainID) external view returns (bytes memory);
+
+    function updateFeeToken(uint64 chainID, address feeToken) external returns (bool success);
+
+    function getFeeToken(uint64 chainID) external view returns (address);
+
+    function updateRootRetention(uint64 chainID, uint64 window) external returns (bool success);
+
+    function getRootRetention(uint64 chainID) external view returns (uint64 window);
 }
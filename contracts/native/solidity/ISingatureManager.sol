@@ -6,6 +6,8 @@ pragma solidity >=0.7.0 <0.9.0;
 
 interface ISignatureManager {
     function addSignature(address addr, uint256 sideChainID, bytes calldata subject, bytes calldata signature) external returns (bool);
+    function addTypedSignature(address addr, uint256 sideChainID, uint8 subjectType, bytes calldata subject, bytes calldata signature) external returns (bool success);
+    function getSignatures(bytes calldata id) external view returns (bytes memory bundle);
 
     event AddSignatureQuorumEvent(bytes id, bytes subject, uint256 sideChainID);
 }
@@ -0,0 +1,10 @@
+pragma solidity >=0.7.0 <0.9.0;
+
+interface ITreasury {
+    function name() external view returns (string memory);
+    function deposit() external returns (bool success);
+    function balance() external view returns (uint256);
+    function getSpend(uint256 ID) external view returns (bytes memory);
+    function getSpendCount() external view returns (uint256);
+    event Deposit(string from, string amount);
+}
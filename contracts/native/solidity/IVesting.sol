@@ -0,0 +1,9 @@
+pragma solidity >=0.7.0 <0.9.0;
+
+interface IVesting {
+    function name() external view returns (string memory);
+    function createVesting(address beneficiary, uint256 startHeight, uint256 cliffHeight, uint256 endHeight) external returns (bool success);
+    function getVesting(address beneficiary) external view returns (bytes memory);
+    function lockedBalance(address beneficiary) external view returns (uint256);
+    event CreateVesting(string beneficiary, string creator, string amount);
+}
@@ -40,9 +40,13 @@ var (
 	Neo3StateManagerContractAddress  = common.HexToAddress("0x0000000000000000000000000000000000001006")
 	SignatureManagerContractAddress  = common.HexToAddress("0x0000000000000000000000000000000000001007")
 	ProposalManagerContractAddress   = common.HexToAddress("0x0000000000000000000000000000000000001008")
+	VestingContractAddress           = common.HexToAddress("0x0000000000000000000000000000000000001009")
+	TreasuryContractAddress          = common.HexToAddress("0x000000000000000000000000000000000000100a")
 
 	NO_PROOF_ROUTER   = uint64(1)
 	ETH_COMMON_ROUTER = uint64(2)
 
-	RIPPLE_ROUTER    = uint64(6)
+	RIPPLE_ROUTER      = uint64(6)
+	ETH_RECEIPT_ROUTER = uint64(7)
+	BTC_ROUTER         = uint64(8)
 )
\ No newline at end of file
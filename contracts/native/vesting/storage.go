@@ -0,0 +1,122 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_VESTING       = "st_vesting"
+	SKP_VESTING_COUNT = "st_vesting_count"
+)
+
+func getVestingCount(db *state.CacheDB, addr common.Address) (*big.Int, error) {
+	store, err := db.Get(vestingCountKey(addr))
+	if err != nil {
+		return nil, fmt.Errorf("getVestingCount, get store error: %v", err)
+	}
+	return new(big.Int).SetBytes(store), nil
+}
+
+func setVestingCount(db *state.CacheDB, addr common.Address, count *big.Int) {
+	db.Put(vestingCountKey(addr), count.Bytes())
+}
+
+func getVesting(db *state.CacheDB, addr common.Address, ID *big.Int) (*VestingAccount, bool, error) {
+	store, err := db.Get(vestingKey(addr, ID))
+	if err != nil {
+		return nil, false, fmt.Errorf("getVesting, get store error: %v", err)
+	}
+	if len(store) == 0 {
+		return nil, false, nil
+	}
+	account := new(VestingAccount)
+	if err := rlp.DecodeBytes(store, account); err != nil {
+		return nil, false, fmt.Errorf("getVesting, deserialize vesting account error: %v", err)
+	}
+	return account, true, nil
+}
+
+// getVestings returns all the vesting accounts of addr in the order of ID.
+func getVestings(db *state.CacheDB, addr common.Address) (VestingAccounts, error) {
+	count, err := getVestingCount(db, addr)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make(VestingAccounts, 0, count.Uint64())
+	for ID := new(big.Int); ID.Cmp(count) < 0; ID = new(big.Int).Add(ID, common.Big1) {
+		account, found, err := getVesting(db, addr, ID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("getVestings, vesting account %s of %s not found", ID, addr.Hex())
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+func setVesting(db *state.CacheDB, account *VestingAccount) error {
+	store, err := rlp.EncodeToBytes(account)
+	if err != nil {
+		return fmt.Errorf("setVesting, serialize vesting account error: %v", err)
+	}
+	db.Put(vestingKey(account.Address, account.ID), store)
+	return nil
+}
+
+func setVestings(db *state.CacheDB, accounts VestingAccounts) error {
+	for _, account := range accounts {
+		if err := setVesting(db, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addVesting stores the account with the next ID of the beneficiary.
+func addVesting(db *state.CacheDB, account *VestingAccount) error {
+	count, err := getVestingCount(db, account.Address)
+	if err != nil {
+		return err
+	}
+	account.ID = count
+	if err := setVesting(db, account); err != nil {
+		return err
+	}
+	setVestingCount(db, account.Address, new(big.Int).Add(count, common.Big1))
+	return nil
+}
+
+func vestingCountKey(addr common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_VESTING_COUNT), addr.Bytes())
+}
+
+func vestingKey(addr common.Address, ID *big.Int) []byte {
+	return utils.ConcatKey(this, []byte(SKP_VESTING), addr.Bytes(), ID.Bytes())
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/vesting_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// VestingAccount locks the original vesting amount in the balance of beneficiary, which
+// is released linearly from StartHeight to EndHeight, and nothing is released before
+// CliffHeight. The stakes of the account are tracked as delegated vesting first and then
+// delegated free, and the delegated vesting is no longer locked in the balance. A
+// beneficiary may have several vesting accounts, which are identified by ID.
+type VestingAccount struct {
+	Address          common.Address
+	ID               *big.Int
+	OriginalVesting  *big.Int
+	StartHeight      *big.Int
+	CliffHeight      *big.Int
+	EndHeight        *big.Int
+	DelegatedVesting *big.Int
+	DelegatedFree    *big.Int
+}
+
+func (m *VestingAccount) validate() error {
+	if m.OriginalVesting == nil || m.OriginalVesting.Sign() <= 0 {
+		return fmt.Errorf("vesting amount must be positive")
+	}
+	if m.StartHeight.Sign() < 0 || m.CliffHeight.Cmp(m.StartHeight) < 0 || m.EndHeight.Cmp(m.CliffHeight) < 0 {
+		return fmt.Errorf("vesting heights must be start <= cliff <= end")
+	}
+	return nil
+}
+
+// VestedAt returns the amount released at the block of height.
+func (m *VestingAccount) VestedAt(height *big.Int) *big.Int {
+	switch {
+	case height.Cmp(m.CliffHeight) < 0:
+		return new(big.Int)
+	case height.Cmp(m.EndHeight) >= 0:
+		return new(big.Int).Set(m.OriginalVesting)
+	}
+	vested := new(big.Int).Mul(m.OriginalVesting, new(big.Int).Sub(height, m.StartHeight))
+	return vested.Div(vested, new(big.Int).Sub(m.EndHeight, m.StartHeight))
+}
+
+// VestingAt returns the amount not released at the block of height.
+func (m *VestingAccount) VestingAt(height *big.Int) *big.Int {
+	return new(big.Int).Sub(m.OriginalVesting, m.VestedAt(height))
+}
+
+// LockedAt returns the balance can not be transferred at the block of height.
+func (m *VestingAccount) LockedAt(height *big.Int) *big.Int {
+	locked := new(big.Int).Sub(m.VestingAt(height), m.DelegatedVesting)
+	if locked.Sign() < 0 {
+		return new(big.Int)
+	}
+	return locked
+}
+
+// trackDelegation records the stake of amount, which takes the vesting balance first.
+func (m *VestingAccount) trackDelegation(height, amount *big.Int) {
+	free := new(big.Int).Sub(amount, m.delegateVesting(height, amount))
+	m.DelegatedFree = new(big.Int).Add(m.DelegatedFree, free)
+}
+
+// trackUndelegation records the stake of amount returned, which releases the delegated
+// free first so that the returned tokens are locked again if they are still vesting.
+func (m *VestingAccount) trackUndelegation(amount *big.Int) {
+	rest := new(big.Int).Sub(amount, m.undelegateFree(amount))
+	m.undelegateVesting(rest)
+}
+
+// delegateVesting records at most amount of the vesting balance as delegated, and
+// returns the amount recorded.
+func (m *VestingAccount) delegateVesting(height, amount *big.Int) *big.Int {
+	vesting := new(big.Int).Sub(m.VestingAt(height), m.DelegatedVesting)
+	if vesting.Sign() < 0 {
+		vesting.SetUint64(0)
+	}
+	if vesting.Cmp(amount) > 0 {
+		vesting.Set(amount)
+	}
+	m.DelegatedVesting = new(big.Int).Add(m.DelegatedVesting, vesting)
+	return vesting
+}
+
+// undelegateFree releases at most amount of the delegated free, and returns the amount
+// released.
+func (m *VestingAccount) undelegateFree(amount *big.Int) *big.Int {
+	free := new(big.Int).Set(amount)
+	if free.Cmp(m.DelegatedFree) > 0 {
+		free.Set(m.DelegatedFree)
+	}
+	m.DelegatedFree = new(big.Int).Sub(m.DelegatedFree, free)
+	return free
+}
+
+// undelegateVesting releases at most amount of the delegated vesting, and returns the
+// amount released.
+func (m *VestingAccount) undelegateVesting(amount *big.Int) *big.Int {
+	vesting := new(big.Int).Set(amount)
+	if vesting.Cmp(m.DelegatedVesting) > 0 {
+		vesting.Set(m.DelegatedVesting)
+	}
+	m.DelegatedVesting = new(big.Int).Sub(m.DelegatedVesting, vesting)
+	return vesting
+}
+
+// VestingAccounts are the vesting accounts of a beneficiary in the order of ID.
+type VestingAccounts []*VestingAccount
+
+func (m *VestingAccounts) Decode(payload []byte) error {
+	var data struct {
+		Vesting []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGetVesting, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Vesting, m)
+}
+
+// LockedAt returns the sum of balance locked by the accounts at the block of height.
+func (m VestingAccounts) LockedAt(height *big.Int) *big.Int {
+	locked := new(big.Int)
+	for _, account := range m {
+		locked.Add(locked, account.LockedAt(height))
+	}
+	return locked
+}
+
+// trackDelegation records the stake of amount, which takes the vesting balance of the
+// accounts in order first, and the rest is delegated free of the last account.
+func (m VestingAccounts) trackDelegation(height, amount *big.Int) {
+	rest := new(big.Int).Set(amount)
+	for _, account := range m {
+		rest.Sub(rest, account.delegateVesting(height, rest))
+	}
+	last := m[len(m)-1]
+	last.DelegatedFree = new(big.Int).Add(last.DelegatedFree, rest)
+}
+
+// trackUndelegation records the stake of amount returned, the delegated free of all the
+// accounts is released before the delegated vesting.
+func (m VestingAccounts) trackUndelegation(amount *big.Int) {
+	rest := new(big.Int).Set(amount)
+	for _, account := range m {
+		rest.Sub(rest, account.undelegateFree(rest))
+	}
+	for _, account := range m {
+		rest.Sub(rest, account.undelegateVesting(rest))
+	}
+}
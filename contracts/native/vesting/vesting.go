@@ -0,0 +1,230 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/vesting_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	CREATE_VESTING_EVENT = "CreateVesting"
+)
+
+var (
+	gasTable = map[string]uint64{
+		MethodName:          39375,
+		MethodCreateVesting: 315000,
+		MethodGetVesting:    84000,
+		MethodLockedBalance: 84000,
+	}
+)
+
+func init() {
+	core.LockedBalance = LockedBalance
+}
+
+func InitVesting() {
+	InitABI()
+	native.Contracts[this] = RegisterVestingContract
+}
+
+func RegisterVestingContract(s *native.NativeContract) {
+	s.Prepare(ABI, gasTable)
+
+	s.Register(MethodName, Name)
+	s.Register(MethodCreateVesting, CreateVesting)
+	s.Register(MethodGetVesting, GetVesting)
+	s.Register(MethodLockedBalance, GetLockedBalance)
+}
+
+func Name(s *native.NativeContract) ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodName, contractName)
+}
+
+// CreateVesting locks the value sent by caller in a new vesting account of beneficiary,
+// the existing vesting accounts of beneficiary are not affected.
+func CreateVesting(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	caller := ctx.Caller
+	value := s.ContractRef().Value()
+	toAddress := s.ContractRef().TxTo()
+
+	if ctx.Caller != s.ContractRef().TxOrigin() {
+		return nil, fmt.Errorf("CreateVesting, contract call forbidden")
+	}
+	if toAddress != utils.VestingContractAddress {
+		return nil, fmt.Errorf("CreateVesting, to address %x must be vesting contract address %x", toAddress, utils.VestingContractAddress)
+	}
+
+	params := &CreateVestingParam{}
+	if err := utils.UnpackMethod(ABI, MethodCreateVesting, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("CreateVesting, unpack params error: %v", err)
+	}
+	account := &VestingAccount{
+		Address:         params.Beneficiary,
+		OriginalVesting: value,
+		StartHeight:     params.StartHeight,
+		CliffHeight:     params.CliffHeight,
+		EndHeight:       params.EndHeight,
+	}
+	// the value is already transferred to this contract
+	if err := CreateVestingAccount(s, this, account); err != nil {
+		return nil, fmt.Errorf("CreateVesting, %v", err)
+	}
+
+	err := s.AddNotify(ABI, []string{CREATE_VESTING_EVENT}, account.Address.Hex(), caller.Hex(), value.String())
+	if err != nil {
+		return nil, fmt.Errorf("CreateVesting, AddNotify error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodCreateVesting, true)
+}
+
+func GetVesting(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetVestingParam{}
+	if err := utils.UnpackMethod(ABI, MethodGetVesting, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetVesting, unpack params error: %v", err)
+	}
+
+	accounts, err := getVestings(s.GetCacheDB(), params.Beneficiary)
+	if err != nil {
+		return nil, fmt.Errorf("GetVesting, getVestings error: %v", err)
+	}
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("GetVesting, vesting account %s not found", params.Beneficiary.Hex())
+	}
+	enc, err := rlp.EncodeToBytes(accounts)
+	if err != nil {
+		return nil, fmt.Errorf("GetVesting, serialize vesting account error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodGetVesting, enc)
+}
+
+func GetLockedBalance(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &LockedBalanceParam{}
+	if err := utils.UnpackMethod(ABI, MethodLockedBalance, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetLockedBalance, unpack params error: %v", err)
+	}
+
+	accounts, err := getVestings(s.GetCacheDB(), params.Beneficiary)
+	if err != nil {
+		return nil, fmt.Errorf("GetLockedBalance, getVestings error: %v", err)
+	}
+	return utils.PackOutputs(ABI, MethodLockedBalance, accounts.LockedAt(s.ContractRef().BlockHeight()))
+}
+
+// CheckVestingAccount checks the schedule of the new vesting account, which should not
+// be ended at current height.
+func CheckVestingAccount(s *native.NativeContract, account *VestingAccount) error {
+	if err := account.validate(); err != nil {
+		return err
+	}
+	if account.EndHeight.Cmp(s.ContractRef().BlockHeight()) <= 0 {
+		return fmt.Errorf("end height %s is already reached", account.EndHeight)
+	}
+	return nil
+}
+
+// CreateVestingAccount transfers the original vesting amount from the balance of from to
+// the new vesting account, in which the amount is locked. The ID of account is assigned
+// in the order of creation.
+func CreateVestingAccount(s *native.NativeContract, from common.Address, account *VestingAccount) error {
+	account.DelegatedVesting = new(big.Int)
+	account.DelegatedFree = new(big.Int)
+	if err := CheckVestingAccount(s, account); err != nil {
+		return err
+	}
+	if err := contract.NativeTransfer(s.StateDB(), s.ContractRef().BlockHeight(), from, account.Address, account.OriginalVesting); err != nil {
+		return fmt.Errorf("utils.NativeTransfer error: %v", err)
+	}
+	return addVesting(s.GetCacheDB(), account)
+}
+
+// LockedBalance returns the balance of vesting account can not be transferred at the block
+// of height, it's zero for the other accounts.
+func LockedBalance(db vm.StateDB, addr common.Address, height *big.Int) *big.Int {
+	sdb, ok := db.(*state.StateDB)
+	if !ok {
+		return new(big.Int)
+	}
+	accounts, err := getVestings((*state.CacheDB)(sdb), addr)
+	if err != nil {
+		return new(big.Int)
+	}
+	return accounts.LockedAt(height)
+}
+
+// StoreGenesisVesting locks the amount of balance of the account in genesis block.
+func StoreGenesisVesting(s *state.StateDB, account *VestingAccount) error {
+	account.DelegatedVesting = new(big.Int)
+	account.DelegatedFree = new(big.Int)
+	if err := account.validate(); err != nil {
+		return fmt.Errorf("StoreGenesisVesting, %v", err)
+	}
+	db := (*state.CacheDB)(s)
+	accounts, err := getVestings(db, account.Address)
+	if err != nil {
+		return fmt.Errorf("StoreGenesisVesting, %v", err)
+	}
+	total := new(big.Int).Set(account.OriginalVesting)
+	for _, v := range accounts {
+		total.Add(total, v.OriginalVesting)
+	}
+	if s.GetBalance(account.Address).Cmp(total) < 0 {
+		return fmt.Errorf("StoreGenesisVesting, balance of %s is less than vesting amount", account.Address.Hex())
+	}
+	if err := addVesting(db, account); err != nil {
+		return fmt.Errorf("StoreGenesisVesting, %v", err)
+	}
+	return nil
+}
+
+// TrackDelegation records the stake of vesting account, which is called by node manager
+// once the stake is transferred.
+func TrackDelegation(s *native.NativeContract, addr common.Address, amount *big.Int) error {
+	accounts, err := getVestings(s.GetCacheDB(), addr)
+	if err != nil || len(accounts) == 0 {
+		return err
+	}
+	accounts.trackDelegation(s.ContractRef().BlockHeight(), amount)
+	return setVestings(s.GetCacheDB(), accounts)
+}
+
+// TrackUndelegation records the stake returned to vesting account, which is called by
+// node manager once the stake is transferred back.
+func TrackUndelegation(s *native.NativeContract, addr common.Address, amount *big.Int) error {
+	accounts, err := getVestings(s.GetCacheDB(), addr)
+	if err != nil || len(accounts) == 0 {
+		return err
+	}
+	accounts.trackUndelegation(amount)
+	return setVestings(s.GetCacheDB(), accounts)
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package vesting
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/contract"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/vesting_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	InitVesting()
+	os.Exit(m.Run())
+}
+
+func newTestAccount(amount, start, cliff, end int64) *VestingAccount {
+	return &VestingAccount{
+		Address:          common.HexToAddress("0x1234"),
+		OriginalVesting:  big.NewInt(amount),
+		StartHeight:      big.NewInt(start),
+		CliffHeight:      big.NewInt(cliff),
+		EndHeight:        big.NewInt(end),
+		DelegatedVesting: new(big.Int),
+		DelegatedFree:    new(big.Int),
+	}
+}
+
+func TestVestingSchedule(t *testing.T) {
+	account := newTestAccount(1000, 100, 150, 200)
+	assert.NoError(t, account.validate())
+
+	testcases := []struct {
+		height int64
+		vested int64
+	}{
+		{0, 0},
+		{100, 0},
+		{149, 0},
+		{150, 500},
+		{175, 750},
+		{199, 990},
+		{200, 1000},
+		{1000, 1000},
+	}
+	for _, tc := range testcases {
+		height := big.NewInt(tc.height)
+		assert.Equal(t, big.NewInt(tc.vested), account.VestedAt(height), "height %d", tc.height)
+		assert.Equal(t, big.NewInt(1000-tc.vested), account.LockedAt(height), "height %d", tc.height)
+	}
+
+	assert.Error(t, newTestAccount(0, 100, 150, 200).validate())
+	assert.Error(t, newTestAccount(1000, 100, 50, 200).validate())
+	assert.Error(t, newTestAccount(1000, 100, 150, 120).validate())
+}
+
+func TestTrackDelegation(t *testing.T) {
+	account := newTestAccount(1000, 0, 0, 100)
+
+	// half of the vesting is released, stake 600 takes 500 vesting and 100 free
+	account.trackDelegation(big.NewInt(50), big.NewInt(600))
+	assert.Equal(t, big.NewInt(500), account.DelegatedVesting)
+	assert.Equal(t, big.NewInt(100), account.DelegatedFree)
+	assert.Zero(t, account.LockedAt(big.NewInt(50)).Sign())
+
+	// the free is returned first
+	account.trackUndelegation(big.NewInt(200))
+	assert.Equal(t, big.NewInt(400), account.DelegatedVesting)
+	assert.Zero(t, account.DelegatedFree.Sign())
+	assert.Equal(t, big.NewInt(100), account.LockedAt(big.NewInt(50)))
+	assert.Zero(t, account.LockedAt(big.NewInt(60)).Sign())
+
+	account.trackUndelegation(big.NewInt(1000))
+	assert.Zero(t, account.DelegatedVesting.Sign())
+	assert.Equal(t, big.NewInt(500), account.LockedAt(big.NewInt(50)))
+}
+
+func TestTrackDelegationAccounts(t *testing.T) {
+	accounts := VestingAccounts{newTestAccount(1000, 0, 0, 100), newTestAccount(1000, 50, 50, 150)}
+	assert.Equal(t, big.NewInt(1500), accounts.LockedAt(big.NewInt(50)))
+
+	// stake 1700 takes 500 and 1000 vesting of the accounts, and 200 free
+	accounts.trackDelegation(big.NewInt(50), big.NewInt(1700))
+	assert.Equal(t, big.NewInt(500), accounts[0].DelegatedVesting)
+	assert.Equal(t, big.NewInt(1000), accounts[1].DelegatedVesting)
+	assert.Equal(t, big.NewInt(200), accounts[1].DelegatedFree)
+	assert.Zero(t, accounts.LockedAt(big.NewInt(50)).Sign())
+
+	// the free is returned first, then the vesting in order
+	accounts.trackUndelegation(big.NewInt(900))
+	assert.Zero(t, accounts[0].DelegatedVesting.Sign())
+	assert.Equal(t, big.NewInt(800), accounts[1].DelegatedVesting)
+	assert.Zero(t, accounts[1].DelegatedFree.Sign())
+	assert.Equal(t, big.NewInt(700), accounts.LockedAt(big.NewInt(50)))
+}
+
+func TestCreateVesting(t *testing.T) {
+	creator := common.HexToAddress("0x5678")
+	beneficiary := common.HexToAddress("0x1234")
+	value := big.NewInt(1000)
+	sdb := native.NewTestStateDB()
+	// the value is transferred to the contract by evm
+	sdb.AddBalance(this, value)
+
+	param := &CreateVestingParam{
+		Beneficiary: beneficiary,
+		StartHeight: big.NewInt(10),
+		CliffHeight: big.NewInt(10),
+		EndHeight:   big.NewInt(110),
+	}
+	payload, err := param.Encode()
+	assert.NoError(t, err)
+	_, err = native.TestNativeCall(t, this, MethodCreateVesting, payload, value, 1, creator, sdb, gasTable[MethodCreateVesting])
+	assert.NoError(t, err)
+	assert.Equal(t, value, sdb.GetBalance(beneficiary))
+	assert.Zero(t, sdb.GetBalance(this).Sign())
+
+	// another vesting account of beneficiary released from height 60
+	sdb.AddBalance(this, value)
+	param.StartHeight, param.CliffHeight = big.NewInt(60), big.NewInt(60)
+	payload, err = param.Encode()
+	assert.NoError(t, err)
+	_, err = native.TestNativeCall(t, this, MethodCreateVesting, payload, value, 1, creator, sdb, gasTable[MethodCreateVesting])
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Mul(value, big.NewInt(2)), sdb.GetBalance(beneficiary))
+
+	payload, err = (&GetVestingParam{Beneficiary: beneficiary}).Encode()
+	assert.NoError(t, err)
+	raw, err := native.TestNativeCall(t, this, MethodGetVesting, payload, common.Big0, 1, sdb, gasTable[MethodGetVesting])
+	assert.NoError(t, err)
+	accounts := VestingAccounts{}
+	assert.NoError(t, accounts.Decode(raw))
+	assert.Equal(t, 2, len(accounts))
+	for i, account := range accounts {
+		assert.Equal(t, big.NewInt(int64(i)), account.ID)
+		assert.Equal(t, value, account.OriginalVesting)
+		assert.Equal(t, big.NewInt(110), account.EndHeight)
+	}
+
+	payload, err = (&LockedBalanceParam{Beneficiary: beneficiary}).Encode()
+	assert.NoError(t, err)
+	raw, err = native.TestNativeCall(t, this, MethodLockedBalance, payload, common.Big0, 60, sdb, gasTable[MethodLockedBalance])
+	assert.NoError(t, err)
+	var locked *big.Int
+	assert.NoError(t, utils.UnpackOutputs(ABI, MethodLockedBalance, &locked, raw))
+	assert.Equal(t, big.NewInt(1500), locked)
+	assert.Equal(t, big.NewInt(1500), LockedBalance(sdb, beneficiary, big.NewInt(60)))
+	assert.Equal(t, big.NewInt(600), LockedBalance(sdb, beneficiary, big.NewInt(90)))
+	assert.Zero(t, LockedBalance(sdb, creator, big.NewInt(60)).Sign())
+
+	// end height is reached
+	param.Beneficiary = creator
+	payload, err = param.Encode()
+	assert.NoError(t, err)
+	_, err = native.TestNativeCall(t, this, MethodCreateVesting, payload, value, 110, creator, sdb, gasTable[MethodCreateVesting])
+	assert.Error(t, err)
+}
+
+func TestStoreGenesisVesting(t *testing.T) {
+	sdb := native.NewTestStateDB()
+	account := newTestAccount(1000, 0, 0, 100)
+	assert.Error(t, StoreGenesisVesting(sdb, account))
+
+	sdb.AddBalance(account.Address, big.NewInt(1000))
+	assert.NoError(t, StoreGenesisVesting(sdb, account))
+	// balance is not enough for both of the vesting accounts
+	assert.Error(t, StoreGenesisVesting(sdb, newTestAccount(1000, 0, 0, 100)))
+
+	sdb.AddBalance(account.Address, big.NewInt(500))
+	second := newTestAccount(500, 0, 50, 100)
+	assert.NoError(t, StoreGenesisVesting(sdb, second))
+
+	stored, err := getVestings((*state.CacheDB)(sdb), account.Address)
+	assert.NoError(t, err)
+	assert.Equal(t, VestingAccounts{account, second}, stored)
+	assert.Equal(t, big.NewInt(750), LockedBalance(sdb, account.Address, big.NewInt(50)))
+}
+
+func TestNativeTransferLocked(t *testing.T) {
+	sdb := native.NewTestStateDB()
+	account := newTestAccount(1000, 0, 0, 100)
+	sdb.AddBalance(account.Address, big.NewInt(1000))
+	assert.NoError(t, StoreGenesisVesting(sdb, account))
+
+	// half of the vesting is locked at height 50
+	to := common.HexToAddress("0x5678")
+	height := big.NewInt(50)
+	assert.Error(t, contract.NativeTransfer(sdb, height, account.Address, to, big.NewInt(600)))
+	assert.NoError(t, contract.NativeTransfer(sdb, height, account.Address, to, big.NewInt(500)))
+
+	// the locked balance could be staked into node manager
+	assert.NoError(t, contract.NativeTransfer(sdb, height, account.Address, utils.NodeManagerContractAddress, big.NewInt(500)))
+	assert.Zero(t, sdb.GetBalance(account.Address).Sign())
+}
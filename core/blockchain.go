@@ -653,6 +653,57 @@ func (bc *BlockChain) FastSyncCommitHead(hash common.Hash) error {
 	return nil
 }
 
+// CommitEpochCheckpoint writes the verified epoch start block, whose state has been synced,
+// as the new head with only the headers of its recent ancestors, so that the chain is extended
+// from the epoch boundary instead of the genesis, and the BLOCKHASH opcode of the following
+// blocks is served. The ancestors must be in ascending order and linked by the parent hash to
+// the block. The engines verifying headers by epoch use the constant difficulty, so that the
+// total difficulty is derived from the genesis one.
+func (bc *BlockChain) CommitEpochCheckpoint(ancestors []*types.Header, block *types.Block, receipts types.Receipts) error {
+	parent := block.ParentHash()
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		if hash := ancestors[i].Hash(); hash != parent {
+			return fmt.Errorf("epoch checkpoint ancestor %d hash %x, want %x", ancestors[i].Number, hash, parent)
+		}
+		parent = ancestors[i].ParentHash
+	}
+	if _, err := trie.NewSecure(block.Root(), bc.stateCache.TrieDB()); err != nil {
+		return err
+	}
+	bc.chainmu.Lock()
+	defer bc.chainmu.Unlock()
+
+	if head := bc.CurrentBlock().NumberU64(); head >= block.NumberU64() {
+		return fmt.Errorf("epoch checkpoint %d not above head %d", block.NumberU64(), head)
+	}
+	td := new(big.Int).Mul(block.Difficulty(), block.Number())
+	td.Add(td, bc.genesisBlock.Difficulty())
+
+	batch := bc.db.NewBatch()
+	for _, header := range ancestors {
+		number := header.Number.Uint64()
+		rawdb.WriteHeader(batch, header)
+		rawdb.WriteTd(batch, header.Hash(), number, new(big.Int).Add(bc.genesisBlock.Difficulty(), new(big.Int).Mul(header.Difficulty, header.Number)))
+		rawdb.WriteCanonicalHash(batch, header.Hash(), number)
+	}
+	rawdb.WriteTd(batch, block.Hash(), block.NumberU64(), td)
+	rawdb.WriteBlock(batch, block)
+	rawdb.WriteReceipts(batch, block.Hash(), block.NumberU64(), receipts)
+	rawdb.WriteEpochCheckpoint(batch, block.NumberU64())
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	bc.writeHeadBlock(block)
+
+	// Destroy any existing state snapshot and regenerate it in the background,
+	// also resuming the normal maintenance of any previously paused snapshot.
+	if bc.snaps != nil {
+		bc.snaps.Rebuild(block.Root())
+	}
+	log.Info("Committed epoch checkpoint", "number", block.Number(), "hash", block.Hash())
+	return nil
+}
+
 // GasLimit returns the gas limit of the current HEAD block.
 func (bc *BlockChain) GasLimit() uint64 {
 	return bc.CurrentBlock().GasLimit()
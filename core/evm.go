@@ -52,7 +52,7 @@ func NewEVMBlockContext(header *types.Header, chain ChainContext, author *common
 		baseFee = new(big.Int).Set(header.BaseFee)
 	}
 	return vm.BlockContext{
-		CanTransfer: CanTransfer,
+		CanTransfer: CanTransferAt(header.Number),
 		Transfer:    Transfer,
 		GetHash:     GetHashFn(header, chain),
 		Coinbase:    beneficiary,
@@ -112,6 +112,23 @@ func GetHashFn(ref *types.Header, chain ChainContext) func(n uint64) common.Hash
 	}
 }
 
+// LockedBalance returns the balance of vesting account which can not be transferred at
+// the block of height, it's set by the native vesting contract.
+var LockedBalance func(db vm.StateDB, addr common.Address, height *big.Int) *big.Int
+
+// CanTransferAt returns the transfer guard of the block of height, which also keeps the
+// locked balance of vesting accounts.
+func CanTransferAt(height *big.Int) vm.CanTransferFunc {
+	if LockedBalance == nil {
+		return CanTransfer
+	}
+	height = new(big.Int).Set(height)
+	return func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+		locked := LockedBalance(db, addr, height)
+		return db.GetBalance(addr).Cmp(new(big.Int).Add(amount, locked)) >= 0
+	}
+}
+
 // CanTransfer checks whether there are enough funds in the address' account to make a transfer.
 // This does not take the necessary gas in to account to make the transfer valid.
 func CanTransfer(db vm.StateDB, addr common.Address, amount *big.Int) bool {
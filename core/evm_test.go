@@ -0,0 +1,61 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func TestCanTransferAt(t *testing.T) {
+	db, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	vested, other := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	db.AddBalance(vested, big.NewInt(100))
+	db.AddBalance(other, big.NewInt(100))
+
+	defer func(fn func(vm.StateDB, common.Address, *big.Int) *big.Int) { LockedBalance = fn }(LockedBalance)
+	LockedBalance = func(_ vm.StateDB, addr common.Address, height *big.Int) *big.Int {
+		if addr != vested || height.Cmp(big.NewInt(10)) >= 0 {
+			return new(big.Int)
+		}
+		return big.NewInt(60)
+	}
+
+	tests := []struct {
+		addr   common.Address
+		height int64
+		amount int64
+		ok     bool
+	}{
+		{vested, 1, 40, true},
+		{vested, 1, 41, false},
+		{vested, 10, 100, true},
+		{other, 1, 100, true},
+		{other, 1, 101, false},
+	}
+	for i, tt := range tests {
+		canTransfer := CanTransferAt(big.NewInt(tt.height))
+		if ok := canTransfer(db, tt.addr, big.NewInt(tt.amount)); ok != tt.ok {
+			t.Errorf("test %d: have %v, want %v", i, ok, tt.ok)
+		}
+	}
+}
@@ -18,22 +18,27 @@ var _ = (*genesisSpecMarshaling)(nil)
 // MarshalJSON marshals as JSON.
 func (g Genesis) MarshalJSON() ([]byte, error) {
 	type Genesis struct {
-		Config           *params.ChainConfig                         `json:"config"`
-		Nonce            math.HexOrDecimal64                         `json:"nonce"`
-		Timestamp        math.HexOrDecimal64                         `json:"timestamp"`
-		ExtraData        hexutil.Bytes                               `json:"extraData"`
-		GasLimit         math.HexOrDecimal64                         `json:"gasLimit"   gencodec:"required"`
-		Difficulty       *math.HexOrDecimal256                       `json:"difficulty" gencodec:"required"`
-		Mixhash          common.Hash                                 `json:"mixHash"`
-		Coinbase         common.Address                              `json:"coinbase"`
-		Alloc            map[common.UnprefixedAddress]GenesisAccount `json:"alloc"      gencodec:"required"`
-		Governance       GenesisGovernance                           `json:"governance" gencodec:"required"`
-		CommunityRate    *big.Int                                    `json:"community_rate" gencodec:"required"`
-		CommunityAddress common.Address                              `json:"community_address" gencodec:"required"`
-		Number           math.HexOrDecimal64                         `json:"number"`
-		GasUsed          math.HexOrDecimal64                         `json:"gasUsed"`
-		ParentHash       common.Hash                                 `json:"parentHash"`
-		BaseFee          *math.HexOrDecimal256                       `json:"baseFeePerGas"`
+		Config               *params.ChainConfig                         `json:"config"`
+		Nonce                math.HexOrDecimal64                         `json:"nonce"`
+		Timestamp            math.HexOrDecimal64                         `json:"timestamp"`
+		ExtraData            hexutil.Bytes                               `json:"extraData"`
+		GasLimit             math.HexOrDecimal64                         `json:"gasLimit"   gencodec:"required"`
+		Difficulty           *math.HexOrDecimal256                       `json:"difficulty" gencodec:"required"`
+		Mixhash              common.Hash                                 `json:"mixHash"`
+		Coinbase             common.Address                              `json:"coinbase"`
+		Alloc                map[common.UnprefixedAddress]GenesisAccount `json:"alloc"      gencodec:"required"`
+		Governance           GenesisGovernance                           `json:"governance" gencodec:"required"`
+		CommunityRate        *big.Int                                    `json:"community_rate" gencodec:"required"`
+		CommunityAddress     common.Address                              `json:"community_address" gencodec:"required"`
+		Treasury             bool                                        `json:"treasury,omitempty"`
+		CommunityBaseFeeRate *big.Int                                    `json:"community_base_fee_rate,omitempty"`
+		NodeManager          *NodeManagerConfig                          `json:"node_manager,omitempty"`
+		Economic             *EconomicConfig                             `json:"economic,omitempty"`
+		Vestings             []GenesisVesting                            `json:"vestings,omitempty"`
+		Number               math.HexOrDecimal64                         `json:"number"`
+		GasUsed              math.HexOrDecimal64                         `json:"gasUsed"`
+		ParentHash           common.Hash                                 `json:"parentHash"`
+		BaseFee              *math.HexOrDecimal256                       `json:"baseFeePerGas"`
 	}
 	var enc Genesis
 	enc.Config = g.Config
@@ -53,6 +58,11 @@ func (g Genesis) MarshalJSON() ([]byte, error) {
 	enc.Governance = g.Governance
 	enc.CommunityRate = g.CommunityRate
 	enc.CommunityAddress = g.CommunityAddress
+	enc.Treasury = g.Treasury
+	enc.CommunityBaseFeeRate = g.CommunityBaseFeeRate
+	enc.NodeManager = g.NodeManager
+	enc.Economic = g.Economic
+	enc.Vestings = g.Vestings
 	enc.Number = math.HexOrDecimal64(g.Number)
 	enc.GasUsed = math.HexOrDecimal64(g.GasUsed)
 	enc.ParentHash = g.ParentHash
@@ -63,22 +73,27 @@ func (g Genesis) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON unmarshals from JSON.
 func (g *Genesis) UnmarshalJSON(input []byte) error {
 	type Genesis struct {
-		Config           *params.ChainConfig                         `json:"config"`
-		Nonce            *math.HexOrDecimal64                        `json:"nonce"`
-		Timestamp        *math.HexOrDecimal64                        `json:"timestamp"`
-		ExtraData        *hexutil.Bytes                              `json:"extraData"`
-		GasLimit         *math.HexOrDecimal64                        `json:"gasLimit"   gencodec:"required"`
-		Difficulty       *math.HexOrDecimal256                       `json:"difficulty" gencodec:"required"`
-		Mixhash          *common.Hash                                `json:"mixHash"`
-		Coinbase         *common.Address                             `json:"coinbase"`
-		Alloc            map[common.UnprefixedAddress]GenesisAccount `json:"alloc"      gencodec:"required"`
-		Governance       *GenesisGovernance                          `json:"governance"`
-		CommunityRate    *big.Int                                    `json:"community_rate" gencodec:"required"`
-		CommunityAddress *common.Address                             `json:"community_address" gencodec:"required"`
-		Number           *math.HexOrDecimal64                        `json:"number"`
-		GasUsed          *math.HexOrDecimal64                        `json:"gasUsed"`
-		ParentHash       *common.Hash                                `json:"parentHash"`
-		BaseFee          *math.HexOrDecimal256                       `json:"baseFeePerGas"`
+		Config               *params.ChainConfig                         `json:"config"`
+		Nonce                *math.HexOrDecimal64                        `json:"nonce"`
+		Timestamp            *math.HexOrDecimal64                        `json:"timestamp"`
+		ExtraData            *hexutil.Bytes                              `json:"extraData"`
+		GasLimit             *math.HexOrDecimal64                        `json:"gasLimit"   gencodec:"required"`
+		Difficulty           *math.HexOrDecimal256                       `json:"difficulty" gencodec:"required"`
+		Mixhash              *common.Hash                                `json:"mixHash"`
+		Coinbase             *common.Address                             `json:"coinbase"`
+		Alloc                map[common.UnprefixedAddress]GenesisAccount `json:"alloc"      gencodec:"required"`
+		Governance           *GenesisGovernance                          `json:"governance"`
+		CommunityRate        *big.Int                                    `json:"community_rate" gencodec:"required"`
+		CommunityAddress     *common.Address                             `json:"community_address" gencodec:"required"`
+		Treasury             *bool                                       `json:"treasury,omitempty"`
+		CommunityBaseFeeRate *big.Int                                    `json:"community_base_fee_rate,omitempty"`
+		NodeManager          *NodeManagerConfig                          `json:"node_manager,omitempty"`
+		Economic             *EconomicConfig                             `json:"economic,omitempty"`
+		Vestings             []GenesisVesting                            `json:"vestings,omitempty"`
+		Number               *math.HexOrDecimal64                        `json:"number"`
+		GasUsed              *math.HexOrDecimal64                        `json:"gasUsed"`
+		ParentHash           *common.Hash                                `json:"parentHash"`
+		BaseFee              *math.HexOrDecimal256                       `json:"baseFeePerGas"`
 	}
 	var dec Genesis
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -127,6 +142,21 @@ func (g *Genesis) UnmarshalJSON(input []byte) error {
 	if dec.CommunityAddress != nil {
 		g.CommunityAddress = *dec.CommunityAddress
 	}
+	if dec.Treasury != nil {
+		g.Treasury = *dec.Treasury
+	}
+	if dec.CommunityBaseFeeRate != nil {
+		g.CommunityBaseFeeRate = dec.CommunityBaseFeeRate
+	}
+	if dec.NodeManager != nil {
+		g.NodeManager = dec.NodeManager
+	}
+	if dec.Economic != nil {
+		g.Economic = dec.Economic
+	}
+	if dec.Vestings != nil {
+		g.Vestings = dec.Vestings
+	}
 	if dec.Number != nil {
 		g.Number = uint64(*dec.Number)
 	}
@@ -5,8 +5,10 @@ package core
 import (
 	"encoding/json"
 	"errors"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 )
 
 var _ = (*genesisGovernanceMarshaling)(nil)
@@ -14,20 +16,38 @@ var _ = (*genesisGovernanceMarshaling)(nil)
 // MarshalJSON marshals as JSON.
 func (g GovernanceAccount) MarshalJSON() ([]byte, error) {
 	type GovernanceAccount struct {
-		Validator common.UnprefixedAddress `json:"validator" gencodec:"required"`
-		Signer    common.UnprefixedAddress `json:"signer" gencodec:"required"`
+		Validator       common.UnprefixedAddress `json:"validator" gencodec:"required"`
+		Signer          common.UnprefixedAddress `json:"signer" gencodec:"required"`
+		StakeAddress    common.UnprefixedAddress `json:"stake_address"`
+		ProposalAddress common.UnprefixedAddress `json:"proposal_address"`
+		Commission      *math.HexOrDecimal256    `json:"commission,omitempty"`
+		SelfStake       *math.HexOrDecimal256    `json:"self_stake,omitempty"`
+		Desc            string                   `json:"desc,omitempty"`
+		Delegations     []GenesisDelegation      `json:"delegations,omitempty"`
 	}
 	var enc GovernanceAccount
 	enc.Validator = common.UnprefixedAddress(g.Validator)
 	enc.Signer = common.UnprefixedAddress(g.Signer)
+	enc.StakeAddress = common.UnprefixedAddress(g.StakeAddress)
+	enc.ProposalAddress = common.UnprefixedAddress(g.ProposalAddress)
+	enc.Commission = (*math.HexOrDecimal256)(g.Commission)
+	enc.SelfStake = (*math.HexOrDecimal256)(g.SelfStake)
+	enc.Desc = g.Desc
+	enc.Delegations = g.Delegations
 	return json.Marshal(&enc)
 }
 
 // UnmarshalJSON unmarshals from JSON.
 func (g *GovernanceAccount) UnmarshalJSON(input []byte) error {
 	type GovernanceAccount struct {
-		Validator *common.UnprefixedAddress `json:"validator" gencodec:"required"`
-		Signer    *common.UnprefixedAddress `json:"signer" gencodec:"required"`
+		Validator       *common.UnprefixedAddress `json:"validator" gencodec:"required"`
+		Signer          *common.UnprefixedAddress `json:"signer" gencodec:"required"`
+		StakeAddress    *common.UnprefixedAddress `json:"stake_address"`
+		ProposalAddress *common.UnprefixedAddress `json:"proposal_address"`
+		Commission      *math.HexOrDecimal256     `json:"commission,omitempty"`
+		SelfStake       *math.HexOrDecimal256     `json:"self_stake,omitempty"`
+		Desc            *string                   `json:"desc,omitempty"`
+		Delegations     []GenesisDelegation       `json:"delegations,omitempty"`
 	}
 	var dec GovernanceAccount
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -41,5 +61,23 @@ func (g *GovernanceAccount) UnmarshalJSON(input []byte) error {
 		return errors.New("missing required field 'signer' for GovernanceAccount")
 	}
 	g.Signer = common.Address(*dec.Signer)
+	if dec.StakeAddress != nil {
+		g.StakeAddress = common.Address(*dec.StakeAddress)
+	}
+	if dec.ProposalAddress != nil {
+		g.ProposalAddress = common.Address(*dec.ProposalAddress)
+	}
+	if dec.Commission != nil {
+		g.Commission = (*big.Int)(dec.Commission)
+	}
+	if dec.SelfStake != nil {
+		g.SelfStake = (*big.Int)(dec.SelfStake)
+	}
+	if dec.Desc != nil {
+		g.Desc = *dec.Desc
+	}
+	if dec.Delegations != nil {
+		g.Delegations = dec.Delegations
+	}
 	return nil
 }
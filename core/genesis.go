@@ -43,6 +43,10 @@ import (
 //go:generate gencodec -type Genesis -field-override genesisSpecMarshaling -out gen_genesis.go
 //go:generate gencodec -type GenesisAccount -field-override genesisAccountMarshaling -out gen_genesis_account.go
 //go:generate gencodec -type GovernanceAccount -field-override genesisGovernanceMarshaling -out gen_genesis_governance.go
+//go:generate gencodec -type GenesisDelegation -field-override genesisDelegationMarshaling -out gen_genesis_delegation.go
+//go:generate gencodec -type NodeManagerConfig -field-override nodeManagerConfigMarshaling -out gen_genesis_node_manager.go
+//go:generate gencodec -type EconomicConfig -field-override economicConfigMarshaling -out gen_genesis_economic.go
+//go:generate gencodec -type GenesisVesting -field-override genesisVestingMarshaling -out gen_genesis_vesting.go
 
 var errGenesisNoConfig = errors.New("genesis has no chain configuration")
 
@@ -59,9 +63,18 @@ type Genesis struct {
 	Coinbase   common.Address      `json:"coinbase"`
 	Alloc      GenesisAlloc        `json:"alloc"      gencodec:"required"`
 	Governance GenesisGovernance   `json:"governance"`
-	// config of community pool
+	// config of community pool, which is the treasury contract if the address is empty
+	// and the treasury is enabled
 	CommunityRate    *big.Int       `json:"community_rate"`
 	CommunityAddress common.Address `json:"community_address"`
+	Treasury         bool           `json:"treasury,omitempty"`
+	// share of the base fee sent to community pool, the rest is burned
+	CommunityBaseFeeRate *big.Int `json:"community_base_fee_rate,omitempty"`
+	// config of node manager and economic contracts, the unset fields take defaults
+	NodeManager *NodeManagerConfig `json:"node_manager,omitempty"`
+	Economic    *EconomicConfig    `json:"economic,omitempty"`
+	// vesting accounts locking part of their balances in alloc
+	Vestings []GenesisVesting `json:"vestings,omitempty"`
 
 	// These fields are used for consensus tests. Please don't use them
 	// in actual genesis blocks.
@@ -101,6 +114,57 @@ type GenesisGovernance []GovernanceAccount
 type GovernanceAccount struct {
 	Validator common.Address `json:"validator" gencodec:"required"`
 	Signer    common.Address `json:"signer" gencodec:"required"`
+
+	// staking setup of the genesis validator, the validator is registered in node
+	// manager contract only if the self stake is set, and the stakes are locked from
+	// the balances of stake address and delegators in genesis alloc.
+	StakeAddress    common.Address      `json:"stake_address"`
+	ProposalAddress common.Address      `json:"proposal_address"`     // defaults to signer address
+	Commission      *big.Int            `json:"commission,omitempty"` // in basis points
+	SelfStake       *big.Int            `json:"self_stake,omitempty"`
+	Desc            string              `json:"desc,omitempty"`
+	Delegations     []GenesisDelegation `json:"delegations,omitempty"`
+}
+
+// GenesisDelegation is the stake delegated to a genesis validator.
+type GenesisDelegation struct {
+	Delegator common.Address `json:"delegator" gencodec:"required"`
+	Amount    *big.Int       `json:"amount" gencodec:"required"`
+}
+
+// GenesisVesting locks the amount of balance of an alloc account, which is released
+// linearly from the start height to the end height, and nothing is released before
+// the cliff height.
+type GenesisVesting struct {
+	Address     common.Address `json:"address" gencodec:"required"`
+	Amount      *big.Int       `json:"amount" gencodec:"required"`
+	StartHeight uint64         `json:"start_height,omitempty"`
+	CliffHeight uint64         `json:"cliff_height,omitempty"`
+	EndHeight   uint64         `json:"end_height" gencodec:"required"`
+}
+
+// NodeManagerConfig is the initial global config of node manager contract.
+type NodeManagerConfig struct {
+	MaxCommissionChange   *big.Int `json:"max_commission_change,omitempty"` // in basis points
+	MinInitialStake       *big.Int `json:"min_initial_stake,omitempty"`
+	MinProposalStake      *big.Int `json:"min_proposal_stake,omitempty"`
+	BlockPerEpoch         *big.Int `json:"block_per_epoch,omitempty"`
+	ConsensusValidatorNum uint64   `json:"consensus_validator_num,omitempty"`
+	VoterValidatorNum     uint64   `json:"voter_validator_num,omitempty"`
+}
+
+// EconomicConfig is the token economic parameters of economic contract.
+type EconomicConfig struct {
+	GenesisSupply  *big.Int `json:"genesis_supply,omitempty"` // total balance of genesis alloc
+	RewardPerBlock *big.Int `json:"reward_per_block,omitempty"`
+
+	// inflation schedule, rates are in basis points and the unset fields disable it
+	DecayInterval     *big.Int `json:"decay_interval,omitempty"`      // blocks between two reward decays
+	DecayRate         *big.Int `json:"decay_rate,omitempty"`          // reward reduction of each decay, 5000 halves it
+	TargetStakingRate *big.Int `json:"target_staking_rate,omitempty"` // target ratio of total stake to supply
+	AdjustRate        *big.Int `json:"adjust_rate,omitempty"`         // reward change of each epoch to approach the target
+	MinRewardPerBlock *big.Int `json:"min_reward_per_block,omitempty"`
+	MaxRewardPerBlock *big.Int `json:"max_reward_per_block,omitempty"`
 }
 
 // field type overrides for gencodec
@@ -124,8 +188,45 @@ type genesisAccountMarshaling struct {
 }
 
 type genesisGovernanceMarshaling struct {
-	Validator common.UnprefixedAddress
-	Signer    common.UnprefixedAddress
+	Validator       common.UnprefixedAddress
+	Signer          common.UnprefixedAddress
+	StakeAddress    common.UnprefixedAddress
+	ProposalAddress common.UnprefixedAddress
+	Commission      *math.HexOrDecimal256
+	SelfStake       *math.HexOrDecimal256
+}
+
+type nodeManagerConfigMarshaling struct {
+	MaxCommissionChange   *math.HexOrDecimal256
+	MinInitialStake       *math.HexOrDecimal256
+	MinProposalStake      *math.HexOrDecimal256
+	BlockPerEpoch         *math.HexOrDecimal256
+	ConsensusValidatorNum math.HexOrDecimal64
+	VoterValidatorNum     math.HexOrDecimal64
+}
+
+type genesisVestingMarshaling struct {
+	Address     common.UnprefixedAddress
+	Amount      *math.HexOrDecimal256
+	StartHeight math.HexOrDecimal64
+	CliffHeight math.HexOrDecimal64
+	EndHeight   math.HexOrDecimal64
+}
+
+type economicConfigMarshaling struct {
+	GenesisSupply     *math.HexOrDecimal256
+	RewardPerBlock    *math.HexOrDecimal256
+	DecayInterval     *math.HexOrDecimal256
+	DecayRate         *math.HexOrDecimal256
+	TargetStakingRate *math.HexOrDecimal256
+	AdjustRate        *math.HexOrDecimal256
+	MinRewardPerBlock *math.HexOrDecimal256
+	MaxRewardPerBlock *math.HexOrDecimal256
+}
+
+type genesisDelegationMarshaling struct {
+	Delegator common.UnprefixedAddress
+	Amount    *math.HexOrDecimal256
 }
 
 // storageJSON represents a 256 bit byte array, but allows less than 256 bits when
@@ -203,11 +304,15 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 			genesis = DefaultGenesisBlock()
 		}
 		// Ensure the stored genesis matches with the given one.
-		hash := genesis.ToBlock(nil).Hash()
+		block, err := genesis.toBlock(nil)
+		if err != nil {
+			return genesis.Config, common.Hash{}, err
+		}
+		hash := block.Hash()
 		if hash != stored {
 			return genesis.Config, hash, &GenesisMismatchError{stored, hash}
 		}
-		block, err := genesis.Commit(db)
+		block, err = genesis.Commit(db)
 		if err != nil {
 			return genesis.Config, hash, err
 		}
@@ -215,7 +320,11 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	}
 	// Check whether the genesis block is already written.
 	if genesis != nil {
-		hash := genesis.ToBlock(nil).Hash()
+		block, err := genesis.toBlock(nil)
+		if err != nil {
+			return genesis.Config, common.Hash{}, err
+		}
+		hash := block.Hash()
 		if hash != stored {
 			return genesis.Config, hash, &GenesisMismatchError{stored, hash}
 		}
@@ -279,8 +388,19 @@ var (
 )
 
 // ToBlock creates the genesis block and writes state of a genesis specification
-// to the given database (or discards it if nil).
+// to the given database (or discards it if nil). The governance setup errors are
+// only logged here, they are reported by Commit and SetupGenesisBlock.
 func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
+	block, err := g.toBlock(db)
+	if err != nil {
+		log.Error("Failed to setup genesis governance", "err", err)
+	}
+	return block
+}
+
+// toBlock creates the genesis block as ToBlock, and returns the governance setup
+// error besides. The state is not written to the database if the setup fails.
+func (g *Genesis) toBlock(db ethdb.Database) (*types.Block, error) {
 	if db == nil {
 		db = rawdb.NewMemoryDatabase()
 	}
@@ -291,6 +411,7 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 	}
 
 	// initialize zion native contract and governance parameters, and `regGenesis` should be nil in mock mode.
+	var regErr error
 	if RegGenesis != nil {
 		g.checkExtra()
 		g.checkGovernance()
@@ -298,7 +419,9 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 		for _, v := range native.NativeContractAddrMap {
 			g.createNativeContract(statedb, v)
 		}
-		RegGenesis(statedb, g)
+		if err := RegGenesis(statedb, g); err != nil {
+			regErr = fmt.Errorf("failed to setup genesis governance: %v", err)
+		}
 	} else {
 		g.mintNativeToken(statedb)
 	}
@@ -334,10 +457,14 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 			head.BaseFee = new(big.Int).SetUint64(params.InitialBaseFee)
 		}
 	}
+	block := types.NewBlock(head, nil, nil, nil, trie.NewStackTrie(nil))
+	if regErr != nil {
+		return block, regErr
+	}
 	statedb.Commit(false)
 	statedb.Database().TrieDB().Commit(root, true, nil)
 
-	return types.NewBlock(head, nil, nil, nil, trie.NewStackTrie(nil))
+	return block, nil
 }
 
 // checkExtra validators should be sorted and do not allow dump validators.
@@ -397,8 +524,8 @@ func (g *Genesis) mintNativeToken(statedb *state.StateDB) {
 	for _, account := range g.Alloc {
 		total = new(big.Int).Add(total, account.Balance)
 	}
-	if CheckAllocWithTotalSupply && total.Cmp(params.GenesisSupply) != 0 {
-		panic(fmt.Sprintf("alloc amount %s should be equal to genesis supply %s", total, params.GenesisSupply))
+	if supply := g.genesisSupply(); CheckAllocWithTotalSupply && total.Cmp(supply) != 0 {
+		panic(fmt.Sprintf("alloc amount %s should be equal to genesis supply %s", total, supply))
 	}
 
 	for addr, account := range g.Alloc {
@@ -411,10 +538,22 @@ func (g *Genesis) mintNativeToken(statedb *state.StateDB) {
 	}
 }
 
+// genesisSupply returns the genesis supply of economic config, or the default one
+// if it's not configured.
+func (g *Genesis) genesisSupply() *big.Int {
+	if g.Economic != nil && g.Economic.GenesisSupply != nil {
+		return g.Economic.GenesisSupply
+	}
+	return params.GenesisSupply
+}
+
 // Commit writes the block and state of a genesis specification to the database.
 // The block is committed as the canonical head block.
 func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
-	block := g.ToBlock(db)
+	block, err := g.toBlock(db)
+	if err != nil {
+		return nil, err
+	}
 	if block.Number().Sign() != 0 {
 		return nil, fmt.Errorf("can't commit genesis block with number > 0")
 	}
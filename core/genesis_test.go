@@ -17,6 +17,8 @@
 package core
 
 import (
+	"encoding/json"
+	"errors"
 	"math/big"
 	"reflect"
 	"testing"
@@ -25,6 +27,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
@@ -170,3 +174,92 @@ func TestGenesisHashes(t *testing.T) {
 		}
 	}
 }
+
+func TestGovernanceAccountJSON(t *testing.T) {
+	input := `{
+		"validator": "0000000000000000000000000000000000000001",
+		"signer": "0000000000000000000000000000000000000002",
+		"stake_address": "0000000000000000000000000000000000000003",
+		"commission": "0x3e8",
+		"self_stake": "100000",
+		"delegations": [{"delegator": "0000000000000000000000000000000000000004", "amount": "1000"}]
+	}`
+	var account GovernanceAccount
+	if err := json.Unmarshal([]byte(input), &account); err != nil {
+		t.Fatalf("failed to unmarshal governance account: %v", err)
+	}
+	want := GovernanceAccount{
+		Validator:    common.HexToAddress("0x01"),
+		Signer:       common.HexToAddress("0x02"),
+		StakeAddress: common.HexToAddress("0x03"),
+		Commission:   big.NewInt(1000),
+		SelfStake:    big.NewInt(100000),
+		Delegations:  []GenesisDelegation{{Delegator: common.HexToAddress("0x04"), Amount: big.NewInt(1000)}},
+	}
+	if !reflect.DeepEqual(account, want) {
+		t.Fatalf("governance account mismatch: have %v, want %v", spew.Sdump(account), spew.Sdump(want))
+	}
+	blob, err := json.Marshal(account)
+	if err != nil {
+		t.Fatalf("failed to marshal governance account: %v", err)
+	}
+	var dec GovernanceAccount
+	if err := json.Unmarshal(blob, &dec); err != nil || !reflect.DeepEqual(dec, want) {
+		t.Fatalf("governance account round trip mismatch: %v", err)
+	}
+}
+
+func TestGenesisGovernanceError(t *testing.T) {
+	CheckAllocWithTotalSupply = false
+	defer func(reg func(*state.StateDB, *Genesis) error) { RegGenesis = reg }(RegGenesis)
+
+	genesis := &Genesis{
+		Config: &params.ChainConfig{ChainID: big.NewInt(1), HotStuff: &params.HotStuffConfig{}},
+		Alloc:  GenesisAlloc{common.HexToAddress("0x01"): {Balance: big.NewInt(1)}},
+	}
+	genesis.ExtraData, _ = types.GenerateExtraWithSignature(0, 1, nil, []byte{}, [][]byte{})
+
+	// the governance setup error is reported rather than panicking
+	errGovernance := errors.New("self stake exceeds balance")
+	RegGenesis = func(db *state.StateDB, genesis *Genesis) error { return errGovernance }
+	db := rawdb.NewMemoryDatabase()
+	if _, err := genesis.Commit(db); err == nil {
+		t.Fatalf("genesis committed with invalid governance")
+	}
+	if hash := rawdb.ReadCanonicalHash(db, 0); hash != (common.Hash{}) {
+		t.Fatalf("invalid genesis written: %x", hash)
+	}
+	if _, _, err := SetupGenesisBlock(db, genesis); err == nil {
+		t.Fatalf("genesis setup with invalid governance")
+	}
+
+	// the stored genesis is checked against the invalid one
+	RegGenesis = func(db *state.StateDB, genesis *Genesis) error { return nil }
+	if _, _, err := SetupGenesisBlock(db, genesis); err != nil {
+		t.Fatalf("failed to setup genesis: %v", err)
+	}
+	RegGenesis = func(db *state.StateDB, genesis *Genesis) error { return errGovernance }
+	if _, _, err := SetupGenesisBlock(db, genesis); err == nil {
+		t.Fatalf("stored genesis matched with invalid governance")
+	}
+}
+
+func TestGenesisConfigJSON(t *testing.T) {
+	input := `{
+		"alloc": {},
+		"gasLimit": "0x1000",
+		"difficulty": "0x1",
+		"node_manager": {"block_per_epoch": "100"},
+		"economic": {"reward_per_block": "5"}
+	}`
+	var genesis Genesis
+	if err := json.Unmarshal([]byte(input), &genesis); err != nil {
+		t.Fatalf("failed to unmarshal genesis: %v", err)
+	}
+	if genesis.NodeManager == nil || genesis.NodeManager.BlockPerEpoch.Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("node manager config mismatch: %v", spew.Sdump(genesis.NodeManager))
+	}
+	if genesis.Economic == nil || genesis.Economic.RewardPerBlock.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("economic config mismatch: %v", spew.Sdump(genesis.Economic))
+	}
+}
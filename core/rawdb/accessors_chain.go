@@ -215,6 +215,32 @@ func WriteLastPivotNumber(db ethdb.KeyValueWriter, pivot uint64) {
 	}
 }
 
+// ReadEpochCheckpoint retrieves the number of the epoch checkpoint block, below which
+// the blocks are never synced. If the node synced from genesis, it will be nil.
+func ReadEpochCheckpoint(db ethdb.KeyValueReader) *uint64 {
+	data, _ := db.Get(epochCheckpointKey)
+	if len(data) == 0 {
+		return nil
+	}
+	var number uint64
+	if err := rlp.DecodeBytes(data, &number); err != nil {
+		log.Error("Invalid epoch checkpoint number in database", "err", err)
+		return nil
+	}
+	return &number
+}
+
+// WriteEpochCheckpoint stores the number of the epoch checkpoint block.
+func WriteEpochCheckpoint(db ethdb.KeyValueWriter, number uint64) {
+	enc, err := rlp.EncodeToBytes(number)
+	if err != nil {
+		log.Crit("Failed to encode epoch checkpoint number", "err", err)
+	}
+	if err := db.Put(epochCheckpointKey, enc); err != nil {
+		log.Crit("Failed to store epoch checkpoint number", "err", err)
+	}
+}
+
 // ReadFastTrieProgress retrieves the number of tries nodes fast synced to allow
 // reporting correct numbers across restarts.
 func ReadFastTrieProgress(db ethdb.KeyValueReader) uint64 {
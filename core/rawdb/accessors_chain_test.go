@@ -496,6 +496,94 @@ func TestAncientStorage(t *testing.T) {
 	}
 }
 
+func TestFreezeEpochCheckpointGap(t *testing.T) {
+	frdir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temp freezer dir: %v", err)
+	}
+	defer os.RemoveAll(frdir)
+
+	kvdb := NewMemoryDatabase()
+	db, err := NewDatabaseWithFreezer(kvdb, frdir, "", false)
+	if err != nil {
+		t.Fatalf("failed to create database with ancient backend: %v", err)
+	}
+	// Write the genesis and the chain from the epoch checkpoint, leaving the gap in between
+	const checkpoint = 5
+	blocks := make(map[uint64]*types.Block)
+	for _, number := range []uint64{0, 5, 6, 7, 8, 9, 10} {
+		block := types.NewBlockWithHeader(&types.Header{
+			Number:      new(big.Int).SetUint64(number),
+			Extra:       []byte("test block"),
+			UncleHash:   types.EmptyUncleHash,
+			TxHash:      types.EmptyRootHash,
+			ReceiptHash: types.EmptyRootHash,
+		})
+		WriteBlock(db, block)
+		WriteReceipts(db, block.Hash(), number, nil)
+		WriteTd(db, block.Hash(), number, big.NewInt(int64(number+1)))
+		WriteCanonicalHash(db, block.Hash(), number)
+		blocks[number] = block
+	}
+	// The ancestor right below the checkpoint only has the header
+	ancestor := &types.Header{Number: big.NewInt(checkpoint - 1), Extra: []byte("test ancestor")}
+	WriteHeader(db, ancestor)
+	WriteTd(db, ancestor.Hash(), checkpoint-1, big.NewInt(checkpoint))
+	WriteCanonicalHash(db, ancestor.Hash(), checkpoint-1)
+
+	WriteEpochCheckpoint(db, checkpoint)
+	WriteHeadHeaderHash(db, blocks[10].Hash())
+	WriteHeadBlockHash(db, blocks[10].Hash())
+
+	freeze := func(threshold uint64) {
+		if err := db.(*freezerdb).Freeze(threshold); err != nil {
+			t.Fatalf("failed to freeze: %v", err)
+		}
+	}
+	// Freeze into the gap and ensure the database can be reopened
+	freeze(8)
+	if frozen, _ := db.Ancients(); frozen != 3 {
+		t.Fatalf("frozen mismatch: have %d, want %d", frozen, 3)
+	}
+	if err := db.(*freezerdb).AncientStore.Close(); err != nil {
+		t.Fatalf("failed to close freezer: %v", err)
+	}
+	if db, err = NewDatabaseWithFreezer(kvdb, frdir, "", false); err != nil {
+		t.Fatalf("failed to reopen database with ancient backend: %v", err)
+	}
+	// Freeze over the gap
+	freeze(2)
+	if frozen, _ := db.Ancients(); frozen != 9 {
+		t.Fatalf("frozen mismatch: have %d, want %d", frozen, 9)
+	}
+	for number := uint64(1); number < checkpoint-1; number++ {
+		if hash := ReadCanonicalHash(db, number); hash != (common.Hash{}) {
+			t.Fatalf("block %d: canonical hash mismatch: have %x, want empty", number, hash)
+		}
+	}
+	if hash := ReadCanonicalHash(db, checkpoint-1); hash != ancestor.Hash() {
+		t.Fatalf("ancestor: canonical hash mismatch: have %x, want %x", hash, ancestor.Hash())
+	}
+	if header := ReadHeader(db, ancestor.Hash(), checkpoint-1); header == nil {
+		t.Fatalf("ancestor: header missing")
+	}
+	if body := ReadBody(db, ancestor.Hash(), checkpoint-1); body != nil {
+		t.Fatalf("ancestor: unexpected body")
+	}
+	for number, block := range blocks {
+		if hash := ReadCanonicalHash(db, number); hash != block.Hash() {
+			t.Fatalf("block %d: canonical hash mismatch: have %x, want %x", number, hash, block.Hash())
+		}
+		if header := ReadHeader(db, block.Hash(), number); header == nil {
+			t.Fatalf("block %d: header missing", number)
+		}
+		if body := ReadBody(db, block.Hash(), number); body == nil {
+			t.Fatalf("block %d: body missing", number)
+		}
+	}
+	db.Close()
+}
+
 func TestCanonicalHashIteration(t *testing.T) {
 	var cases = []struct {
 		from, to uint64
@@ -168,8 +168,10 @@ func NewDatabaseWithFreezer(db ethdb.KeyValueStore, freezer string, namespace st
 			// are contiguous, otherwise we might end up with a non-functional freezer.
 			if kvhash, _ := db.Get(headerHashKey(frozen)); len(kvhash) == 0 {
 				// Subsequent header after the freezer limit is missing from the database.
-				// Reject startup is the database has a more recent head.
-				if *ReadHeaderNumber(db, ReadHeadHeaderHash(db)) > frozen-1 {
+				// Reject startup is the database has a more recent head, unless the
+				// header is below the epoch checkpoint, which is never synced.
+				checkpoint := ReadEpochCheckpoint(db)
+				if *ReadHeaderNumber(db, ReadHeadHeaderHash(db)) > frozen-1 && (checkpoint == nil || frozen >= *checkpoint) {
 					return nil, fmt.Errorf("gap (#%d) in the chain between ancients and leveldb", frozen)
 				}
 				// Database contains only older data than the freezer, this happens if the
@@ -351,6 +351,15 @@ func (f *freezer) freeze(db ethdb.KeyValueStore) {
 			// Retrieves all the components of the canonical block
 			hash := ReadCanonicalHash(nfdb, f.frozen)
 			if hash == (common.Hash{}) {
+				// The blocks below the epoch checkpoint are never synced, skip them with
+				// empty items to keep the ancient tables contiguous.
+				if checkpoint := ReadEpochCheckpoint(nfdb); checkpoint != nil && f.frozen < *checkpoint {
+					if err := f.AppendAncient(f.frozen, nil, nil, nil, nil, nil); err != nil {
+						break
+					}
+					ancients = append(ancients, hash)
+					continue
+				}
 				log.Error("Canonical hash missing, can't freeze", "number", f.frozen)
 				break
 			}
@@ -361,6 +370,14 @@ func (f *freezer) freeze(db ethdb.KeyValueStore) {
 			}
 			body := ReadBodyRLP(nfdb, hash, f.frozen)
 			if len(body) == 0 {
+				// The ancestors of the epoch checkpoint are synced without bodies and receipts.
+				if checkpoint := ReadEpochCheckpoint(nfdb); checkpoint != nil && f.frozen < *checkpoint {
+					if err := f.AppendAncient(f.frozen, hash[:], header, nil, nil, ReadTdRLP(nfdb, hash, f.frozen)); err != nil {
+						break
+					}
+					ancients = append(ancients, hash)
+					continue
+				}
 				log.Error("Block body missing, can't freeze", "number", f.frozen, "hash", hash)
 				break
 			}
@@ -42,6 +42,9 @@ var (
 	// lastPivotKey tracks the last pivot block used by fast sync (to reenable on sethead).
 	lastPivotKey = []byte("LastPivot")
 
+	// epochCheckpointKey tracks the epoch checkpoint block synced without its ancestors.
+	epochCheckpointKey = []byte("EpochCheckpoint")
+
 	// fastTrieProgressKey tracks the number of trie entries imported during fast sync.
 	fastTrieProgressKey = []byte("TrieSync")
 
@@ -24,7 +24,9 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -141,3 +143,59 @@ func GenerateBadBlock(parent *types.Block, engine consensus.Engine, txs types.Tr
 	// Assemble and return the final block for sealing
 	return types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
 }
+
+// TestDistributeGasFee tests the gas fee is separated into base fee and priority fee.
+func TestDistributeGasFee(t *testing.T) {
+	defer func() { DistributeGasFee = nil }()
+
+	var (
+		from     = common.HexToAddress("0x01")
+		to       = common.HexToAddress("0x02")
+		coinbase = common.HexToAddress("0x03")
+		baseFee  = big.NewInt(params.InitialBaseFee)
+	)
+	apply := func(gasPrice *big.Int, hook bool) (*state.StateDB, []*big.Int) {
+		var distributed []*big.Int
+		DistributeGasFee = nil
+		if hook {
+			DistributeGasFee = func(db vm.StateDB, proposer common.Address, base, tip *big.Int) {
+				if proposer != coinbase {
+					t.Fatalf("proposer mismatch: have %x, want %x", proposer, coinbase)
+				}
+				distributed = []*big.Int{base, tip}
+			}
+		}
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		statedb.AddBalance(from, big.NewInt(params.Ether))
+		blockCtx := vm.BlockContext{
+			CanTransfer: CanTransfer,
+			Transfer:    Transfer,
+			Coinbase:    coinbase,
+			BlockNumber: big.NewInt(1),
+			GasLimit:    params.TxGas,
+			BaseFee:     baseFee,
+		}
+		msg := types.NewMessage(from, &to, 0, big.NewInt(0), params.TxGas, gasPrice, gasPrice, new(big.Int).Sub(gasPrice, baseFee), nil, nil, true)
+		evm := vm.NewEVM(blockCtx, NewEVMTxContext(msg), statedb, params.TestChainConfig, vm.Config{})
+		if _, err := ApplyMessage(evm, msg, new(GasPool).AddGas(params.TxGas)); err != nil {
+			t.Fatalf("failed to apply message: %v", err)
+		}
+		return statedb, distributed
+	}
+
+	gasPrice := new(big.Int).Add(baseFee, big.NewInt(2))
+	statedb, _ := apply(gasPrice, false)
+	if have, want := statedb.GetBalance(utils.NodeManagerContractAddress), new(big.Int).Mul(gasPrice, big.NewInt(int64(params.TxGas))); have.Cmp(want) != 0 {
+		t.Fatalf("deposited gas fee mismatch: have %v, want %v", have, want)
+	}
+	statedb, distributed := apply(gasPrice, true)
+	if statedb.GetBalance(utils.NodeManagerContractAddress).Sign() != 0 {
+		t.Fatalf("gas fee should be distributed by hook")
+	}
+	if have, want := distributed[0], new(big.Int).Mul(baseFee, big.NewInt(int64(params.TxGas))); have.Cmp(want) != 0 {
+		t.Fatalf("base fee mismatch: have %v, want %v", have, want)
+	}
+	if have, want := distributed[1], big.NewInt(int64(2*params.TxGas)); have.Cmp(want) != 0 {
+		t.Fatalf("priority fee mismatch: have %v, want %v", have, want)
+	}
+}
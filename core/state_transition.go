@@ -156,6 +156,11 @@ func IntrinsicGas(data []byte, accessList types.AccessList, isContractCreation b
 	return gas, nil
 }
 
+// DistributeGasFee distributes the base fee and priority fee of transaction after
+// London, it's set by the governance contracts. The whole gas fee is deposited into
+// node manager contract if it's nil.
+var DistributeGasFee func(db vm.StateDB, proposer common.Address, baseFee, tip *big.Int)
+
 // NewStateTransition initialises and returns a new state transition object.
 func NewStateTransition(evm *vm.EVM, msg Message, gp *GasPool) *StateTransition {
 	return &StateTransition{
@@ -201,6 +206,10 @@ func (st *StateTransition) buyGas() error {
 	if have, want := st.state.GetBalance(st.msg.From()), balanceCheck; have.Cmp(want) < 0 {
 		return fmt.Errorf("%w: address %v have %v want %v", ErrInsufficientFunds, st.msg.From().Hex(), have, want)
 	}
+	// the locked balance of vesting account can not pay for gas
+	if balanceCheck.Sign() > 0 && !st.evm.Context.CanTransfer(st.state, st.msg.From(), balanceCheck) {
+		return fmt.Errorf("%w: address %v balance is locked", ErrInsufficientFunds, st.msg.From().Hex())
+	}
 	if err := st.gp.SubGas(st.msg.Gas()); err != nil {
 		return err
 	}
@@ -295,8 +304,8 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	}
 	st.gas -= gas
 
-	// Check clause 6
-	if msg.Value().Sign() > 0 && !st.evm.Context.CanTransfer(st.state, msg.From(), msg.Value()) {
+	// Check clause 6, the locked balance is checked by evm since it could be staked
+	if msg.Value().Sign() > 0 && !CanTransfer(st.state, msg.From(), msg.Value()) {
 		return nil, fmt.Errorf("%w: address %v", ErrInsufficientFundsForTransfer, msg.From().Hex())
 	}
 
@@ -325,8 +334,19 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	st.refundGas(params.RefundQuotientEIP3529)
 	log.Trace("Refund Gas", "gas price", st.gasPrice.String(), "gas used", st.gasUsed())
 	// Gas fee as reward will be deposited into governance contract instead of st.evm.Context.Coinbase like in pow mode
-	gasFee := new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice)
-	st.state.AddBalance(utils.NodeManagerContractAddress, gasFee)
+	gasUsed := new(big.Int).SetUint64(st.gasUsed())
+	gasFee := new(big.Int).Mul(gasUsed, st.gasPrice)
+	if DistributeGasFee == nil || st.evm.Context.BaseFee == nil {
+		st.state.AddBalance(utils.NodeManagerContractAddress, gasFee)
+	} else {
+		// the system transactions are allowed to pay less than base fee
+		price := st.evm.Context.BaseFee
+		if st.gasPrice.Cmp(price) < 0 {
+			price = st.gasPrice
+		}
+		baseFee := new(big.Int).Mul(gasUsed, price)
+		DistributeGasFee(st.state, st.evm.Context.Coinbase, baseFee, new(big.Int).Sub(gasFee, baseFee))
+	}
 
 	return &ExecutionResult{
 		UsedGas:    st.gasUsed(),
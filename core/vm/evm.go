@@ -227,7 +227,7 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 		return nil, gas, ErrDepth
 	}
 	// Fail if we're trying to transfer more than the available balance
-	if value.Sign() != 0 && !evm.Context.CanTransfer(evm.StateDB, caller.Address(), value) {
+	if value.Sign() != 0 && !evm.canTransferTo(caller.Address(), addr, value) {
 		return nil, gas, ErrInsufficientBalance
 	}
 	snapshot := evm.StateDB.Snapshot()
@@ -293,6 +293,15 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	return ret, gas, err
 }
 
+// canTransferTo checks the value transfer of call, the locked balance of vesting account
+// could be staked into the native contracts which track it as delegated vesting.
+func (evm *EVM) canTransferTo(from, to common.Address, value *big.Int) bool {
+	if native.AcceptsLockedBalance(to) {
+		return evm.StateDB.GetBalance(from).Cmp(value) >= 0
+	}
+	return evm.Context.CanTransfer(evm.StateDB, from, value)
+}
+
 // CallCode executes the contract associated with the addr with the given input
 // as parameters. It also handles any necessary value transfer required and takes
 // the necessary steps to create accounts and reverses the state in case of an
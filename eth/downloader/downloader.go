@@ -27,6 +27,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state/snapshot"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -107,13 +108,17 @@ type Downloader struct {
 	mux  *event.TypeMux // Event multiplexer to announce sync operation events
 
 	checkpoint uint64   // Checkpoint block number to enforce head against (e.g. fast sync)
-	genesis    uint64   // Genesis block number to limit sync to (e.g. light client CHT)
+	genesis    uint64   // Genesis block number to limit sync to (e.g. light client CHT, epoch checkpoint)
 	queue      *queue   // Scheduler for selecting the hashes to download
 	peers      *peerSet // Set of active peers from which download can proceed
 
 	stateDB    ethdb.Database  // Database to state sync into (and deduplicate via)
 	stateBloom *trie.SyncBloom // Bloom filter for fast trie node and contract code existence checks
 
+	epochCheckpoint common.Hash             // Trusted epoch start header hash to sync the epochs from
+	epochVerifier   consensus.EpochVerifier // Verifier of the epoch start headers (e.g. hotstuff)
+	epochSnap       bool                    // Whether to sync the epoch checkpoint state over the snap protocol
+
 	// Statistics
 	syncStatsChainOrigin uint64 // Origin block number where syncing started at
 	syncStatsChainHeight uint64 // Highest block number known when syncing started
@@ -216,6 +221,10 @@ type BlockChain interface {
 	// InsertReceiptChain inserts a batch of receipts into the local chain.
 	InsertReceiptChain(types.Blocks, []types.Receipts, uint64) (int, error)
 
+	// CommitEpochCheckpoint directly commits the epoch start block as the head along with the
+	// headers of its recent ancestors.
+	CommitEpochCheckpoint([]*types.Header, *types.Block, types.Receipts) error
+
 	// Snapshots returns the blockchain snapshot tree to paused it during sync.
 	Snapshots() *snapshot.Tree
 }
@@ -392,6 +401,12 @@ func (d *Downloader) synchronise(id string, hash common.Hash, td *big.Int, mode
 	if mode == FullSync && d.stateBloom != nil {
 		d.stateBloom.Close()
 	}
+	// The epoch checkpoint sync replaces the fast and snap sync, the state is only
+	// synced at the latest epoch boundary and the blocks after are fully imported.
+	if d.epochVerifier != nil && (mode == FastSync || mode == SnapSync) {
+		d.epochSnap = mode == SnapSync
+		mode = FullSync
+	}
 	// If snap sync was requested, create the snap scheduler and switch to fast
 	// sync mode. Long term we could drop fast sync or merge the two together,
 	// but until snap becomes prevalent, we should support both. TODO(karalabe).
@@ -485,6 +500,11 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 	if err != nil {
 		return err
 	}
+	if mode == FullSync && d.epochVerifier != nil && d.blockchain.CurrentFastBlock().NumberU64() == 0 {
+		if err := d.syncEpochs(p, latest); err != nil {
+			return err
+		}
+	}
 	if mode == FastSync && pivot == nil {
 		// If no pivot block was returned, the head is below the min full block
 		// threshold (i.e. new chian). In that case we won't really fast sync
@@ -805,9 +825,10 @@ func (d *Downloader) findAncestor(p *peerConnection, remoteHeader *types.Header)
 		// We're above the max reorg threshold, find the earliest fork point
 		floor = int64(localHeight - maxForkAncestry)
 	}
-	// If we're doing a light sync, ensure the floor doesn't go below the CHT, as
-	// all headers before that point will be missing.
-	if mode == LightSync {
+	// If we're doing a light sync, or a full sync from the epoch checkpoint, ensure
+	// the floor doesn't go below the CHT or the checkpoint, as all headers before
+	// that point will be missing.
+	if mode == LightSync || (mode == FullSync && d.epochVerifier != nil) {
 		// If we don't know the current CHT position, find it
 		if d.genesis == 0 {
 			header := d.lightchain.CurrentHeader()
@@ -816,6 +837,10 @@ func (d *Downloader) findAncestor(p *peerConnection, remoteHeader *types.Header)
 				if floor >= int64(d.genesis)-1 {
 					break
 				}
+				// The ancestors of the epoch checkpoint are synced without bodies
+				if mode == FullSync && !d.blockchain.HasBlock(header.ParentHash, header.Number.Uint64()-1) {
+					break
+				}
 				header = d.lightchain.GetHeaderByHash(header.ParentHash)
 			}
 		}
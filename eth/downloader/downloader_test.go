@@ -235,6 +235,27 @@ func (dl *downloadTester) FastSyncCommitHead(hash common.Hash) error {
 	return fmt.Errorf("non existent block: %x", hash[:4])
 }
 
+// CommitEpochCheckpoint directly commits the epoch start block as the head along with the
+// headers of its recent ancestors.
+func (dl *downloadTester) CommitEpochCheckpoint(ancestors []*types.Header, block *types.Block, receipts types.Receipts) error {
+	dl.lock.Lock()
+	defer dl.lock.Unlock()
+
+	if _, err := trie.NewSecure(block.Root(), trie.NewDatabase(dl.stateDb)); err != nil {
+		return err
+	}
+	for _, header := range ancestors {
+		dl.ownHeaders[header.Hash()] = header
+	}
+	hash := block.Hash()
+	dl.ownHashes = append(dl.ownHashes, hash)
+	dl.ownHeaders[hash] = block.Header()
+	dl.ownBlocks[hash] = block
+	dl.ownReceipts[hash] = receipts
+	dl.ownChainTd[hash] = new(big.Int).Add(dl.genesis.Difficulty(), new(big.Int).Mul(block.Difficulty(), block.Number()))
+	return nil
+}
+
 // GetTd retrieves the block's total difficulty from the canonical chain.
 func (dl *downloadTester) GetTd(hash common.Hash, number uint64) *big.Int {
 	dl.lock.RLock()
@@ -0,0 +1,251 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package downloader
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// epochAncestors is the number of headers below the committed epoch start block to be synced,
+// which are accessed by the BLOCKHASH opcode in the blocks after it.
+const epochAncestors = 256
+
+// SetEpochCheckpoint enables the epoch checkpoint sync from the trusted epoch start header,
+// which replaces the fast and snap sync of an empty chain.
+func (d *Downloader) SetEpochCheckpoint(hash common.Hash, verifier consensus.EpochVerifier) {
+	d.epochCheckpoint = hash
+	d.epochVerifier = verifier
+}
+
+// syncEpochs downloads the chain of epoch start headers from the trusted one, verifying
+// each against the validators elected in the previous one. The state of the latest epoch
+// start block below the remote head is synced, and the block is committed as the local head,
+// so that the blocks before the epoch boundary are never replayed.
+func (d *Downloader) syncEpochs(p *peerConnection, latest *types.Header) error {
+	epoch, err := d.fetchEpochHeader(p, func() error {
+		return p.peer.RequestHeadersByHash(d.epochCheckpoint, 1, 0, false)
+	})
+	if err != nil {
+		return err
+	}
+	if epoch.Hash() != d.epochCheckpoint {
+		return fmt.Errorf("%w: epoch checkpoint %x != requested %x", errBadPeer, epoch.Hash(), d.epochCheckpoint)
+	}
+	if start, _, err := d.epochVerifier.EpochRange(epoch); err != nil || start != epoch.Number.Uint64() {
+		return fmt.Errorf("epoch checkpoint %d is not an epoch start header", epoch.Number)
+	}
+	p.log.Debug("Synchronising epochs", "checkpoint", epoch.Number, "head", latest.Number)
+
+	for {
+		_, end, err := d.epochVerifier.EpochRange(epoch)
+		if err != nil {
+			return err
+		}
+		if end > latest.Number.Uint64() {
+			break
+		}
+		header, err := d.fetchEpochHeader(p, func() error {
+			return p.peer.RequestHeadersByNumber(end, 1, 0, false)
+		})
+		if err != nil {
+			return err
+		}
+		if err := d.epochVerifier.VerifyEpochHeader(epoch, header); err != nil {
+			return fmt.Errorf("%w: epoch %d: %v", errInvalidChain, end, err)
+		}
+		epoch = header
+	}
+	if epoch.Number.Uint64() == 0 {
+		return nil
+	}
+	ancestors, err := d.fetchEpochAncestors(p, epoch)
+	if err != nil {
+		return err
+	}
+	block, receipts, err := d.fetchEpochBlock(p, epoch)
+	if err != nil {
+		return err
+	}
+	log.Info("Syncing epoch checkpoint state", "number", epoch.Number, "hash", epoch.Hash(), "root", epoch.Root)
+
+	if d.epochSnap && !d.snapSync {
+		// Snap sync uses the snapshot namespace to store potentially flakey data until
+		// sync completely heals and finishes, the snapshot is rebuilt on commit.
+		if snapshots := d.blockchain.Snapshots(); snapshots != nil {
+			snapshots.Disable()
+		}
+		d.snapSync = true
+	}
+	sync := d.syncState(epoch.Root)
+	if err := sync.Wait(); err != nil {
+		sync.Cancel()
+		return err
+	}
+	return d.blockchain.CommitEpochCheckpoint(ancestors, block, receipts)
+}
+
+// fetchEpochAncestors retrieves the headers right below the verified epoch start header, which
+// are linked backwards by the parent hash from it.
+func (d *Downloader) fetchEpochAncestors(p *peerConnection, epoch *types.Header) ([]*types.Header, error) {
+	number := epoch.Number.Uint64()
+	from := uint64(1)
+	if number > epochAncestors {
+		from = number - epochAncestors
+	}
+	ancestors := make([]*types.Header, 0, number-from)
+	for next := from; next < number; {
+		count := number - next
+		if count > uint64(MaxHeaderFetch) {
+			count = uint64(MaxHeaderFetch)
+		}
+		headers, err := d.fetchEpochHeaders(p, int(count), func() error {
+			return p.peer.RequestHeadersByNumber(next, int(count), 0, false)
+		})
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, headers...)
+		next += count
+	}
+	parent := epoch.ParentHash
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		if ancestors[i].Hash() != parent {
+			return nil, fmt.Errorf("%w: epoch ancestor %d not linked to checkpoint %d", errInvalidChain, from+uint64(i), number)
+		}
+		parent = ancestors[i].ParentHash
+	}
+	return ancestors, nil
+}
+
+// fetchEpochHeader sends the request of a single header to the peer and waits for it.
+func (d *Downloader) fetchEpochHeader(p *peerConnection, request func() error) (*types.Header, error) {
+	headers, err := d.fetchEpochHeaders(p, 1, request)
+	if err != nil {
+		return nil, err
+	}
+	return headers[0], nil
+}
+
+// fetchEpochHeaders sends the request of the given amount of headers to the peer and waits for them.
+func (d *Downloader) fetchEpochHeaders(p *peerConnection, amount int, request func() error) ([]*types.Header, error) {
+	if err := request(); err != nil {
+		return nil, fmt.Errorf("%w: request epoch header: %v", errBadPeer, err)
+	}
+
+	ttl := d.requestTTL()
+	timeout := time.After(ttl)
+	for {
+		select {
+		case <-d.cancelCh:
+			return nil, errCanceled
+
+		case packet := <-d.headerCh:
+			// Discard anything not from the origin peer
+			if packet.PeerId() != p.id {
+				log.Debug("Received headers from incorrect peer", "peer", packet.PeerId())
+				break
+			}
+			headers := packet.(*headerPack).headers
+			if len(headers) != amount {
+				return nil, fmt.Errorf("%w: returned headers %d != requested %d", errBadPeer, len(headers), amount)
+			}
+			return headers, nil
+
+		case <-timeout:
+			p.log.Debug("Waiting for epoch header timed out", "elapsed", ttl)
+			return nil, errTimeout
+
+		case <-d.bodyCh:
+		case <-d.receiptCh:
+			// Out of bounds delivery, ignore
+		}
+	}
+}
+
+// fetchEpochBlock retrieves the body and receipts of the verified epoch start header.
+func (d *Downloader) fetchEpochBlock(p *peerConnection, header *types.Header) (*types.Block, types.Receipts, error) {
+	hashes := []common.Hash{header.Hash()}
+	if err := p.peer.RequestBodies(hashes); err != nil {
+		return nil, nil, fmt.Errorf("%w: request epoch block body: %v", errBadPeer, err)
+	}
+
+	var (
+		block    *types.Block
+		receipts types.Receipts
+		done     bool
+		ttl      = d.requestTTL()
+		timeout  = time.After(ttl)
+	)
+	for !done {
+		select {
+		case <-d.cancelCh:
+			return nil, nil, errCanceled
+
+		case packet := <-d.bodyCh:
+			if packet.PeerId() != p.id {
+				log.Debug("Received bodies from incorrect peer", "peer", packet.PeerId())
+				break
+			}
+			bodies := packet.(*bodyPack)
+			if len(bodies.transactions) != 1 || len(bodies.uncles) != 1 {
+				return nil, nil, fmt.Errorf("%w: returned bodies %d != requested %d", errBadPeer, len(bodies.transactions), 1)
+			}
+			if types.DeriveSha(types.Transactions(bodies.transactions[0]), trie.NewStackTrie(nil)) != header.TxHash ||
+				types.CalcUncleHash(bodies.uncles[0]) != header.UncleHash {
+				return nil, nil, errInvalidBody
+			}
+			block = types.NewBlockWithHeader(header).WithBody(bodies.transactions[0], bodies.uncles[0])
+			if err := p.peer.RequestReceipts(hashes); err != nil {
+				return nil, nil, fmt.Errorf("%w: request epoch block receipts: %v", errBadPeer, err)
+			}
+
+		case packet := <-d.receiptCh:
+			if packet.PeerId() != p.id {
+				log.Debug("Received receipts from incorrect peer", "peer", packet.PeerId())
+				break
+			}
+			if block == nil {
+				break
+			}
+			lists := packet.(*receiptPack).receipts
+			if len(lists) != 1 {
+				return nil, nil, fmt.Errorf("%w: returned receipts %d != requested %d", errBadPeer, len(lists), 1)
+			}
+			if types.DeriveSha(types.Receipts(lists[0]), trie.NewStackTrie(nil)) != header.ReceiptHash {
+				return nil, nil, errInvalidReceipt
+			}
+			receipts, done = lists[0], true
+
+		case <-timeout:
+			p.log.Debug("Waiting for epoch block timed out", "elapsed", ttl)
+			return nil, nil, errTimeout
+
+		case <-d.headerCh:
+			// Out of bounds delivery, ignore
+		}
+	}
+	return block, receipts, nil
+}
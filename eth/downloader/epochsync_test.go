@@ -0,0 +1,122 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package downloader
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+)
+
+// testEpochVerifier starts an epoch every fixed number of blocks.
+type testEpochVerifier struct {
+	length uint64
+}
+
+func (v *testEpochVerifier) EpochRange(header *types.Header) (uint64, uint64, error) {
+	start := header.Number.Uint64() - header.Number.Uint64()%v.length
+	return start, start + v.length, nil
+}
+
+func (v *testEpochVerifier) VerifyEpochHeader(epoch, header *types.Header) error {
+	if header.Number.Uint64() != epoch.Number.Uint64()+v.length {
+		return errors.New("not the next epoch")
+	}
+	return nil
+}
+
+func (v *testEpochVerifier) VerifyEpochMember(epoch, header *types.Header) error {
+	if start, _, _ := v.EpochRange(header); start != epoch.Number.Uint64() {
+		return errors.New("out of epoch")
+	}
+	return nil
+}
+
+// Tests that the epoch checkpoint sync commits the latest epoch start block, and only
+// imports the blocks after it.
+func TestEpochCheckpointSync(t *testing.T) {
+	t.Parallel()
+
+	tester := newTester()
+	defer tester.terminate()
+
+	chain := testChainBase.shorten(250)
+	tester.newPeer("peer", eth.ETH66, chain)
+	tester.downloader.SetEpochCheckpoint(chain.genesis.Hash(), &testEpochVerifier{length: 100})
+
+	if err := tester.sync("peer", nil, FastSync); err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+	if head := tester.CurrentBlock(); head.Hash() != chain.headBlock().Hash() {
+		t.Fatalf("head mismatch: have %d, want %d", head.NumberU64(), chain.headBlock().NumberU64())
+	}
+	checkpoint := chain.blockm[chain.chain[200]]
+	if block := tester.GetBlockByHash(checkpoint.Hash()); block == nil {
+		t.Fatalf("epoch checkpoint %d missing", checkpoint.NumberU64())
+	}
+	for _, number := range []int{1, 199} {
+		if block := tester.GetBlockByHash(chain.chain[number]); block != nil {
+			t.Errorf("block %d before epoch checkpoint imported", number)
+		}
+		if header := tester.GetHeaderByHash(chain.chain[number]); header == nil {
+			t.Errorf("ancestor header %d of epoch checkpoint missing", number)
+		}
+	}
+}
+
+// failingEpochPeer fails to send the request of the epoch checkpoint header.
+type failingEpochPeer struct {
+	*downloadTesterPeer
+	checkpoint common.Hash
+}
+
+func (p *failingEpochPeer) RequestHeadersByHash(origin common.Hash, amount int, skip int, reverse bool) error {
+	if origin == p.checkpoint {
+		return errors.New("connection closed")
+	}
+	return p.downloadTesterPeer.RequestHeadersByHash(origin, amount, skip, reverse)
+}
+
+// Tests that the peer failing to send the epoch header request is dropped.
+func TestEpochCheckpointRequestFailure(t *testing.T) {
+	t.Parallel()
+
+	tester := newTester()
+	defer tester.terminate()
+
+	chain := testChainBase.shorten(250)
+	peer := &downloadTesterPeer{dl: tester, id: "peer", chain: chain}
+	tester.peers[peer.id] = peer
+	if err := tester.downloader.RegisterPeer(peer.id, eth.ETH66, &failingEpochPeer{peer, chain.genesis.Hash()}); err != nil {
+		t.Fatalf("failed to register peer: %v", err)
+	}
+	tester.downloader.SetEpochCheckpoint(chain.genesis.Hash(), &testEpochVerifier{length: 100})
+
+	head := chain.headBlock().Hash()
+	if err := tester.downloader.Synchronise(peer.id, head, chain.td(head), FastSync); !errors.Is(err, errBadPeer) {
+		t.Fatalf("sync error mismatch: have %v, want %v", err, errBadPeer)
+	}
+	if _, ok := tester.peers[peer.id]; ok {
+		t.Fatalf("peer failing the epoch header request not dropped")
+	}
+}
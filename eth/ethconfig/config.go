@@ -31,7 +31,9 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	hsb "github.com/ethereum/go-ethereum/consensus/hotstuff/backend"
+	hss "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
 	"github.com/ethereum/go-ethereum/ethdb"
@@ -172,6 +174,14 @@ type Config struct {
 	// Ethash options
 	Ethash ethash.Config
 
+	// HotStuffSigner is the external signer(url or path to ipc file) which signs
+	// consensus messages with validator key, use node key if empty.
+	HotStuffSigner string `toml:",omitempty"`
+
+	// EpochCheckpoint is the trusted epoch start block hash to sync the epochs from,
+	// which replaces the fast or snap sync of an empty chain.
+	EpochCheckpoint common.Hash `toml:",omitempty"`
+
 	// Transaction pool options
 	TxPool core.TxPoolConfig
 
@@ -208,7 +218,7 @@ type Config struct {
 }
 
 // CreateConsensusEngine creates a consensus engine for the given chain configuration.
-func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, config *ethash.Config, notify []string, noverify bool, db ethdb.Database) consensus.Engine {
+func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, config *ethash.Config, hotstuffSigner string, notify []string, noverify bool, db ethdb.Database) consensus.Engine {
 	// If proof-of-authority is requested, set it up
 	if chainConfig.Clique != nil {
 		return clique.New(chainConfig.Clique, db)
@@ -216,7 +226,15 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, co
 	if chainConfig.HotStuff != nil {
 		config := hotstuff.DefaultBasicConfig
 		nodeKey := stack.Config().NodeKey()
-		return hsb.New(chainConfig, config, nodeKey, db, false)
+		if hotstuffSigner == "" {
+			return hsb.New(chainConfig, config, nodeKey, db, false)
+		}
+		signer, err := hss.NewRemoteSigner(hotstuffSigner, crypto.PubkeyToAddress(nodeKey.PublicKey), chainConfig.HotstuffVoteBlock())
+		if err != nil {
+			log.Crit("Failed to connect hotstuff remote signer", "url", hotstuffSigner, "err", err)
+		}
+		log.Info("Using hotstuff remote signer", "url", hotstuffSigner, "address", signer.Address())
+		return hsb.NewWithSigner(chainConfig, config, signer, db, false)
 	}
 	// Otherwise assume proof-of-work
 	switch config.PowMode {
@@ -49,6 +49,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		Preimages               bool
 		Miner                   miner.Config
 		Ethash                  ethash.Config
+		EpochCheckpoint         common.Hash `toml:",omitempty"`
 		TxPool                  core.TxPoolConfig
 		GPO                     gasprice.Config
 		EnablePreimageRecording bool
@@ -93,6 +94,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.Preimages = c.Preimages
 	enc.Miner = c.Miner
 	enc.Ethash = c.Ethash
+	enc.EpochCheckpoint = c.EpochCheckpoint
 	enc.TxPool = c.TxPool
 	enc.GPO = c.GPO
 	enc.EnablePreimageRecording = c.EnablePreimageRecording
@@ -141,6 +143,7 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		Preimages               *bool
 		Miner                   *miner.Config
 		Ethash                  *ethash.Config
+		EpochCheckpoint         *common.Hash `toml:",omitempty"`
 		TxPool                  *core.TxPoolConfig
 		GPO                     *gasprice.Config
 		EnablePreimageRecording *bool
@@ -252,6 +255,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.Ethash != nil {
 		c.Ethash = *dec.Ethash
 	}
+	if dec.EpochCheckpoint != nil {
+		c.EpochCheckpoint = *dec.EpochCheckpoint
+	}
 	if dec.TxPool != nil {
 		c.TxPool = *dec.TxPool
 	}
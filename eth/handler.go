@@ -17,6 +17,7 @@
 package eth
 
 import (
+	"crypto/ecdsa"
 	"errors"
 	"math"
 	"math/big"
@@ -88,6 +89,9 @@ type handlerConfig struct {
 	Checkpoint *params.TrustedCheckpoint // Hard coded checkpoint for sync challenges
 	Whitelist  map[uint64]common.Hash    // Hard coded whitelist for sync challenged
 	Miner      common.Address            // Miner address for lookup broadcast nodes
+	NodeKey    *ecdsa.PrivateKey         // Node key to authenticate the validator on `hotstuff` protocol
+
+	EpochCheckpoint common.Hash // Trusted epoch start block hash to sync the epochs from
 }
 
 type handler struct {
@@ -131,7 +135,9 @@ type handler struct {
 	nodeFetcher *nodeFetcher
 
 	// hotstuff
-	engine consensus.Engine
+	engine        consensus.Engine
+	nodeKey       *ecdsa.PrivateKey
+	hotstuffPeers *hotstuffPeerSet
 }
 
 // newHandler returns a handler for all Ethereum chain management protocol.
@@ -152,6 +158,9 @@ func newHandler(config *handlerConfig, engine consensus.Engine, manager staticNo
 		txsyncCh:   make(chan *txsync),
 		quitSync:   make(chan struct{}),
 		engine:     engine,
+		nodeKey:    config.NodeKey,
+
+		hotstuffPeers: newHotstuffPeerSet(),
 	}
 
 	h.nodeFetcher = newNodeBroadcaster(config.Miner, manager, h)
@@ -198,6 +207,13 @@ func newHandler(config *handlerConfig, engine consensus.Engine, manager staticNo
 		h.stateBloom = trie.NewSyncBloom(config.BloomCache, config.Database)
 	}
 	h.downloader = downloader.New(h.checkpointNumber, config.Database, h.stateBloom, h.eventMux, h.chain, nil, h.removePeer)
+	if config.EpochCheckpoint != (common.Hash{}) {
+		verifier, ok := h.chain.Engine().(consensus.EpochVerifier)
+		if !ok {
+			return nil, errors.New("epoch checkpoint sync not supported by the consensus engine")
+		}
+		h.downloader.SetEpochCheckpoint(config.EpochCheckpoint, verifier)
+	}
 
 	// Construct the fetcher (short sync)
 	validator := func(header *types.Header) error {
@@ -554,6 +570,8 @@ func (h *handler) Enqueue(id string, block *types.Block) {
 	h.blockFetcher.Enqueue(id, block)
 }
 
+// FindPeers implements consensus.Broadcaster, the peers on `hotstuff` protocol are
+// preferred, and the `eth` peers are used for the validators not supporting it.
 func (h *handler) FindPeers(targets map[common.Address]bool) map[common.Address]consensus.Peer {
 	m := make(map[common.Address]consensus.Peer)
 	for _, p := range h.peers.allPeers() {
@@ -563,10 +581,26 @@ func (h *handler) FindPeers(targets map[common.Address]bool) map[common.Address]
 			m[addr] = p
 		}
 	}
+	for addr, p := range h.hotstuffPeers.peers(targets) {
+		m[addr] = p
+	}
 	return m
 }
 
+// FindPeer implements consensus.Broadcaster, the peer on `hotstuff` protocol is
+// preferred.
 func (h *handler) FindPeer(target common.Address) consensus.Peer {
+	if p := h.hotstuffPeers.peer(target); p != nil {
+		return p
+	}
+	if p := h.findEthPeer(target); p != nil {
+		return p
+	}
+	return nil
+}
+
+// findEthPeer retrieves the `eth` peer of the node address.
+func (h *handler) findEthPeer(target common.Address) *ethPeer {
 	for _, p := range h.peers.allPeers() {
 		pubKey := p.Node().Pubkey()
 		addr := crypto.PubkeyToAddress(*pubKey)
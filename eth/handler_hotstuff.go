@@ -0,0 +1,227 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eth
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	nm "github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/eth/protocols/hotstuff"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// errNoHotstuffEngine is returned if a consensus message is received on the hotstuff
+// protocol while the consensus engine does not handle the messages.
+var errNoHotstuffEngine = errors.New("consensus engine does not support hotstuff protocol")
+
+// hotstuffHandler implements the hotstuff.Backend interface to handle the consensus
+// messages received on the dedicated `hotstuff` protocol.
+type hotstuffHandler handler
+
+// RunPeer is invoked when a peer joins on the `hotstuff` protocol.
+func (h *hotstuffHandler) RunPeer(peer *hotstuff.Peer, hand hotstuff.Handler) error {
+	return (*handler)(h).runHotstuffPeer(peer, hand)
+}
+
+// PeerInfo retrieves all known `hotstuff` information about a peer.
+func (h *hotstuffHandler) PeerInfo(id enode.ID) interface{} {
+	if p := h.hotstuffPeers.peerByID(id.String()); p != nil {
+		return &hotstuffPeerInfo{Version: p.Version(), Address: p.Address()}
+	}
+	return nil
+}
+
+// Handle delivers the consensus message to the engine, the message is wrapped as
+// the one overloaded in `eth` protocol, which is still handled by the engine for
+// the validators not supporting the `hotstuff` protocol.
+func (h *hotstuffHandler) Handle(peer *hotstuff.Peer, payload []byte) error {
+	engine, ok := h.engine.(consensus.Handler)
+	if !ok {
+		return errNoHotstuffEngine
+	}
+	size, r, err := rlp.EncodeToReader(payload)
+	if err != nil {
+		return err
+	}
+	_, err = engine.HandleMsg(peer.Address(), p2p.Msg{Code: eth.HotstuffMsg, Size: uint32(size), Payload: r})
+	return err
+}
+
+// hotstuffPeerInfo represents a short summary of the `hotstuff` sub-protocol metadata
+// known about a connected peer.
+type hotstuffPeerInfo struct {
+	Version uint           `json:"version"` // Hotstuff protocol version negotiated
+	Address common.Address `json:"address"` // Consensus address of the validator
+}
+
+// runHotstuffPeer authenticates the remote validator on the `hotstuff` protocol and
+// registers it into the hotstuff peer set, which is independent of the `eth` peers.
+// the handshake is signed by the hotstuff signer, so that the validators holding the
+// consensus key in an external signer are able to join.
+//
+// the `hotstuff` protocol runs on the same devp2p connection as `eth`, and returning
+// from here tears down the whole connection. so that the peers which could not join
+// the consensus, e.g. the local node could not sign or the validator is connected
+// already, are kept idle instead of being dropped. the remote which is not validator
+// of current epoch is registered as inactive, and it's re-checked at epoch change.
+func (h *handler) runHotstuffPeer(peer *hotstuff.Peer, handler hotstuff.Handler) error {
+	_, handle := h.engine.(consensus.Handler)
+	signer, sign := h.engine.(consensus.HandshakeSigner)
+	if !handle || !sign {
+		peer.Log().Trace("Hotstuff peer idle", "err", errNoHotstuffEngine)
+		return peer.Idle()
+	}
+	if h.nodeKey == nil {
+		peer.Log().Trace("Hotstuff peer idle", "reason", "node key missing")
+		return peer.Idle()
+	}
+	h.peerWG.Add(1)
+	defer h.peerWG.Done()
+
+	genesis := h.chain.Genesis()
+	self := enode.PubkeyToIDV4(&h.nodeKey.PublicKey)
+	if err := peer.Handshake(h.networkID, genesis.Hash(), self, signer.SignHandshake); err != nil {
+		if errors.Is(err, hotstuff.ErrSignStatus) {
+			peer.Log().Trace("Hotstuff peer idle", "err", err)
+			return peer.Idle()
+		}
+		peer.Log().Debug("Hotstuff handshake failed", "err", err)
+		return err
+	}
+	if err := h.hotstuffPeers.register(peer); err != nil {
+		peer.Log().Debug("Hotstuff peer idle", "validator", peer.Address().Hex(), "err", err)
+		return peer.Idle()
+	}
+	defer h.hotstuffPeers.unregister(peer)
+	peer.SetActive(h.isEpochValidator(peer.Address()))
+
+	peer.Log().Debug("Hotstuff peer connected", "validator", peer.Address().Hex(), "active", peer.Active())
+	return handler(peer)
+}
+
+// isEpochValidator returns true if the address is validator of current epoch.
+func (h *handler) isEpochValidator(addr common.Address) bool {
+	state, err := h.chain.State()
+	if err != nil {
+		return false
+	}
+	epoch, err := nm.GetCurrentEpochInfoFromDB(state)
+	if err != nil {
+		return false
+	}
+	for _, v := range epoch.Validators {
+		if v == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// hotstuffPeerSet represents the collection of validators connected on the
+// `hotstuff` protocol, mapped by consensus address.
+type hotstuffPeerSet struct {
+	set  map[common.Address]*hotstuff.Peer
+	lock sync.RWMutex
+}
+
+func newHotstuffPeerSet() *hotstuffPeerSet {
+	return &hotstuffPeerSet{set: make(map[common.Address]*hotstuff.Peer)}
+}
+
+// register injects a new `hotstuff` peer into the working set, or returns an error
+// if the validator is already connected.
+func (ps *hotstuffPeerSet) register(peer *hotstuff.Peer) error {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	if _, ok := ps.set[peer.Address()]; ok {
+		return errPeerAlreadyRegistered
+	}
+	ps.set[peer.Address()] = peer
+	return nil
+}
+
+// unregister removes the peer from the working set if it is still the registered one.
+func (ps *hotstuffPeerSet) unregister(peer *hotstuff.Peer) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	if ps.set[peer.Address()] == peer {
+		delete(ps.set, peer.Address())
+	}
+}
+
+// peer retrieves the registered peer of the validator, the inactive peer is omitted.
+func (ps *hotstuffPeerSet) peer(addr common.Address) *hotstuff.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	if p := ps.set[addr]; p != nil && p.Active() {
+		return p
+	}
+	return nil
+}
+
+// peerByID retrieves the registered peer with the given node id.
+func (ps *hotstuffPeerSet) peerByID(id string) *hotstuff.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	for _, p := range ps.set {
+		if p.ID() == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// peers retrieves the registered peers of the targets, the inactive peers are omitted.
+func (ps *hotstuffPeerSet) peers(targets map[common.Address]bool) map[common.Address]*hotstuff.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	list := make(map[common.Address]*hotstuff.Peer)
+	for addr, p := range ps.set {
+		if targets[addr] && p.Active() {
+			list[addr] = p
+		}
+	}
+	return list
+}
+
+// activate re-checks the registered peers against the validators of new epoch, the
+// peers joined or left the validators are activated or deactivated.
+func (ps *hotstuffPeerSet) activate(validators []common.Address) {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	active := make(map[common.Address]bool)
+	for _, addr := range validators {
+		active[addr] = true
+	}
+	for addr, p := range ps.set {
+		p.SetActive(active[addr])
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/eth/protocols/hotstuff"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/p2p"
+)
+
+// testHotstuffEngine is a consensus.Handler leaving all of the messages to the
+// `eth` handlers, and signing the hotstuff handshake with the local key.
+type testHotstuffEngine struct {
+	consensus.Engine
+	signer *signer.SignerImpl
+}
+
+func (e *testHotstuffEngine) NewChainHead(header *types.Header) error { return nil }
+func (e *testHotstuffEngine) HandleMsg(address common.Address, data p2p.Msg) (bool, error) {
+	return false, nil
+}
+func (e *testHotstuffEngine) SignHandshake(hash common.Hash) ([]byte, error) {
+	return e.signer.SignHandshake(hash)
+}
+func (e *testHotstuffEngine) SetBroadcaster(consensus.Broadcaster)  {}
+func (e *testHotstuffEngine) GetBroadcaster() consensus.Broadcaster { return nil }
+func (e *testHotstuffEngine) SubscribeNodes(chan<- consensus.StaticNodesEvent) event.Subscription {
+	return nil
+}
+func (e *testHotstuffEngine) SubscribeBlock(chan<- consensus.ExecutedBlock) event.Subscription {
+	return nil
+}
+
+// Tests that the peers which are not validators are registered as inactive on the
+// `hotstuff` protocol rather than tearing down the `eth` connection, and they're
+// activated once joined the validators.
+func TestHotstuffNonValidatorPeer(t *testing.T) {
+	core.RegGenesis = nil
+	newServer := func() (*testHandler, *p2p.Server) {
+		key, _ := crypto.GenerateKey()
+		backend := newTestHandler()
+		backend.handler.engine = &testHotstuffEngine{signer: signer.NewSigner(key, nil)}
+		backend.handler.nodeKey = key
+
+		server := &p2p.Server{Config: p2p.Config{
+			Name:        "test",
+			MaxPeers:    10,
+			ListenAddr:  "127.0.0.1:0",
+			NoDiscovery: true,
+			PrivateKey:  key,
+			Protocols: append(eth.MakeProtocols((*ethHandler)(backend.handler), 1, nil),
+				hotstuff.MakeProtocols((*hotstuffHandler)(backend.handler))...),
+		}}
+		if err := server.Start(); err != nil {
+			t.Fatalf("could not start server: %v", err)
+		}
+		return backend, server
+	}
+	closeServer := func(backend *testHandler, server *p2p.Server) {
+		server.Stop()
+		// the node fetcher is not started with the engine
+		backend.handler.engine = nil
+		backend.close()
+	}
+	backend1, server1 := newServer()
+	defer closeServer(backend1, server1)
+	backend2, server2 := newServer()
+	defer closeServer(backend2, server2)
+
+	server1.AddPeer(server2.Self())
+	deadline := time.Now().Add(5 * time.Second)
+	for backend1.handler.peers.len() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("eth peer not connected")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the remote is not validator as the epoch info is absent
+	addr := crypto.PubkeyToAddress(*server2.Self().Pubkey())
+	registered := func() bool {
+		backend1.handler.hotstuffPeers.lock.RLock()
+		defer backend1.handler.hotstuffPeers.lock.RUnlock()
+		return backend1.handler.hotstuffPeers.set[addr] != nil
+	}
+	for !registered() {
+		if time.Now().After(deadline) {
+			t.Fatalf("hotstuff peer not registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if server1.PeerCount() != 1 || backend1.handler.peers.len() != 1 {
+		t.Fatalf("eth connection dropped")
+	}
+	if backend1.handler.hotstuffPeers.peer(addr) != nil {
+		t.Fatalf("non-validator activated as hotstuff peer")
+	}
+
+	// the remote joins and leaves the validators at epoch change
+	backend1.handler.hotstuffPeers.activate([]common.Address{addr})
+	if backend1.handler.hotstuffPeers.peer(addr) == nil {
+		t.Fatalf("validator not activated")
+	}
+	if len(backend1.handler.hotstuffPeers.peers(map[common.Address]bool{addr: true})) != 1 {
+		t.Fatalf("validator not listed")
+	}
+	backend1.handler.hotstuffPeers.activate(nil)
+	if backend1.handler.hotstuffPeers.peer(addr) != nil {
+		t.Fatalf("validator not deactivated")
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	nm "github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/event"
@@ -41,6 +42,10 @@ import (
 // resemble it's own enode info and broadcast it and remote enodes to other validators. After
 // the node receives node info, it adds connections and deletes unnecessary links.
 //
+// validators publish their signed node records in the node manager contract. the fetcher takes
+// the `epochChange` event as a signal only, and builds the validator set from the current epoch
+// info of node manager. validators of current epoch are dialed with these records at first, and
+// the seed nodes are only requested for the validators which have not published the record yet.
 
 var (
 	// todo(fuk): update fixed param value
@@ -81,6 +86,9 @@ type nodeFetcher struct {
 	notifyCh     chan consensus.StaticNodesEvent // channel for listening `StaticNodeEvent`
 	notifySub    event.Subscription              // subscribe consensus `StaticNodeEvent`
 
+	// registry returns the validators of current epoch and the node records published by them
+	registry func() ([]common.Address, map[common.Address]*enode.Node, error)
+
 	taskCh chan *task    // signal for connect task
 	quit   chan struct{} // signal for quit loop
 }
@@ -96,6 +104,13 @@ func newNodeBroadcaster(miner common.Address, manager staticNodeServer, handler
 		notifyCh:   make(chan consensus.StaticNodesEvent, nodeFetcherChCapacity),
 		taskCh:     make(chan *task, nodeFetcherChCapacity),
 		quit:       make(chan struct{}),
+		registry: func() ([]common.Address, map[common.Address]*enode.Node, error) {
+			state, err := handler.chain.State()
+			if err != nil {
+				return nil, nil, err
+			}
+			return nm.GetEpochEnodesFromDB(state)
+		},
 	}
 }
 
@@ -133,20 +148,29 @@ func (h *nodeFetcher) Stop() {
 func (h *nodeFetcher) loop() {
 	for {
 		select {
-		case evt := <-h.notifyCh:
+		case <-h.notifyCh:
+			validators, _, err := h.registry()
+			if err != nil {
+				h.logger.Warn("Node Fetcher failed to get epoch validators", "err", err)
+				continue
+			}
+
+			// re-check the consensus peers, they may join or leave the validators in new epoch.
+			h.handler.hotstuffPeers.activate(validators)
+
 			// seed node will disconnect last task validators before reset validators for new epoch.
 			if h.isSeed() {
-				h.seedDisconnect(evt.Validators)
+				h.seedDisconnect(validators)
 			}
 
 			// all nodes should reset validators
-			h.resetValidators(evt.Validators)
+			h.resetValidators(validators)
 
 			// only validator node for current epoch will handle new task.
 			if !h.isSeed() && h.checkValidator(h.miner) {
 				h.waitingLastTask()
 				h.waitingSeedServer()
-				task := h.newTask(evt.Validators)
+				task := h.newTask(validators)
 				go h.handleTask(task)
 			}
 
@@ -207,8 +231,10 @@ func (h *nodeFetcher) handleTask(task *task) {
 				h.logger.Trace("Node Fetcher full connected!")
 				return
 			}
-			h.connectSeeds()
-			h.batchRequest()
+			if !h.connectRegistered() {
+				h.connectSeeds()
+				h.batchRequest()
+			}
 			timer.Reset(nodeFetcherDuration)
 
 		case <-task.halt:
@@ -226,6 +252,34 @@ func (h *nodeFetcher) handleTask(task *task) {
 	}
 }
 
+// connectRegistered dials the validators with the node records published in node manager contract,
+// and returns true if all of the validators have published their records.
+func (h *nodeFetcher) connectRegistered() bool {
+	_, nodes, err := h.registry()
+	if err != nil {
+		h.logger.Trace("Node Fetcher failed to get registered nodes", "err", err)
+		return false
+	}
+
+	complete := true
+	for _, addr := range h.validatorAddresses() {
+		if addr == h.miner {
+			continue
+		}
+		node, exist := nodes[addr]
+		if !exist {
+			complete = false
+			continue
+		}
+		h.setValidator(addr, node)
+		if h.handler.FindPeer(addr) == nil {
+			h.server.AddPeer(node)
+			h.logger.Trace("Node Fetcher Connect", "registered validator", addr.Hex(), "node", identity(node))
+		}
+	}
+	return complete
+}
+
 // 如果种子节点已经全连接，则无需再重新连接
 func (h *nodeFetcher) connectSeeds() {
 	seedFulConn := func() bool {
@@ -243,10 +297,10 @@ func (h *nodeFetcher) connectSeeds() {
 
 	for _, seed := range h.server.SeedNodes() {
 		addr := nodeAddress(seed)
-		if peer := h.handler.FindPeer(addr); peer == nil {
+		if peer := h.handler.findEthPeer(addr); peer == nil {
 			h.server.AddPeer(seed)
 		} else {
-			h.seeds[addr] = peer.(*ethPeer)
+			h.seeds[addr] = peer
 			h.logger.Trace("Node Fetcher Connected", "seed", addr.Hex())
 		}
 	}
@@ -296,8 +350,7 @@ func (h *nodeFetcher) seedDisconnect(newGroup []common.Address) {
 	restCap := maxPeer - currentNum
 	if len(discList) > restCap/2 {
 		for _, addr := range discList {
-			if data := h.handler.FindPeer(addr); data != nil {
-				peer := data.(*ethPeer)
+			if peer := h.handler.findEthPeer(addr); peer != nil {
 				peer.Disconnect(p2p.DiscQuitting)
 				h.logger.Trace("Node Fetcher DisConnect", "last validator", addr.Hex())
 			}
@@ -426,6 +479,17 @@ func (h *nodeFetcher) checkValidator(addr common.Address) bool {
 	return exist
 }
 
+func (h *nodeFetcher) validatorAddresses() []common.Address {
+	h.validatorsMu.RLock()
+	defer h.validatorsMu.RUnlock()
+
+	list := make([]common.Address, 0, len(h.validators))
+	for addr := range h.validators {
+		list = append(list, addr)
+	}
+	return list
+}
+
 func (h *nodeFetcher) validatorList() []*enode.Node {
 	h.validatorsMu.RLock()
 	defer h.validatorsMu.RUnlock()
@@ -19,10 +19,19 @@
 package eth
 
 import (
+	"errors"
 	"math/rand"
+	"net"
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 )
 
 func TestGoroutineManage(t *testing.T) {
@@ -138,3 +147,106 @@ func TestQuitSignal(t *testing.T) {
 	time.Sleep(1 * time.Second)
 	t.Log("quit goruntine", num)
 }
+
+// testNodeServer is a simulated p2p server which records the dialed nodes.
+type testNodeServer struct {
+	self  *enode.Node
+	seeds []*enode.Node
+	added []*enode.Node
+}
+
+func (s *testNodeServer) PeersInfo() []*p2p.PeerInfo  { return nil }
+func (s *testNodeServer) Peers() []*p2p.Peer          { return nil }
+func (s *testNodeServer) AddPeer(node *enode.Node)    { s.added = append(s.added, node) }
+func (s *testNodeServer) RemovePeer(node *enode.Node) {}
+func (s *testNodeServer) Self() *enode.Node           { return s.self }
+func (s *testNodeServer) SeedNodes() []*enode.Node    { return s.seeds }
+func (s *testNodeServer) MaxPeer() int                { return 50 }
+
+func TestConnectRegisteredValidators(t *testing.T) {
+	validators := make([]common.Address, 4)
+	nodes := make(map[common.Address]*enode.Node)
+	for i := range validators {
+		key, _ := crypto.GenerateKey()
+		node := enode.NewV4(&key.PublicKey, net.IPv4(127, 0, 0, 1), 30300+i, 30300+i)
+		validators[i] = nodeAddress(node)
+		nodes[validators[i]] = node
+	}
+	miner := validators[0]
+	server := &testNodeServer{self: nodes[miner]}
+	fetcher := newNodeBroadcaster(miner, server, &handler{peers: newPeerSet(), hotstuffPeers: newHotstuffPeerSet()})
+	fetcher.resetValidators(validators)
+
+	// registry unavailable
+	fetcher.registry = func() ([]common.Address, map[common.Address]*enode.Node, error) {
+		return nil, nil, errors.New("state unavailable")
+	}
+	if fetcher.connectRegistered() {
+		t.Fatalf("connected without registry")
+	}
+
+	// part of validators published their records
+	registered := map[common.Address]*enode.Node{
+		miner:         nodes[miner],
+		validators[1]: nodes[validators[1]],
+		validators[2]: nodes[validators[2]],
+	}
+	fetcher.registry = func() ([]common.Address, map[common.Address]*enode.Node, error) {
+		return validators, registered, nil
+	}
+	if fetcher.connectRegistered() {
+		t.Fatalf("validator %s has not registered", validators[3].Hex())
+	}
+	if len(server.added) != 2 {
+		t.Fatalf("dialed nodes mismatch, expect 2, got %d", len(server.added))
+	}
+	for _, node := range server.added {
+		if node == nodes[miner] {
+			t.Fatalf("miner should not dial itself")
+		}
+	}
+
+	// all validators published their records
+	registered[validators[3]] = nodes[validators[3]]
+	if !fetcher.connectRegistered() {
+		t.Fatalf("all validators have registered")
+	}
+	if len(server.added) != 5 {
+		t.Fatalf("dialed nodes mismatch, expect 5, got %d", len(server.added))
+	}
+	if len(fetcher.validatorList()) != 3 {
+		t.Fatalf("validator list mismatch, expect 3, got %d", len(fetcher.validatorList()))
+	}
+}
+
+func TestValidatorsFromEpochInfo(t *testing.T) {
+	epochValidators := make([]common.Address, 4)
+	for i := range epochValidators {
+		key, _ := crypto.GenerateKey()
+		epochValidators[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	key, _ := crypto.GenerateKey()
+	self := enode.NewV4(&key.PublicKey, net.IPv4(127, 0, 0, 1), 30300, 30300)
+	fetcher := newNodeBroadcaster(nodeAddress(self), &testNodeServer{self: self}, &handler{peers: newPeerSet(), hotstuffPeers: newHotstuffPeerSet()})
+	fetcher.registry = func() ([]common.Address, map[common.Address]*enode.Node, error) {
+		return epochValidators, nil, nil
+	}
+	fetcher.notifySub = event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	})
+	go fetcher.loop()
+	defer close(fetcher.quit)
+
+	// the validators carried by event are ignored
+	fetcher.notifyCh <- consensus.StaticNodesEvent{Validators: epochValidators[:1]}
+	time.Sleep(100 * time.Millisecond)
+	if got := len(fetcher.validatorAddresses()); got != len(epochValidators) {
+		t.Fatalf("validators mismatch, expect %d, got %d", len(epochValidators), got)
+	}
+	for _, addr := range epochValidators {
+		if !fetcher.checkValidator(addr) {
+			t.Fatalf("validator %s missing", addr.Hex())
+		}
+	}
+}
@@ -73,7 +73,9 @@ const (
 	GetPooledTransactionsMsg      = 0x09
 	PooledTransactionsMsg         = 0x0a
 
-	// Protocol messages overloaded in hotstuff
+	// Protocol messages overloaded in hotstuff, the consensus messages are carried by
+	// the dedicated `hotstuff` protocol if both of the peers support it.
+	HotstuffMsg       = 0x11
 	GetStaticNodesMsg = 0x21
 	StaticNodesMsg    = 0x22
 )
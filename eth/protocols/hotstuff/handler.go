@@ -0,0 +1,135 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// Handler is a callback to invoke from an outside runner after the boilerplate
+// exchanges have passed.
+type Handler func(peer *Peer) error
+
+// Backend defines the callback methods to invoke on remote deliveries, the
+// consensus messages are separated from the `eth` protocol, so that they
+// neither compete with block and transaction propagation nor count in the
+// `eth` peer limits.
+type Backend interface {
+	// RunPeer is invoked when a peer joins on the `hotstuff` protocol. The handler
+	// should do the handshake and peer maintenance work. If all is passed, control
+	// should be given back to the `handler` to process the inbound messages going
+	// forward.
+	RunPeer(peer *Peer, handler Handler) error
+
+	// PeerInfo retrieves all known `hotstuff` information about a peer.
+	PeerInfo(id enode.ID) interface{}
+
+	// Handle is a callback to be invoked when a consensus message is received
+	// from the remote peer, the duplicated messages are filtered out before.
+	Handle(peer *Peer, payload []byte) error
+}
+
+// MakeProtocols constructs the P2P protocol definitions for `hotstuff`.
+func MakeProtocols(backend Backend) []p2p.Protocol {
+	// messages received from any of the peers, they are delivered to backend once
+	recentMessages, _ := lru.New(maxRecentMessages)
+
+	protocols := make([]p2p.Protocol, len(ProtocolVersions))
+	for i, version := range ProtocolVersions {
+		version := version // Closure
+
+		protocols[i] = p2p.Protocol{
+			Name:    ProtocolName,
+			Version: version,
+			Length:  protocolLengths[version],
+			Run: func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+				peer := NewPeer(version, p, rw)
+				defer peer.Close()
+
+				return backend.RunPeer(peer, func(peer *Peer) error {
+					return handle(backend, peer, recentMessages)
+				})
+			},
+			PeerInfo: func(id enode.ID) interface{} {
+				return backend.PeerInfo(id)
+			},
+		}
+	}
+	return protocols
+}
+
+// handle is the callback invoked to manage the life cycle of a `hotstuff` peer.
+// When this function terminates, the peer is disconnected.
+func handle(backend Backend, peer *Peer, recentMessages *lru.Cache) error {
+	go peer.broadcast()
+
+	for {
+		if err := handleMessage(backend, peer, recentMessages); err != nil {
+			peer.Log().Debug("Message handling failed in `hotstuff`", "err", err)
+			return err
+		}
+	}
+}
+
+// handleMessage is invoked whenever an inbound message is received from a
+// remote peer on the `hotstuff` protocol. The remote connection is torn down
+// upon returning any error.
+func handleMessage(backend Backend, peer *Peer, recentMessages *lru.Cache) error {
+	// Read the next message from the remote peer, and ensure it's fully consumed
+	msg, err := peer.rw.ReadMsg()
+	if err != nil {
+		return err
+	}
+	if msg.Size > maxMessageSize {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, maxMessageSize)
+	}
+	defer msg.Discard()
+
+	switch msg.Code {
+	case ConsensusMsg:
+		var payload []byte
+		if err := msg.Decode(&payload); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if !peer.Active() {
+			return nil
+		}
+		hash := messageHash(payload)
+		peer.markMessage(hash)
+		if recentMessages.Contains(hash) {
+			return nil
+		}
+		// the message is dropped if the engine fails to handle it, the validator
+		// connection is kept for the following consensus messages. it's not taken
+		// as received, so that the same message relayed by other peers is handled.
+		if err := backend.Handle(peer, payload); err != nil {
+			peer.Log().Debug("Failed to handle consensus message", "hash", hash, "err", err)
+			return nil
+		}
+		recentMessages.Add(hash, true)
+		return nil
+
+	default:
+		return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
+	}
+}
@@ -0,0 +1,299 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+var testGenesis = common.HexToHash("0x01")
+
+// testBackend is a hotstuff.Backend accepting all of the validators, the status is
+// signed by the consensus key apart from the node key.
+type testBackend struct {
+	self  enode.ID
+	key   *ecdsa.PrivateKey
+	peers chan *Peer
+	msgs  chan []byte
+	fail  int32 // number of the following messages failed to be handled
+}
+
+func newTestBackend(nodeKey, key *ecdsa.PrivateKey) *testBackend {
+	return &testBackend{self: enode.PubkeyToIDV4(&nodeKey.PublicKey), key: key, peers: make(chan *Peer, 1), msgs: make(chan []byte, 10)}
+}
+
+func (b *testBackend) RunPeer(peer *Peer, handler Handler) error {
+	if err := peer.Handshake(1, testGenesis, b.self, testSign(b.key)); err != nil {
+		return err
+	}
+	peer.SetActive(true)
+	b.peers <- peer
+	return handler(peer)
+}
+
+func (b *testBackend) PeerInfo(id enode.ID) interface{} { return nil }
+
+func (b *testBackend) Handle(peer *Peer, payload []byte) error {
+	if atomic.AddInt32(&b.fail, -1) >= 0 {
+		return errors.New("handle failed")
+	}
+	b.msgs <- payload
+	return nil
+}
+
+func testSign(key *ecdsa.PrivateKey) func(common.Hash) ([]byte, error) {
+	return signer.NewSigner(key, nil).SignHandshake
+}
+
+// newTestPeers creates a pair of peers connected by message pipe, the remote
+// node ids are derived from the keys.
+func newTestPeers(key1, key2 *ecdsa.PrivateKey) (*Peer, *Peer) {
+	app, net := p2p.MsgPipe()
+	peer1 := NewPeer(HOTSTUFF1, p2p.NewPeer(enode.PubkeyToIDV4(&key2.PublicKey), "", nil), app)
+	peer2 := NewPeer(HOTSTUFF1, p2p.NewPeer(enode.PubkeyToIDV4(&key1.PublicKey), "", nil), net)
+	return peer1, peer2
+}
+
+func TestHandshake(t *testing.T) {
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	// the consensus keys are apart from the node keys, e.g. held by external signer
+	ckey1, _ := crypto.GenerateKey()
+	ckey2, _ := crypto.GenerateKey()
+	addr1, addr2 := crypto.PubkeyToAddress(ckey1.PublicKey), crypto.PubkeyToAddress(ckey2.PublicKey)
+	id1, id2 := enode.PubkeyToIDV4(&key1.PublicKey), enode.PubkeyToIDV4(&key2.PublicKey)
+
+	tests := []struct {
+		network uint64
+		genesis common.Hash
+		want    error
+	}{
+		{network: 1, genesis: testGenesis},
+		{network: 2, genesis: testGenesis, want: errNetworkIDMismatch},
+		{network: 1, genesis: common.HexToHash("0x02"), want: errGenesisMismatch},
+	}
+	for i, tt := range tests {
+		peer1, peer2 := newTestPeers(key1, key2)
+		errc := make(chan error, 1)
+		go func() {
+			errc <- peer2.Handshake(1, testGenesis, id2, testSign(ckey2))
+		}()
+		err := peer1.Handshake(tt.network, tt.genesis, id1, testSign(ckey1))
+		if !errors.Is(err, tt.want) {
+			t.Fatalf("test %d: handshake error mismatch: have %v, want %v", i, err, tt.want)
+		}
+		if tt.want == nil {
+			if err := <-errc; err != nil {
+				t.Fatalf("test %d: remote handshake failed: %v", i, err)
+			}
+			if peer1.Address() != addr2 || peer2.Address() != addr1 {
+				t.Fatalf("test %d: authenticated address mismatch", i)
+			}
+		}
+		peer1.rw.(*p2p.MsgPipeRW).Close()
+	}
+}
+
+func TestHandshakeReplay(t *testing.T) {
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	key3, _ := crypto.GenerateKey()
+
+	// the status signed for node 3 is replayed to node 1
+	peer1, peer2 := newTestPeers(key1, key2)
+	sig, _ := testSign(key2)(statusHash(1, testGenesis, enode.PubkeyToIDV4(&key3.PublicKey)))
+	go func() {
+		p2p.Send(peer2.rw, StatusMsg, &StatusPacket{ProtocolVersion: HOTSTUFF1, NetworkID: 1, Genesis: testGenesis, Signature: sig})
+		if msg, err := peer2.rw.ReadMsg(); err == nil {
+			msg.Discard()
+		}
+	}()
+	err := peer1.Handshake(1, testGenesis, enode.PubkeyToIDV4(&key1.PublicKey), testSign(key1))
+	if err == nil && peer1.Address() == crypto.PubkeyToAddress(key2.PublicKey) {
+		t.Fatalf("replayed status accepted")
+	}
+}
+
+func TestPriorityQueue(t *testing.T) {
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	peer1, peer2 := newTestPeers(key1, key2)
+	defer peer1.Close()
+
+	normal := [][]byte{{0x01}, {0x02}}
+	urgent := []byte{0x03}
+	for _, payload := range normal {
+		peer1.AsyncSend(payload, PriorityNormal)
+	}
+	peer1.AsyncSend(urgent, PriorityHigh)
+	// known message is never resent
+	peer1.AsyncSend(normal[0], PriorityHigh)
+	go peer1.broadcast()
+
+	for i, want := range [][]byte{urgent, normal[0], normal[1]} {
+		msg, err := peer2.rw.ReadMsg()
+		if err != nil {
+			t.Fatalf("message %d: read failed: %v", i, err)
+		}
+		var payload []byte
+		if err := msg.Decode(&payload); err != nil {
+			t.Fatalf("message %d: decode failed: %v", i, err)
+		}
+		if msg.Code != ConsensusMsg || !bytes.Equal(payload, want) {
+			t.Fatalf("message %d: mismatch: have %x, want %x", i, payload, want)
+		}
+	}
+}
+
+func TestDeduplication(t *testing.T) {
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	peer1, peer2 := newTestPeers(key1, key2)
+	defer peer1.Close()
+
+	backend := newTestBackend(key1, key1)
+	recentMessages, _ := lru.New(maxRecentMessages)
+	peer1.SetActive(true)
+	go handle(backend, peer1, recentMessages)
+
+	payloads := [][]byte{{0x01}, {0x01}, {0x02}}
+	for _, payload := range payloads {
+		if err := p2p.Send(peer2.rw, ConsensusMsg, payload); err != nil {
+			t.Fatalf("failed to send message: %v", err)
+		}
+	}
+	for _, want := range [][]byte{{0x01}, {0x02}} {
+		select {
+		case payload := <-backend.msgs:
+			if !bytes.Equal(payload, want) {
+				t.Fatalf("delivered message mismatch: have %x, want %x", payload, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("message %x not delivered", want)
+		}
+	}
+	select {
+	case payload := <-backend.msgs:
+		t.Fatalf("duplicated message %x delivered", payload)
+	default:
+	}
+	if !peer1.KnownMessage(messageHash([]byte{0x01})) {
+		t.Fatalf("received message should be known by peer")
+	}
+}
+
+// Tests that the messages failed to be handled are not taken as received, and the
+// messages from inactive peers are discarded.
+func TestHandleFailure(t *testing.T) {
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	peer1, peer2 := newTestPeers(key1, key2)
+	defer peer1.Close()
+
+	backend := newTestBackend(key1, key1)
+	recentMessages, _ := lru.New(maxRecentMessages)
+	go handle(backend, peer1, recentMessages)
+
+	send := func(payload []byte) {
+		if err := p2p.Send(peer2.rw, ConsensusMsg, payload); err != nil {
+			t.Fatalf("failed to send message: %v", err)
+		}
+	}
+	// inactive peer
+	send([]byte{0x01})
+
+	peer1.SetActive(true)
+	atomic.StoreInt32(&backend.fail, 1)
+	send([]byte{0x02})
+	send([]byte{0x02})
+
+	select {
+	case payload := <-backend.msgs:
+		if !bytes.Equal(payload, []byte{0x02}) {
+			t.Fatalf("delivered message mismatch: have %x, want %x", payload, []byte{0x02})
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("message not delivered after handling failure")
+	}
+	if !recentMessages.Contains(messageHash([]byte{0x02})) || recentMessages.Contains(messageHash([]byte{0x01})) {
+		t.Fatalf("received messages mismatch")
+	}
+}
+
+func TestServerProtocol(t *testing.T) {
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	ckey1, _ := crypto.GenerateKey()
+	ckey2, _ := crypto.GenerateKey()
+	backend1, backend2 := newTestBackend(key1, ckey1), newTestBackend(key2, ckey2)
+
+	newServer := func(key *ecdsa.PrivateKey, backend Backend) *p2p.Server {
+		server := &p2p.Server{Config: p2p.Config{
+			Name:        "test",
+			MaxPeers:    10,
+			ListenAddr:  "127.0.0.1:0",
+			NoDiscovery: true,
+			PrivateKey:  key,
+			Protocols:   MakeProtocols(backend),
+		}}
+		if err := server.Start(); err != nil {
+			t.Fatalf("could not start server: %v", err)
+		}
+		return server
+	}
+	server1, server2 := newServer(key1, backend1), newServer(key2, backend2)
+	defer server1.Stop()
+	defer server2.Stop()
+
+	server1.AddPeer(server2.Self())
+	var peer1 *Peer
+	select {
+	case peer1 = <-backend1.peers:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("hotstuff peer not connected")
+	}
+	if peer1.Address() != crypto.PubkeyToAddress(ckey2.PublicKey) {
+		t.Fatalf("authenticated address mismatch")
+	}
+
+	payload := []byte{0x01, 0x02}
+	if err := peer1.SendUrgent(payload); err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+	select {
+	case got := <-backend2.msgs:
+		if !bytes.Equal(got, payload) {
+			t.Fatalf("delivered message mismatch: have %x, want %x", got, payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("message not delivered")
+	}
+}
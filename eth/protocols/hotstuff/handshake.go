@@ -0,0 +1,123 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+const (
+	// handshakeTimeout is the maximum allowed time for the `hotstuff` handshake to
+	// complete before dropping the connection as malicious.
+	handshakeTimeout = 5 * time.Second
+)
+
+// Handshake executes the hotstuff protocol handshake, negotiating version number,
+// network IDs and genesis blocks, and authenticates the remote validator with the
+// signature of its consensus key. The status is signed by `sign` with the local
+// consensus key, which may be held by an external signer rather than the node key,
+// and `self` is the local node id which the remote signature should be bound to.
+func (p *Peer) Handshake(network uint64, genesis common.Hash, self enode.ID, sign func(common.Hash) ([]byte, error)) error {
+	sig, err := sign(statusHash(network, genesis, p.Peer.ID()))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSignStatus, err)
+	}
+
+	// Send out own handshake in a new thread
+	errc := make(chan error, 2)
+
+	var status StatusPacket // safe to read after two values have been received from errc
+
+	go func() {
+		errc <- p2p.Send(p.rw, StatusMsg, &StatusPacket{
+			ProtocolVersion: uint32(p.version),
+			NetworkID:       network,
+			Genesis:         genesis,
+			Signature:       sig,
+		})
+	}()
+	go func() {
+		errc <- p.readStatus(network, &status, genesis)
+	}()
+	timeout := time.NewTimer(handshakeTimeout)
+	defer timeout.Stop()
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return err
+			}
+		case <-timeout.C:
+			return p2p.DiscReadTimeout
+		}
+	}
+
+	// the signature is bound to local node id, so that it can not be replayed by the
+	// remote to other nodes. whether the signer is validator is left to the caller.
+	pub, err := crypto.SigToPub(signer.HandshakeHash(statusHash(network, genesis, self)).Bytes(), status.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidSignature, err)
+	}
+	p.address = crypto.PubkeyToAddress(*pub)
+	return nil
+}
+
+// readStatus reads the remote handshake message.
+func (p *Peer) readStatus(network uint64, status *StatusPacket, genesis common.Hash) error {
+	msg, err := p.rw.ReadMsg()
+	if err != nil {
+		return err
+	}
+	if msg.Code != StatusMsg {
+		return fmt.Errorf("%w: first msg has code %x (!= %x)", errNoStatusMsg, msg.Code, StatusMsg)
+	}
+	if msg.Size > maxMessageSize {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, maxMessageSize)
+	}
+	// Decode the handshake and make sure everything matches
+	if err := msg.Decode(&status); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	if status.NetworkID != network {
+		return fmt.Errorf("%w: %d (!= %d)", errNetworkIDMismatch, status.NetworkID, network)
+	}
+	if uint(status.ProtocolVersion) != p.version {
+		return fmt.Errorf("%w: %d (!= %d)", errProtocolVersionMismatch, status.ProtocolVersion, p.version)
+	}
+	if status.Genesis != genesis {
+		return fmt.Errorf("%w: %x (!= %x)", errGenesisMismatch, status.Genesis, genesis)
+	}
+	return nil
+}
+
+// statusHash is the digest of the status signed by the sender as handshake hash,
+// `remote` is the node id of the receiver.
+func statusHash(network uint64, genesis common.Hash, remote enode.ID) common.Hash {
+	var enc [8]byte
+	binary.BigEndian.PutUint64(enc[:], network)
+	return crypto.Keccak256Hash([]byte(ProtocolName), enc[:], genesis[:], remote[:])
+}
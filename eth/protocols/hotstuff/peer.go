@@ -0,0 +1,223 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// maxQueuedMsgs is the maximum number of consensus messages to queue up for
+	// each priority before dropping the new ones.
+	maxQueuedMsgs = 256
+
+	// maxRecentMessages is the maximum number of message hashes to keep in the
+	// known list of a peer, and the messages received from all of the peers.
+	maxRecentMessages = 4096
+)
+
+// Priority is the sending priority of consensus message.
+type Priority int
+
+const (
+	PriorityNormal Priority = iota
+	PriorityHigh
+)
+
+// Peer is a collection of relevant information we have about a `hotstuff` peer.
+type Peer struct {
+	id string // Unique ID for the peer, cached
+
+	*p2p.Peer                   // The embedded P2P package peer
+	rw        p2p.MsgReadWriter // Input/output streams for hotstuff
+	version   uint              // Protocol version negotiated
+	address   common.Address    // Consensus address authenticated in handshake
+	active    int32             // Flag whether the remote is validator of current epoch
+
+	recentMessages *lru.ARCCache // Hashes of the messages known to be known by this peer
+	highQueue      chan []byte   // Queue of the urgent messages, drained ahead of normal queue
+	normalQueue    chan []byte   // Queue of the other consensus messages
+
+	logger log.Logger    // Contextual logger with the peer id injected
+	term   chan struct{} // Termination channel to stop the broadcaster
+}
+
+// NewPeer create a wrapper for a network connection and negotiated protocol
+// version, the broadcaster is started after handshake.
+func NewPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+	id := p.ID().String()
+	recentMessages, _ := lru.NewARC(maxRecentMessages)
+	return &Peer{
+		id:             id,
+		Peer:           p,
+		rw:             rw,
+		version:        version,
+		recentMessages: recentMessages,
+		highQueue:      make(chan []byte, maxQueuedMsgs),
+		normalQueue:    make(chan []byte, maxQueuedMsgs),
+		logger:         log.New("peer", id[:8]),
+		term:           make(chan struct{}),
+	}
+}
+
+// Close signals the broadcast goroutine to terminate. Only ever call this if
+// you created the peer yourself via NewPeer. Otherwise let whoever created it
+// clean it up!
+func (p *Peer) Close() {
+	close(p.term)
+}
+
+// ID retrieves the peer's unique identifier.
+func (p *Peer) ID() string {
+	return p.id
+}
+
+// Version retrieves the peer's negotiated `hotstuff` protocol version.
+func (p *Peer) Version() uint {
+	return p.version
+}
+
+// Address retrieves the consensus address of the remote validator.
+func (p *Peer) Address() common.Address {
+	return p.address
+}
+
+// Active returns whether the remote is validator of current epoch, the consensus
+// messages from inactive peers are discarded.
+func (p *Peer) Active() bool {
+	return atomic.LoadInt32(&p.active) == 1
+}
+
+// SetActive marks whether the remote is validator of current epoch, the validators
+// are re-checked at every epoch change.
+func (p *Peer) SetActive(active bool) {
+	if active {
+		atomic.StoreInt32(&p.active, 1)
+	} else {
+		atomic.StoreInt32(&p.active, 0)
+	}
+}
+
+// Log overrides the P2P logger with the higher level one containing only the id.
+func (p *Peer) Log() log.Logger {
+	return p.logger
+}
+
+// KnownMessage returns whether peer is known to already have the message.
+func (p *Peer) KnownMessage(hash common.Hash) bool {
+	return p.recentMessages.Contains(hash)
+}
+
+// markMessage marks the message as known for the peer, ensuring that it will
+// never be sent to the peer.
+func (p *Peer) markMessage(hash common.Hash) {
+	p.recentMessages.Add(hash, true)
+}
+
+// Send implements consensus.Peer, the engine messages are carried by ConsensusMsg
+// with normal priority.
+func (p *Peer) Send(msgcode uint64, data interface{}) error {
+	payload, ok := data.([]byte)
+	if !ok {
+		return fmt.Errorf("%w: consensus payload %T", errDecode, data)
+	}
+	p.AsyncSend(payload, PriorityNormal)
+	return nil
+}
+
+// SendUrgent implements consensus.PriorityPeer, the message is delivered ahead
+// of the queued ones.
+func (p *Peer) SendUrgent(data interface{}) error {
+	payload, ok := data.([]byte)
+	if !ok {
+		return fmt.Errorf("%w: consensus payload %T", errDecode, data)
+	}
+	p.AsyncSend(payload, PriorityHigh)
+	return nil
+}
+
+// AsyncSend queues the consensus message for propagation to the remote peer,
+// the message already known by the peer is skipped, and the message is dropped
+// if the queue of the priority is full.
+func (p *Peer) AsyncSend(payload []byte, priority Priority) {
+	hash := messageHash(payload)
+	if p.KnownMessage(hash) {
+		return
+	}
+	queue := p.normalQueue
+	if priority == PriorityHigh {
+		queue = p.highQueue
+	}
+	select {
+	case queue <- payload:
+		p.markMessage(hash)
+	case <-p.term:
+	default:
+		p.Log().Debug("Dropping consensus message", "hash", hash, "priority", priority)
+	}
+}
+
+// Idle discards the inbound messages without handling them until the remote peer
+// quits, so that the connection shared with other protocols is kept alive for the
+// peers which could not join the consensus.
+func (p *Peer) Idle() error {
+	for {
+		msg, err := p.rw.ReadMsg()
+		if err != nil {
+			return nil
+		}
+		msg.Discard()
+	}
+}
+
+// broadcast is a write loop that sends the queued consensus messages to the
+// remote peer, the urgent messages are always sent first. The goroutine stops
+// when the peer is closed or sending fails.
+func (p *Peer) broadcast() {
+	for {
+		var payload []byte
+		select {
+		case payload = <-p.highQueue:
+		case <-p.term:
+			return
+		default:
+			select {
+			case payload = <-p.highQueue:
+			case payload = <-p.normalQueue:
+			case <-p.term:
+				return
+			}
+		}
+		if err := p2p.Send(p.rw, ConsensusMsg, payload); err != nil {
+			p.Log().Debug("Failed to send consensus message", "err", err)
+			return
+		}
+	}
+}
+
+func messageHash(payload []byte) common.Hash {
+	return crypto.Keccak256Hash(payload)
+}
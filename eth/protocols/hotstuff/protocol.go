@@ -0,0 +1,75 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Constants to match up protocol versions and messages
+const (
+	HOTSTUFF1 = 1
+)
+
+// ProtocolName is the official short name of the `hotstuff` protocol used during
+// devp2p capability negotiation.
+const ProtocolName = "hotstuff"
+
+// ProtocolVersions are the supported versions of the `hotstuff` protocol (first
+// is primary).
+var ProtocolVersions = []uint{HOTSTUFF1}
+
+// protocolLengths are the number of implemented message corresponding to
+// different protocol versions.
+var protocolLengths = map[uint]uint64{HOTSTUFF1: 2}
+
+// maxMessageSize is the maximum cap on the size of a protocol message.
+const maxMessageSize = 10 * 1024 * 1024
+
+const (
+	StatusMsg    = 0x00
+	ConsensusMsg = 0x01
+)
+
+var (
+	errNoStatusMsg             = errors.New("no status message")
+	errMsgTooLarge             = errors.New("message too long")
+	errDecode                  = errors.New("invalid message")
+	errInvalidMsgCode          = errors.New("invalid message code")
+	errProtocolVersionMismatch = errors.New("protocol version mismatch")
+	errNetworkIDMismatch       = errors.New("network ID mismatch")
+	errGenesisMismatch         = errors.New("genesis mismatch")
+	errInvalidSignature        = errors.New("invalid status signature")
+)
+
+// ErrSignStatus is returned by the handshake if the local consensus key fails to sign
+// the status, the connection should be kept idle rather than dropped.
+var ErrSignStatus = errors.New("failed to sign status")
+
+// StatusPacket is the network packet for the status message, the signature is
+// signed by the consensus key of sender over the status and the remote node id,
+// so that it can not be replayed to other nodes.
+type StatusPacket struct {
+	ProtocolVersion uint32
+	NetworkID       uint64
+	Genesis         common.Hash
+	Signature       []byte
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/mclock"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/light"
@@ -32,6 +33,7 @@ type LesOdr struct {
 	db                                         ethdb.Database
 	indexerConfig                              *light.IndexerConfig
 	chtIndexer, bloomTrieIndexer, bloomIndexer *core.ChainIndexer
+	epochVerifier                              consensus.EpochVerifier
 	peers                                      *serverPeerSet
 	retriever                                  *retrieveManager
 	stop                                       chan struct{}
@@ -79,6 +81,16 @@ func (odr *LesOdr) BloomIndexer() *core.ChainIndexer {
 	return odr.bloomIndexer
 }
 
+// SetEpochVerifier enables the headers retrieved by epoch instead of CHT, e.g. hotstuff
+func (odr *LesOdr) SetEpochVerifier(verifier consensus.EpochVerifier) {
+	odr.epochVerifier = verifier
+}
+
+// EpochVerifier returns the verifier of the headers by epoch (implementation of light.EpochOdrBackend)
+func (odr *LesOdr) EpochVerifier() consensus.EpochVerifier {
+	return odr.epochVerifier
+}
+
 // IndexerConfig returns the indexer config.
 func (odr *LesOdr) IndexerConfig() *light.IndexerConfig {
 	return odr.indexerConfig
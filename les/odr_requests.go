@@ -42,6 +42,7 @@ var (
 	errDataHashMismatch    = errors.New("data hash mismatch")
 	errCHTHashMismatch     = errors.New("cht hash mismatch")
 	errCHTNumberMismatch   = errors.New("cht number mismatch")
+	errHeaderNumMismatch   = errors.New("header number mismatch")
 	errUselessNodes        = errors.New("useless nodes in merkle proof nodeset")
 )
 
@@ -64,6 +65,8 @@ func LesRequest(req light.OdrRequest) LesOdrRequest {
 		return (*CodeRequest)(r)
 	case *light.ChtRequest:
 		return (*ChtRequest)(r)
+	case *light.EpochHeaderRequest:
+		return (*EpochHeaderRequest)(r)
 	case *light.BloomRequest:
 		return (*BloomRequest)(r)
 	case *light.TxStatusRequest:
@@ -396,6 +399,50 @@ func (r *ChtRequest) Validate(db ethdb.Database, msg *Msg) error {
 	return nil
 }
 
+// ODR request type for requesting headers verified by epoch, see LesOdrRequest interface
+type EpochHeaderRequest light.EpochHeaderRequest
+
+// GetCost returns the cost of the given ODR request according to the serving
+// peer's cost table (implementation of LesOdrRequest)
+func (r *EpochHeaderRequest) GetCost(peer *serverPeer) uint64 {
+	return peer.getRequestCost(GetBlockHeadersMsg, 1)
+}
+
+// CanSend tells if a certain peer is suitable for serving the given request
+func (r *EpochHeaderRequest) CanSend(peer *serverPeer) bool {
+	return peer.HeadNumber() >= r.Number
+}
+
+// Request sends an ODR request to the LES network (implementation of LesOdrRequest)
+func (r *EpochHeaderRequest) Request(reqID uint64, peer *serverPeer) error {
+	peer.Log().Debug("Requesting epoch header", "epoch", r.Epoch.Number, "block", r.Number)
+	return peer.requestHeadersByNumber(reqID, r.Number, 1, 0, false)
+}
+
+// Valid processes an ODR request reply message from the LES network
+// returns true and stores results in memory if the message was a valid reply
+// to the request (implementation of LesOdrRequest)
+func (r *EpochHeaderRequest) Validate(db ethdb.Database, msg *Msg) error {
+	log.Debug("Validating epoch header", "epoch", r.Epoch.Number, "block", r.Number)
+
+	if msg.MsgType != MsgBlockHeaders {
+		return errInvalidMessageType
+	}
+	headers := msg.Obj.([]*types.Header)
+	if len(headers) != 1 {
+		return errInvalidEntryCount
+	}
+	header := headers[0]
+	if header.Number == nil || header.Number.Uint64() != r.Number {
+		return errHeaderNumMismatch
+	}
+	if err := r.Verifier.VerifyEpochMember(r.Epoch, header); err != nil {
+		return err
+	}
+	r.Header = header
+	return nil
+}
+
 type BloomReq struct {
 	BloomTrieNum, BitIdx, SectionIndex, FromLevel uint64
 }
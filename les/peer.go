@@ -204,6 +204,14 @@ func (p *peerCommons) HeadAndTd() (hash common.Hash, td *big.Int) {
 	return p.headInfo.Hash, new(big.Int).Set(p.headInfo.Td)
 }
 
+// HeadNumber retrieves the current head number of a peer.
+func (p *peerCommons) HeadNumber() uint64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.headInfo.Number
+}
+
 // sendReceiveHandshake exchanges handshake packet with remote peer and returns any error
 // if failed to send or receive packet.
 func (p *peerCommons) sendReceiveHandshake(sendList keyValueList) (keyValueList, error) {
@@ -19,17 +19,24 @@ package les
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/light"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 )
 
-var errInvalidCheckpoint = errors.New("invalid advertised checkpoint")
+var (
+	errInvalidCheckpoint = errors.New("invalid advertised checkpoint")
+	errMissingEpoch      = errors.New("local epoch start header missing")
+	errInvalidEpoch      = errors.New("invalid epoch header")
+)
 
 const (
 	// lightSync starts syncing from the current highest block.
@@ -87,6 +94,62 @@ func (h *clientHandler) validateCheckpoint(peer *serverPeer) error {
 	return nil
 }
 
+// epochSync syncs up the local chain with a remote peer by the epoch start headers only.
+// Each epoch start header is verified against the validators elected in the previous one,
+// and then the remote head is verified against the validators of the latest epoch, so that
+// the headers between are never downloaded.
+func (h *clientHandler) epochSync(peer *serverPeer, verifier consensus.EpochVerifier) error {
+	var (
+		chain    = h.backend.blockchain
+		wrapPeer = &peerConnection{handler: h, peer: peer}
+		head     = peer.HeadNumber()
+	)
+	start, _, err := verifier.EpochRange(chain.CurrentHeader())
+	if err != nil {
+		return err
+	}
+	epoch := chain.GetHeaderByNumber(start)
+	if epoch == nil {
+		return errMissingEpoch
+	}
+	retrieve := func(number uint64) (*types.Header, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		return wrapPeer.RetrieveSingleHeaderByNumber(ctx, number)
+	}
+	for {
+		_, end, err := verifier.EpochRange(epoch)
+		if err != nil {
+			return err
+		}
+		if end > head {
+			break
+		}
+		header, err := retrieve(end)
+		if err != nil {
+			return err
+		}
+		if err := verifier.VerifyEpochHeader(epoch, header); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidEpoch, err)
+		}
+		if err := chain.InsertTrustedHeader(header); err != nil {
+			return err
+		}
+		epoch = header
+	}
+	if head <= chain.CurrentHeader().Number.Uint64() {
+		return nil
+	}
+	header, err := retrieve(head)
+	if err != nil {
+		return err
+	}
+	if err := verifier.VerifyEpochMember(epoch, header); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidEpoch, err)
+	}
+	return chain.InsertTrustedHeader(header)
+}
+
 // synchronise tries to sync up our local chain with a remote peer.
 func (h *clientHandler) synchronise(peer *serverPeer) {
 	// Short circuit if the peer is nil.
@@ -99,6 +162,27 @@ func (h *clientHandler) synchronise(peer *serverPeer) {
 	if currentTd != nil && peer.Td().Cmp(currentTd) < 0 {
 		return
 	}
+	// The engines verifying headers by epoch, e.g. hotstuff, neither rely on the CHT
+	// checkpoint nor download the whole header chain.
+	if verifier, ok := h.backend.engine.(consensus.EpochVerifier); ok {
+		defer func() {
+			if h.syncEnd != nil {
+				h.syncEnd(h.backend.blockchain.CurrentHeader())
+			}
+		}()
+		start := time.Now()
+		if err := h.epochSync(peer, verifier); err != nil {
+			log.Debug("Epoch synchronise failed", "reason", err)
+			// Only drop the peer serving the invalid headers, the local failures and
+			// timeouts are retried in the next round.
+			if errors.Is(err, errInvalidEpoch) {
+				h.removePeer(peer.id)
+			}
+			return
+		}
+		log.Debug("Epoch synchronise finished", "elapsed", common.PrettyDuration(time.Since(start)))
+		return
+	}
 	// Recap the checkpoint. The light client may be connected to several different
 	// versions of the server.
 	// (1) Old version server which can not provide stable checkpoint in the
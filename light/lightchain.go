@@ -535,6 +535,40 @@ func (lc *LightChain) SyncCheckpoint(ctx context.Context, checkpoint *params.Tru
 	return false
 }
 
+// InsertTrustedHeader writes the header verified without its ancestors, e.g. the epoch
+// start header verified against the validators of the previous epoch, as the new chain
+// head. The engines verifying headers by epoch use the constant difficulty, so that the
+// total difficulty is derived from the genesis one.
+func (lc *LightChain) InsertTrustedHeader(header *types.Header) error {
+	lc.chainmu.Lock()
+	defer lc.chainmu.Unlock()
+
+	var (
+		hash   = header.Hash()
+		number = header.Number.Uint64()
+	)
+	if lc.hc.CurrentHeader().Number.Uint64() >= number {
+		return nil
+	}
+	td := new(big.Int).Mul(header.Difficulty, header.Number)
+	td.Add(td, lc.genesisBlock.Difficulty())
+
+	batch := lc.chainDb.NewBatch()
+	rawdb.WriteHeader(batch, header)
+	rawdb.WriteTd(batch, hash, number, td)
+	rawdb.WriteCanonicalHash(batch, hash, number)
+	rawdb.WriteHeadHeaderHash(batch, hash)
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	lc.hc.SetCurrentHeader(header)
+	log.Info("Updated latest header by epoch", "number", number, "hash", hash, "age", common.PrettyAge(time.Unix(int64(header.Time), 0)))
+
+	block := types.NewBlockWithHeader(header)
+	lc.postChainEvents([]interface{}{core.ChainEvent{Block: block, Hash: hash}})
+	return nil
+}
+
 // LockChain locks the chain mutex for reading so that multiple canonical hashes can be
 // retrieved while it is guaranteed that they belong to the same version of the chain
 func (lc *LightChain) LockChain() {
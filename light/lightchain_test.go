@@ -354,3 +354,39 @@ func TestReorgBadHeaderHashes(t *testing.T) {
 		t.Errorf("last header hash mismatch: have: %x, want %x", ncm.CurrentHeader().Hash(), headers[2].Hash())
 	}
 }
+
+// Tests that the trusted header is written as the head without its ancestors, and the
+// header chain is extended from it.
+func TestInsertTrustedHeader(t *testing.T) {
+	bc := newTestLightChain()
+
+	trusted := &types.Header{ParentHash: common.Hash{0x01}, Number: big.NewInt(10), Difficulty: big.NewInt(1)}
+	if err := bc.InsertTrustedHeader(trusted); err != nil {
+		t.Fatalf("failed to insert trusted header: %v", err)
+	}
+	if bc.CurrentHeader().Hash() != trusted.Hash() {
+		t.Errorf("head hash mismatch: have: %x, want %x", bc.CurrentHeader().Hash(), trusted.Hash())
+	}
+	if header := bc.GetHeaderByNumber(10); header == nil || header.Hash() != trusted.Hash() {
+		t.Errorf("canonical header mismatch: have: %v, want %x", header, trusted.Hash())
+	}
+	if td := bc.GetTd(trusted.Hash(), 10); td == nil || td.Cmp(big.NewInt(11)) != 0 {
+		t.Errorf("total difficulty mismatch: have: %v, want %v", td, 11)
+	}
+
+	// The lower header is ignored
+	if err := bc.InsertTrustedHeader(&types.Header{Number: big.NewInt(5), Difficulty: big.NewInt(1)}); err != nil {
+		t.Fatalf("failed to insert trusted header: %v", err)
+	}
+	if bc.CurrentHeader().Hash() != trusted.Hash() {
+		t.Errorf("head hash mismatch: have: %x, want %x", bc.CurrentHeader().Hash(), trusted.Hash())
+	}
+
+	child := &types.Header{ParentHash: trusted.Hash(), Number: big.NewInt(11), Difficulty: big.NewInt(1)}
+	if _, err := bc.InsertHeaderChain([]*types.Header{child}, 1); err != nil {
+		t.Fatalf("failed to import headers: %v", err)
+	}
+	if bc.CurrentHeader().Hash() != child.Hash() {
+		t.Errorf("head hash mismatch: have: %x, want %x", bc.CurrentHeader().Hash(), child.Hash())
+	}
+}
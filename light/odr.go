@@ -22,6 +22,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -46,6 +47,14 @@ type OdrBackend interface {
 	IndexerConfig() *IndexerConfig
 }
 
+// EpochOdrBackend is implemented by the ODR backends of the chains verified by epoch, e.g.
+// hotstuff, which have no CHT, so that the canonical headers are retrieved one by one and
+// verified against the trusted epoch start headers.
+type EpochOdrBackend interface {
+	OdrBackend
+	EpochVerifier() consensus.EpochVerifier
+}
+
 // OdrRequest is an interface for retrieval requests
 type OdrRequest interface {
 	StoreResult(db ethdb.Database)
@@ -152,6 +161,29 @@ func (req *ChtRequest) StoreResult(db ethdb.Database) {
 	rawdb.WriteCanonicalHash(db, hash, num)
 }
 
+// EpochHeaderRequest is the ODR request type for retrieving the canonical header inside of
+// the trusted epoch, which is verified against the validators elected in the epoch start header.
+type EpochHeaderRequest struct {
+	Number   uint64
+	Epoch    *types.Header
+	Verifier consensus.EpochVerifier
+	Header   *types.Header
+}
+
+// StoreResult stores the retrieved data in local database, the engines verifying headers
+// by epoch use the constant difficulty, so that the total difficulty is derived from the
+// genesis one.
+func (req *EpochHeaderRequest) StoreResult(db ethdb.Database) {
+	hash, num := req.Header.Hash(), req.Header.Number.Uint64()
+	td := new(big.Int).Mul(req.Header.Difficulty, req.Header.Number)
+	if genesis := rawdb.ReadHeader(db, rawdb.ReadCanonicalHash(db, 0), 0); genesis != nil {
+		td.Add(td, genesis.Difficulty)
+	}
+	rawdb.WriteHeader(db, req.Header)
+	rawdb.WriteTd(db, hash, num, td)
+	rawdb.WriteCanonicalHash(db, hash, num)
+}
+
 // BloomRequest is the ODR request type for retrieving bloom filters from a CHT structure
 type BloomRequest struct {
 	OdrRequest
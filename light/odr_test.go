@@ -26,6 +26,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -319,3 +320,98 @@ func testChainOdr(t *testing.T, protocol int, fn odrTestFn) {
 	odr.disable = true
 	test(len(gchain))
 }
+
+// testEpochVerifier starts an epoch every fixed number of blocks, and rejects the headers
+// carrying any extra data.
+type testEpochVerifier struct {
+	length uint64
+}
+
+func (v *testEpochVerifier) EpochRange(header *types.Header) (uint64, uint64, error) {
+	start := header.Number.Uint64() - header.Number.Uint64()%v.length
+	return start, start + v.length, nil
+}
+
+func (v *testEpochVerifier) VerifyEpochHeader(epoch, header *types.Header) error {
+	return nil
+}
+
+func (v *testEpochVerifier) VerifyEpochMember(epoch, header *types.Header) error {
+	if start, _, _ := v.EpochRange(header); start != epoch.Number.Uint64() {
+		return errors.New("out of epoch")
+	}
+	if len(header.Extra) != 0 {
+		return errors.New("invalid seal")
+	}
+	return nil
+}
+
+// testEpochOdr serves the headers of the chain verified by epoch.
+type testEpochOdr struct {
+	testOdr
+	verifier *testEpochVerifier
+	headers  map[uint64]*types.Header
+}
+
+func (odr *testEpochOdr) EpochVerifier() consensus.EpochVerifier {
+	return odr.verifier
+}
+
+func (odr *testEpochOdr) Retrieve(ctx context.Context, req OdrRequest) error {
+	if req, ok := req.(*EpochHeaderRequest); ok {
+		header := odr.headers[req.Number]
+		if err := req.Verifier.VerifyEpochMember(req.Epoch, header); err != nil {
+			return err
+		}
+		req.Header = header
+	}
+	req.StoreResult(odr.ldb)
+	return nil
+}
+
+// Tests that the headers between the epoch start headers are retrieved on demand and
+// verified against the trusted epoch.
+func TestOdrGetHeaderByEpoch(t *testing.T) {
+	odr := &testEpochOdr{
+		testOdr:  testOdr{ldb: rawdb.NewMemoryDatabase()},
+		verifier: &testEpochVerifier{length: 10},
+		headers:  make(map[uint64]*types.Header),
+	}
+	for i := uint64(0); i < 30; i++ {
+		odr.headers[i] = &types.Header{Number: new(big.Int).SetUint64(i), Difficulty: big.NewInt(1)}
+	}
+	odr.headers[7].Extra = []byte("forged")
+
+	// Only the genesis and the epoch start header are trusted
+	for _, number := range []uint64{0, 10} {
+		header := odr.headers[number]
+		rawdb.WriteHeader(odr.ldb, header)
+		rawdb.WriteCanonicalHash(odr.ldb, header.Hash(), number)
+	}
+	for _, number := range []uint64{5, 10, 15} {
+		header, err := GetHeaderByNumber(context.Background(), odr, number)
+		if err != nil {
+			t.Fatalf("block %d: failed to retrieve header: %v", number, err)
+		}
+		if header.Hash() != odr.headers[number].Hash() {
+			t.Fatalf("block %d: header mismatch", number)
+		}
+		if hash := rawdb.ReadCanonicalHash(odr.ldb, number); hash != header.Hash() {
+			t.Fatalf("block %d: canonical hash mismatch: have %x, want %x", number, hash, header.Hash())
+		}
+		if td := rawdb.ReadTd(odr.ldb, header.Hash(), number); number != 10 && (td == nil || td.Uint64() != number+1) {
+			t.Fatalf("block %d: total difficulty mismatch: have %v, want %d", number, td, number+1)
+		}
+	}
+	// The forged header is rejected
+	if _, err := GetHeaderByNumber(context.Background(), odr, 7); err == nil {
+		t.Fatalf("forged header accepted")
+	}
+	if hash := rawdb.ReadCanonicalHash(odr.ldb, 7); hash != (common.Hash{}) {
+		t.Fatalf("forged header stored")
+	}
+	// The header beyond the trusted epochs is not retrieved
+	if _, err := GetHeaderByNumber(context.Background(), odr, 25); err != errNoTrustedEpoch {
+		t.Fatalf("error mismatch: have %v, want %v", err, errNoTrustedEpoch)
+	}
+}
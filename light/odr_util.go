@@ -23,9 +23,11 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -48,6 +50,22 @@ func GetHeaderByNumber(ctx context.Context, odr OdrBackend, number uint64) (*typ
 			return header, nil
 		}
 	}
+	// The chains verified by epoch have no CHT, retrieve the header via ODR and
+	// verify it against the trusted epoch start header covering it instead.
+	if eodr, ok := odr.(EpochOdrBackend); ok && eodr.EpochVerifier() != nil {
+		epoch, err := trustedEpoch(db, eodr.EpochVerifier(), number)
+		if err != nil {
+			return nil, err
+		}
+		if epoch.Number.Uint64() == number {
+			return epoch, nil
+		}
+		r := &EpochHeaderRequest{Number: number, Epoch: epoch, Verifier: eodr.EpochVerifier()}
+		if err := odr.Retrieve(ctx, r); err != nil {
+			return nil, err
+		}
+		return r.Header, nil
+	}
 	// Retrieve the header via ODR, ensure the requested header is covered
 	// by local trusted CHT.
 	chts, _, chtHead := odr.ChtIndexer().Sections()
@@ -66,6 +84,23 @@ func GetHeaderByNumber(ctx context.Context, odr OdrBackend, number uint64) (*typ
 	return r.Header, nil
 }
 
+// trustedEpoch returns the local epoch start header covering the number, following the
+// chain of the epoch start headers from the genesis.
+func trustedEpoch(db ethdb.Reader, verifier consensus.EpochVerifier, number uint64) (*types.Header, error) {
+	epoch := rawdb.ReadHeader(db, rawdb.ReadCanonicalHash(db, 0), 0)
+	for epoch != nil {
+		_, end, err := verifier.EpochRange(epoch)
+		if err != nil {
+			return nil, err
+		}
+		if number < end {
+			return epoch, nil
+		}
+		epoch = rawdb.ReadHeader(db, rawdb.ReadCanonicalHash(db, end), end)
+	}
+	return nil, errNoTrustedEpoch
+}
+
 // GetCanonicalHash retrieves the canonical block hash corresponding to the number.
 func GetCanonicalHash(ctx context.Context, odr OdrBackend, number uint64) (common.Hash, error) {
 	hash := rawdb.ReadCanonicalHash(odr.Database(), number)
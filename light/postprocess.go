@@ -102,6 +102,7 @@ var (
 var (
 	errNoTrustedCht       = errors.New("no trusted canonical hash trie")
 	errNoTrustedBloomTrie = errors.New("no trusted bloom trie")
+	errNoTrustedEpoch     = errors.New("no trusted epoch")
 	errNoHeader           = errors.New("header not found")
 	chtPrefix             = []byte("chtRootV2-") // chtPrefix + chtNum (uint64 big endian) -> trie root hash
 	ChtTablePrefix        = "cht-"
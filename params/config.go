@@ -364,6 +364,10 @@ type ChainConfig struct {
 // todo:
 type HotStuffConfig struct {
 	Protocol string `json:"protocol"`
+
+	// VoteBlock switches the consensus signatures from the raw hashes to the domain separated
+	// votes, which are required by the remote signer (nil = no fork, 0 = already activated)
+	VoteBlock *big.Int `json:"voteBlock,omitempty"`
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -487,6 +491,11 @@ func (c *ChainConfig) IsCatalyst(num *big.Int) bool {
 	return isForked(c.CatalystBlock, num)
 }
 
+// IsHotstuffVote returns whether num is either equal to the hotstuff vote fork block or greater.
+func (c *ChainConfig) IsHotstuffVote(num *big.Int) bool {
+	return c.HotStuff != nil && isForked(c.HotStuff.VoteBlock, num)
+}
+
 // IsEWASM returns whether num represents a block number after the EWASM fork
 func (c *ChainConfig) IsEWASM(num *big.Int) bool {
 	return isForked(c.EWASMBlock, num)
@@ -604,9 +613,20 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.EWASMBlock, newcfg.EWASMBlock, head) {
 		return newCompatError("ewasm fork block", c.EWASMBlock, newcfg.EWASMBlock)
 	}
+	if isForkIncompatible(c.HotstuffVoteBlock(), newcfg.HotstuffVoteBlock(), head) {
+		return newCompatError("hotstuff vote fork block", c.HotstuffVoteBlock(), newcfg.HotstuffVoteBlock())
+	}
 	return nil
 }
 
+// HotstuffVoteBlock returns the hotstuff vote fork block, nil if it's not scheduled.
+func (c *ChainConfig) HotstuffVoteBlock() *big.Int {
+	if c == nil || c.HotStuff == nil {
+		return nil
+	}
+	return c.HotStuff.VoteBlock
+}
+
 // BaseFeeChangeDenominator bounds the amount the base fee can change between blocks.
 func (c *ChainConfig) BaseFeeChangeDenominator() uint64 {
 	return DefaultBaseFeeChangeDenominator
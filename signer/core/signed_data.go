@@ -58,6 +58,10 @@ var (
 		accounts.MimetypeClique,
 		0x02,
 	}
+	ApplicationHotstuff = SigFormat{
+		accounts.MimetypeHotstuff,
+		0x03,
+	}
 	TextPlain = SigFormat{
 		accounts.MimetypeTextPlain,
 		0x45,
@@ -69,6 +73,16 @@ type ValidatorData struct {
 	Message hexutil.Bytes
 }
 
+// HotstuffVote is the consensus hash to be signed by hotstuff validator, along
+// with the view and message type or seal code. The hash is never signed directly
+// but within the domain of `accounts.HotstuffVoteHash`.
+type HotstuffVote struct {
+	Height uint64
+	Round  uint64
+	Code   uint64
+	Hash   common.Hash
+}
+
 type TypedData struct {
 	Types       Types            `json:"types"`
 	PrimaryType string           `json:"primaryType"`
@@ -147,8 +161,16 @@ func (api *SignerAPI) sign(req *SignDataRequest, legacyV bool) (hexutil.Bytes, e
 	if err != nil {
 		return nil, err
 	}
-	// Sign the data with the wallet
-	signature, err := wallet.SignDataWithPassphrase(account, pw, req.ContentType, req.Rawdata)
+	// Sign the data with the wallet, hotstuff votes are signed over the pre-image of vote hash
+	data := req.Rawdata
+	if req.ContentType == ApplicationHotstuff.Mime {
+		vote := new(HotstuffVote)
+		if err := rlp.DecodeBytes(req.Rawdata, vote); err != nil {
+			return nil, err
+		}
+		_, data = accounts.HotstuffVoteAndHash(vote.Height, vote.Code, vote.Hash)
+	}
+	signature, err := wallet.SignDataWithPassphrase(account, pw, req.ContentType, data)
 	if err != nil {
 		return nil, err
 	}
@@ -258,6 +280,38 @@ func (api *SignerAPI) determineSignatureFormat(ctx context.Context, contentType
 		// Clique uses V on the form 0 or 1
 		useEthereumV = false
 		req = &SignDataRequest{ContentType: mediaType, Rawdata: cliqueRlp, Messages: messages, Hash: sighash}
+	case ApplicationHotstuff.Mime:
+		// Calculates the signature of hotstuff vote for:
+		// hash = keccak256("hotstuff-vote${height}${code}${hash}")
+		stringData, ok := data.(string)
+		if !ok {
+			return nil, useEthereumV, fmt.Errorf("input for %v must be an hex-encoded string", ApplicationHotstuff.Mime)
+		}
+		hotstuffData, err := hexutil.Decode(stringData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		vote := new(HotstuffVote)
+		if err := rlp.DecodeBytes(hotstuffData, vote); err != nil {
+			return nil, useEthereumV, err
+		}
+		if vote.Hash == (common.Hash{}) {
+			return nil, useEthereumV, fmt.Errorf("hotstuff vote hash is empty")
+		}
+		if vote.Code == 0 {
+			return nil, useEthereumV, fmt.Errorf("hotstuff vote code is empty")
+		}
+		sighash := accounts.HotstuffVoteHash(vote.Height, vote.Code, vote.Hash)
+		messages := []*NameValueType{
+			{
+				Name:  "Hotstuff vote",
+				Typ:   "hotstuff",
+				Value: fmt.Sprintf("height %d round %d code %d [0x%x]", vote.Height, vote.Round, vote.Code, vote.Hash),
+			},
+		}
+		// Hotstuff uses V on the form 0 or 1
+		useEthereumV = false
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: hotstuffData, Messages: messages, Hash: sighash}
 	default: // also case TextPlain.Mime:
 		// Calculates an Ethereum ECDSA signature for:
 		// hash = keccak256("\x19${byteVersion}Ethereum Signed Message:\n${message length}${message}")
@@ -0,0 +1,146 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/ethereum/go-ethereum/signer/storage"
+)
+
+const hotstuffStoragePrefix = "hotstuff-votes-"
+
+// hotstuffSealCodeProposal is the seal code of the proposer seal, same as `hotstuff.SealCodeProposal`.
+// The proposer may re-seal the block of the same round with new timestamp or transactions, and the
+// proposals are never slashable, so the single signing is only enforced on the votes.
+const hotstuffSealCodeProposal uint64 = 0x80
+
+// hotstuffSealCodeHandshake is the seal code of consensus peer handshake, same as `hotstuff.SealCodeHandshake`.
+// The handshake is signed for each of the connected peers, and it's never taken as vote.
+const hotstuffSealCodeHandshake uint64 = 0x83
+
+// hotstuffRecord is the votes signed by validator in the highest height, keyed by `round-code`.
+type hotstuffRecord struct {
+	Height uint64                 `json:"height"`
+	Votes  map[string]common.Hash `json:"votes"`
+}
+
+// hotstuffRuleset provides an implementation of UIClientAPI that auto-approves hotstuff
+// votes, and refuses to sign two different hashes for the same height/round/type, or
+// any vote lower than the highest signed height. Proposer seals and peer handshakes are
+// approved without the check. Other requests are dispatched to the
+// next handler.
+type hotstuffRuleset struct {
+	core.UIClientAPI // The next handler, for manual processing
+	storage          storage.Storage
+	mu               sync.Mutex
+}
+
+func NewHotstuffRuleset(next core.UIClientAPI, backend storage.Storage) *hotstuffRuleset {
+	return &hotstuffRuleset{
+		UIClientAPI: next,
+		storage:     backend,
+	}
+}
+
+func (r *hotstuffRuleset) ApproveSignData(request *core.SignDataRequest) (core.SignDataResponse, error) {
+	if request.ContentType != core.ApplicationHotstuff.Mime {
+		return r.UIClientAPI.ApproveSignData(request)
+	}
+
+	vote := new(core.HotstuffVote)
+	if err := rlp.DecodeBytes(request.Rawdata, vote); err != nil {
+		return core.SignDataResponse{Approved: false}, err
+	}
+	if vote.Code == 0 {
+		return core.SignDataResponse{Approved: false}, fmt.Errorf("hotstuff vote code is empty")
+	}
+	if !bytes.Equal(accounts.HotstuffVoteHash(vote.Height, vote.Code, vote.Hash), request.Hash) {
+		return core.SignDataResponse{Approved: false}, fmt.Errorf("hotstuff vote hash mismatch")
+	}
+
+	if vote.Code == hotstuffSealCodeProposal || vote.Code == hotstuffSealCodeHandshake {
+		return core.SignDataResponse{Approved: true}, nil
+	}
+	if err := r.checkAndRecord(request.Address.Address(), vote); err != nil {
+		log.Warn("Hotstuff vote rejected", "address", request.Address, "height", vote.Height, "round", vote.Round, "code", vote.Code, "hash", vote.Hash, "err", err)
+		r.UIClientAPI.ShowError(err.Error())
+		return core.SignDataResponse{Approved: false}, nil
+	}
+	return core.SignDataResponse{Approved: true}, nil
+}
+
+// checkAndRecord check the vote against the votes signed before and persist it.
+func (r *hotstuffRuleset) checkAndRecord(addr common.Address, vote *core.HotstuffVote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, err := r.load(addr)
+	if err != nil {
+		return err
+	}
+	if vote.Height < record.Height {
+		return fmt.Errorf("height %d lower than signed height %d", vote.Height, record.Height)
+	}
+	if vote.Height > record.Height {
+		record = &hotstuffRecord{Height: vote.Height, Votes: make(map[string]common.Hash)}
+	}
+
+	key := fmt.Sprintf("%d-%d", vote.Round, vote.Code)
+	if signed, ok := record.Votes[key]; ok {
+		if signed != vote.Hash {
+			return fmt.Errorf("double sign, round %d code %d signed %v", vote.Round, vote.Code, signed.Hex())
+		}
+		return nil
+	}
+	record.Votes[key] = vote.Hash
+	return r.store(addr, record)
+}
+
+func (r *hotstuffRuleset) load(addr common.Address) (*hotstuffRecord, error) {
+	record := &hotstuffRecord{Votes: make(map[string]common.Hash)}
+	raw, err := r.storage.Get(hotstuffStoragePrefix + addr.Hex())
+	if err != nil || raw == "" {
+		return record, nil
+	}
+	if err := json.Unmarshal([]byte(raw), record); err != nil {
+		return nil, fmt.Errorf("corrupted hotstuff votes: %v", err)
+	}
+	if record.Votes == nil {
+		record.Votes = make(map[string]common.Hash)
+	}
+	return record, nil
+}
+
+func (r *hotstuffRuleset) store(addr common.Address, record *hotstuffRecord) error {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	r.storage.Put(hotstuffStoragePrefix+addr.Hex(), string(raw))
+	return nil
+}
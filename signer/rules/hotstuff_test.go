@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/ethereum/go-ethereum/signer/storage"
+)
+
+func hotstuffRequest(t *testing.T, addr common.Address, height, round, code uint64, hash common.Hash) *core.SignDataRequest {
+	raw, err := rlp.EncodeToBytes(&core.HotstuffVote{Height: height, Round: round, Code: code, Hash: hash})
+	if err != nil {
+		t.Fatalf("failed to encode vote: %v", err)
+	}
+	return &core.SignDataRequest{
+		ContentType: accounts.MimetypeHotstuff,
+		Address:     common.NewMixedcaseAddress(addr),
+		Rawdata:     raw,
+		Hash:        accounts.HotstuffVoteHash(height, code, hash),
+	}
+}
+
+func TestHotstuffRuleset(t *testing.T) {
+	var (
+		ui      = &dummyUI{make([]string, 0)}
+		backend = storage.NewEphemeralStorage()
+		addr    = common.HexToAddress("0x1234")
+		hash1   = common.HexToHash("0x01")
+		hash2   = common.HexToHash("0x02")
+	)
+
+	testcases := []struct {
+		height, round, code uint64
+		hash                common.Hash
+		approved            bool
+	}{
+		{10, 0, 2, hash1, true},
+		{10, 0, 2, hash1, true},  // same vote signed again
+		{10, 0, 2, hash2, false}, // double sign
+		{10, 0, 4, hash2, true},  // different message type
+		{10, 1, 2, hash2, true},  // different round
+		{9, 0, 2, hash2, false},  // lower height
+		{11, 0, 2, hash2, true},
+		{11, 0, 2, hash1, false},
+		{11, 0, 0x80, hash1, true}, // proposer seal
+		{11, 0, 0x80, hash2, true}, // re-seal the proposal
+		{9, 0, 0x80, hash2, true},  // proposal is never recorded
+		{0, 0, 0x83, hash1, true},  // peer handshake
+		{0, 0, 0x83, hash2, true},  // handshake with another peer
+	}
+
+	r := NewHotstuffRuleset(ui, backend)
+	for i, tc := range testcases {
+		resp, err := r.ApproveSignData(hotstuffRequest(t, addr, tc.height, tc.round, tc.code, tc.hash))
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %v", i, err)
+		}
+		if resp.Approved != tc.approved {
+			t.Errorf("case %d: approved mismatch, have %v, want %v", i, resp.Approved, tc.approved)
+		}
+	}
+
+	// the votes should be protected after clef restarted
+	r = NewHotstuffRuleset(ui, backend)
+	if resp, _ := r.ApproveSignData(hotstuffRequest(t, addr, 11, 0, 2, hash1)); resp.Approved {
+		t.Errorf("double sign approved after restart")
+	}
+	if resp, _ := r.ApproveSignData(hotstuffRequest(t, common.HexToAddress("0x5678"), 11, 0, 2, hash1)); !resp.Approved {
+		t.Errorf("vote of another validator should be approved")
+	}
+
+	// the raw hash and the hash without consensus context are never approved
+	request := hotstuffRequest(t, addr, 12, 0, 2, hash1)
+	request.Hash = hash1.Bytes()
+	if resp, err := r.ApproveSignData(request); err == nil || resp.Approved {
+		t.Errorf("raw hash should be rejected")
+	}
+	if resp, err := r.ApproveSignData(hotstuffRequest(t, addr, 12, 0, 0, hash1)); err == nil || resp.Approved {
+		t.Errorf("hash without consensus context should be rejected")
+	}
+	for _, call := range ui.calls {
+		if call == "ApproveSignData" {
+			t.Errorf("expect no forwarded call, got %v", ui.calls)
+		}
+	}
+
+	// other requests are dispatched to the next handler
+	r.ApproveSignData(&core.SignDataRequest{ContentType: accounts.MimetypeTextPlain, Address: common.NewMixedcaseAddress(addr)})
+	if len(ui.calls) == 0 || ui.calls[len(ui.calls)-1] != "ApproveSignData" {
+		t.Errorf("expect forwarded call, got %v", ui.calls)
+	}
+}